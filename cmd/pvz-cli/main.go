@@ -20,7 +20,13 @@ func main() {
 	syncChan := make(chan struct{})
 	defer cancel()
 
-	cli := cli.NewCLI(serviceURL)
+	// operator is recorded in service audit log for every change
+	operatorID := os.Getenv("PVZ_OPERATOR")
+	if operatorID == "" {
+		operatorID = os.Getenv("USER")
+	}
+
+	cli := cli.NewCLI(serviceURL, operatorID)
 
 	go cli.Run(ctxWithCancel, syncChan)
 
@@ -2,29 +2,47 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"flag"
 	"fmt"
+	"io/fs"
 	"log"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/IBM/sarama"
+	"github.com/Na322Pr/route256/internal/app/admin"
 	"github.com/Na322Pr/route256/internal/app/mw"
 	"github.com/Na322Pr/route256/internal/app/pvz_service"
 	"github.com/Na322Pr/route256/internal/cache"
+	"github.com/Na322Pr/route256/internal/clock"
 	"github.com/Na322Pr/route256/internal/config"
+	"github.com/Na322Pr/route256/internal/domain"
+	"github.com/Na322Pr/route256/internal/dto"
+	"github.com/Na322Pr/route256/internal/kafka/consumer"
 	"github.com/Na322Pr/route256/internal/kafka/event"
 	"github.com/Na322Pr/route256/internal/kafka/producer"
 	"github.com/Na322Pr/route256/internal/repository"
+	"github.com/Na322Pr/route256/internal/repository/memory"
+	"github.com/Na322Pr/route256/internal/repository/postgres"
+	"github.com/Na322Pr/route256/internal/repository/sqlite"
+	"github.com/Na322Pr/route256/internal/scoring"
 	"github.com/Na322Pr/route256/internal/tracer"
 	"github.com/Na322Pr/route256/internal/usecase"
 	"github.com/go-chi/chi"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/redis/go-redis/v9"
 	httpSwagger "github.com/swaggo/http-swagger"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -34,7 +52,15 @@ import (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		runMigrate(os.Args[2:])
+		return
+	}
+
+	autoMigrate := flag.Bool("auto-migrate", false, "apply pending migrations before serving")
+
 	cfg := config.MustLoad()
+	*autoMigrate = *autoMigrate || cfg.Migrations.AutoMigrate
 
 	psqlDSN := getPsqlDSN(cfg)
 	httpHost := cfg.HTTP.Host
@@ -50,11 +76,61 @@ func main() {
 
 	tracer.MustSetup(ctx, "baker-bot")
 
-	pool, err := pgxpool.New(ctxWithCancel, psqlDSN)
-	if err != nil {
-		log.Fatal(err)
+	clk := clock.Real
+
+	var (
+		repo             usecase.OrderRepoFacade
+		idempotencyStore mw.IdempotencyStore
+		errOrderNotFound error
+	)
+
+	switch cfg.Storage {
+	case config.StorageMemory:
+		repo = memory.NewFacade()
+		idempotencyStore = memory.NewIdempotencyStore()
+		errOrderNotFound = memory.ErrOrderNotFound
+	case config.StorageSQLite:
+		db, err := sqlite.Open(cfg.SQLite.Path)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer db.Close()
+
+		if err := prepareSchema(ctxWithCancel, db, cfg.Storage, *autoMigrate); err != nil {
+			log.Fatal(err)
+		}
+
+		repo = sqlite.NewFacade(db)
+		idempotencyStore = sqlite.NewIdempotencyStore(db)
+		errOrderNotFound = sqlite.ErrOrderNotFound
+	default:
+		pool, err := pgxpool.New(ctxWithCancel, psqlDSN)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer pool.Close()
+
+		db := stdlib.OpenDBFromPool(pool)
+		defer db.Close()
+
+		if err := prepareSchema(ctxWithCancel, db, config.StoragePostgres, *autoMigrate); err != nil {
+			log.Fatal(err)
+		}
+
+		replicas, closeReplicas, err := openReplicas(ctxWithCancel, cfg.Replicas)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer closeReplicas()
+
+		go replicas.RunHealthCheck(ctxWithCancel, cfg.Replicas.HealthCheckInterval)
+
+		repo = repository.NewFacade(pool, postgres.WithReplicas(replicas, cfg.Replicas.ReadAfterWrite))
+		idempotencyStore = postgres.NewIdempotencyStore(postgres.NewTxManager(pool))
+		errOrderNotFound = postgres.ErrOrderNotFound
 	}
-	defer pool.Close()
+
+	go cleanupIdempotencyKeys(ctxWithCancel, idempotencyStore, cfg.Idempotency.CleanupInterval, clk)
 
 	prod, err := producer.NewSyncProducer(cfg.Kafka,
 		producer.WithRequiredAcks(sarama.WaitForLocal),
@@ -68,15 +144,85 @@ func main() {
 	}
 	defer prod.Close()
 
-	eventLogProd, err := event.NewEventLogProducer(prod, "pvz.events-log", "pvz-service")
+	instanceID, err := getInstanceID(cfg.Kafka)
 	if err != nil {
 		log.Fatal(err)
 	}
+	log.Printf("instance id: %s", instanceID)
 
-	cache := cache.NewOrderCache(1 * time.Hour)
+	eventLogProd, err := event.NewEventLogProducer(prod, "pvz.events-log", "pvz-service",
+		event.WithInstanceID(instanceID),
+		event.WithClock(clk),
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
 
-	repo := repository.NewFacade(pool)
-	orderUseCase := usecase.NewOrderUseCase(repo, eventLogProd, cache)
+	orderCache, closeCache, err := openOrderCache(ctxWithCancel, cfg.Cache, clk)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var (
+		cacheOpts = []repository.CachedFacadeOption{repository.WithClock(clk)}
+		// derivedCaches are local caches filled from orders, they are flushed with the order cache
+		derivedCaches []admin.Flusher
+	)
+	if cfg.Cache.Lists.Enabled {
+		clientOrders := cache.NewListCache[int](cache.ClientOrdersCacheName, cfg.Cache.Lists.TTL, cfg.Cache.Lists.MaxEntries)
+		refunds := cache.NewListCache[repository.RefundsPage](cache.RefundsCacheName, cfg.Cache.Lists.TTL, cfg.Cache.Lists.MaxEntries)
+
+		cacheOpts = append(cacheOpts, repository.WithListCaches(clientOrders, refunds))
+		derivedCaches = append(derivedCaches, clientOrders, refunds)
+	}
+
+	if cfg.Cache.NotFound.Enabled {
+		notFound := cache.NewNotFoundCache(cfg.Cache.NotFound.TTL, cfg.Cache.NotFound.MaxEntries)
+
+		cacheOpts = append(cacheOpts, repository.WithNotFoundCache(notFound, errOrderNotFound))
+		derivedCaches = append(derivedCaches, notFound)
+	}
+
+	cachedRepo := repository.NewCachedFacade(repo, orderCache, cacheOpts...)
+
+	// redis order cache is shared by replicas, but list and not found caches are local to each of them
+	localCaches := cfg.Cache.Backend != config.CacheRedis || len(derivedCaches) > 0
+	if localCaches && cfg.Cache.Invalidation.Enabled {
+		group, err := consumer.NewConsumerGroup(cfg.Kafka, cfg.Cache.Invalidation.GroupPrefix+"-"+instanceID)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer group.Close()
+
+		go consumer.Run(ctxWithCancel, group, []string{cfg.Cache.Invalidation.Topic},
+			event.NewCacheInvalidator(cachedRepo, instanceID),
+		)
+	}
+
+	if cfg.Cache.WarmUp.Enabled {
+		received := []string{domain.OrderStatusMap[domain.OrderStatusReceived]}
+
+		warmed, err := cachedRepo.WarmUp(ctxWithCancel, received, cfg.Cache.WarmUp.BatchSize)
+		if err != nil {
+			log.Printf("failed to warm up order cache: %v", err)
+		}
+		log.Printf("warmed up cache with %d orders", warmed)
+	}
+
+	repo = cachedRepo
+
+	scorer := scoring.NewRefundScorer(cfg.RefundScoring)
+	go scorer.RunCleanup(ctxWithCancel, cfg.RefundScoring.CleanupInterval, clk)
+
+	orderUseCase := usecase.NewOrderUseCase(repo, eventLogProd,
+		usecase.WithRefundScorer(scorer),
+		usecase.WithArchivePolicy(cfg.Archive.OlderThan, cfg.Archive.BatchSize),
+		usecase.WithClock(clk),
+	)
+
+	if cfg.Archive.Enabled {
+		go archiveOrders(ctxWithCancel, orderUseCase, cfg.Archive.Interval)
+	}
 	pvzService := pvz_service.NewImplementation(*orderUseCase)
 
 	lis, err := net.Listen("tcp", grpcHost)
@@ -85,12 +231,17 @@ func main() {
 	}
 
 	grpcServer := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(mw.Logging),
+		grpc.ChainUnaryInterceptor(
+			mw.Logging,
+			mw.Operator(cfg.Operator.DefaultID, cfg.Operator.Supervisors, pvz_service.MutatingMethods...),
+			mw.Supervisor(pvz_service.SupervisorMethods...),
+			mw.Idempotency(idempotencyStore, cfg.Idempotency.TTL, cfg.Idempotency.Lease, clk, pvz_service.MutatingMethods...),
+		),
 	)
 	reflection.Register(grpcServer)
 	desc.RegisterPVZServiceServer(grpcServer, pvzService)
 
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(mw.HeaderMatcher))
 	err = desc.RegisterPVZServiceHandlerFromEndpoint(ctx, mux, grpcHost, []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 	})
@@ -110,6 +261,8 @@ func main() {
 	fmt.Println("Starting admin server...")
 	go func() {
 		adminServer := chi.NewMux()
+		admin.RegisterCacheRoutes(adminServer, orderCache, clk, derivedCaches...)
+
 		adminServer.HandleFunc("/swagger.json", func(w http.ResponseWriter, r *http.Request) {
 			b, _ := os.ReadFile("./pkg/pvz-service/v1/pvz_service.swagger.json")
 			w.Header().Set("Content-Type", "application/json")
@@ -134,6 +287,7 @@ func main() {
 
 	<-stop
 	fmt.Println("\nShutting down servers...")
+	closeCache()
 	os.Exit(0)
 }
 
@@ -147,6 +301,146 @@ func getPsqlDSN(cfg *config.Config) string {
 	)
 }
 
+func openReplicas(ctx context.Context, cfg config.Replicas) (*postgres.ReplicaSet, func(), error) {
+	pools := make([]*pgxpool.Pool, 0, len(cfg.DSNs))
+	closeAll := func() {
+		for _, pool := range pools {
+			pool.Close()
+		}
+	}
+
+	dbs := make([]postgres.Database, 0, len(cfg.DSNs))
+	for _, dsn := range cfg.DSNs {
+		pool, err := pgxpool.New(ctx, dsn)
+		if err != nil {
+			closeAll()
+			return nil, nil, err
+		}
+
+		pools = append(pools, pool)
+		dbs = append(dbs, pool)
+	}
+
+	return postgres.NewReplicaSet(cfg.MaxLag, dbs...), closeAll, nil
+}
+
+// getInstanceID returns configured instance id or the one generated on the first start and kept
+// in cfg.InstanceIDPath, so a restarted replica keeps its invalidation group and committed offset.
+// Hostname is not used, replicas running on one host would share it
+func getInstanceID(cfg config.Kafka) (string, error) {
+	if cfg.InstanceID != "" {
+		return cfg.InstanceID, nil
+	}
+
+	b, err := os.ReadFile(cfg.InstanceIDPath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return "", err
+	}
+
+	if id := strings.TrimSpace(string(b)); id != "" {
+		return id, nil
+	}
+
+	random := make([]byte, 8)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	id := "pvz-service-" + hex.EncodeToString(random)
+
+	if err := os.MkdirAll(filepath.Dir(cfg.InstanceIDPath), 0o755); err != nil {
+		return "", err
+	}
+
+	if err := os.WriteFile(cfg.InstanceIDPath, []byte(id+"\n"), 0o644); err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+type orderCache interface {
+	repository.OrderCacheFacade
+	admin.OrderCache
+}
+
+func openOrderCache(ctx context.Context, cfg config.Cache, clk clock.Clock) (orderCache, func(), error) {
+	if cfg.Backend != config.CacheRedis {
+		c := cache.NewOrderCache(cfg.TTL, cfg.Shards, cfg.MaxEntries, cfg.MaxBytes,
+			cache.WithClock[int64, *dto.OrderDTO](clk),
+		)
+		go c.RunJanitor(ctx, cfg.JanitorInterval)
+
+		if !cfg.Snapshot.Enabled {
+			return c, func() {}, nil
+		}
+
+		// broken snapshot only costs a cold start
+		restored, err := c.LoadSnapshot(cfg.Snapshot.Path, clk.Now(), cfg.Snapshot.RestoreTTL)
+		if err != nil {
+			log.Printf("failed to restore order cache: %v", err)
+		}
+		log.Printf("restored %d orders to cache", restored)
+
+		go c.RunSnapshots(ctx, cfg.Snapshot.Path, cfg.Snapshot.Interval)
+
+		return c, func() {
+			if _, err := c.SaveSnapshot(cfg.Snapshot.Path, clk.Now()); err != nil {
+				log.Printf("failed to save order cache: %v", err)
+			}
+		}, nil
+	}
+
+	client := redis.NewClient(&redis.Options{
+		Addr:     cfg.Redis.Addr,
+		Password: cfg.Redis.Password,
+		DB:       cfg.Redis.DB,
+	})
+
+	if err := client.Ping(ctx).Err(); err != nil {
+		client.Close()
+		return nil, nil, fmt.Errorf("failed to connect to redis: %w", err)
+	}
+
+	return cache.NewRedisOrderCache(client, cfg.TTL, cfg.Redis.KeyPrefix, clk), func() { client.Close() }, nil
+}
+
+func cleanupIdempotencyKeys(ctx context.Context, store mw.IdempotencyStore, interval time.Duration, clk clock.Clock) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := store.DeleteExpired(ctx, clk.Now()); err != nil {
+				log.Printf("failed to delete expired idempotency keys: %v", err)
+			}
+		}
+	}
+}
+
+func archiveOrders(ctx context.Context, orderUseCase *usecase.OrderUseCase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			archived, err := orderUseCase.ArchiveOrders(ctx)
+			if err != nil {
+				log.Printf("failed to archive orders: %v", err)
+			}
+
+			if archived > 0 {
+				log.Printf("archived %d orders", archived)
+			}
+		}
+	}
+}
+
 func prometheusHandler() runtime.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
 		promhttp.Handler().ServeHTTP(w, r)
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Na322Pr/route256/internal/config"
+	"github.com/Na322Pr/route256/internal/migrator"
+	"github.com/Na322Pr/route256/internal/repository/sqlite"
+)
+
+const migrateUsage = `Usage: pvz-service migrate up|down|status [--config=path]
+  up      apply all pending migrations
+  down    roll back the latest migration
+  status  list migrations and their state`
+
+// runMigrate runs migrate subcommand against the storage from config
+func runMigrate(args []string) {
+	if len(args) == 0 {
+		fmt.Println(migrateUsage)
+		os.Exit(2)
+	}
+
+	flags := flag.NewFlagSet("migrate", flag.ExitOnError)
+	configPath := flags.String("config", os.Getenv("CONFIG_PATH"), "path to config file")
+	flags.Usage = func() { fmt.Println(migrateUsage) }
+
+	command := args[0]
+	if err := flags.Parse(args[1:]); err != nil {
+		log.Fatal(err)
+	}
+
+	if *configPath == "" {
+		log.Fatal("config path is empty")
+	}
+
+	cfg := config.MustLoadPath(*configPath)
+	ctx := context.Background()
+
+	db, err := openMigrationDB(cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+
+	m, err := migrator.New(db, cfg.Storage)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	switch command {
+	case "up":
+		results, err := m.Up(ctx)
+		for _, result := range results {
+			fmt.Printf("applied %s in %s\n", filepath.Base(result.Source.Path), result.Duration.Round(time.Millisecond))
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if len(results) == 0 {
+			fmt.Println("no pending migrations")
+		}
+	case "down":
+		result, err := m.Down(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		fmt.Printf("rolled back %s in %s\n", filepath.Base(result.Source.Path), result.Duration.Round(time.Millisecond))
+	case "status":
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		for _, status := range statuses {
+			appliedAt := "-"
+			if !status.AppliedAt.IsZero() {
+				appliedAt = status.AppliedAt.Format(time.DateTime)
+			}
+
+			fmt.Printf("%-8s %-20s %s\n", status.State, appliedAt, filepath.Base(status.Source.Path))
+		}
+	default:
+		fmt.Println(migrateUsage)
+		os.Exit(2)
+	}
+}
+
+// prepareSchema applies pending migrations when asked to and refuses to go on with outdated schema
+func prepareSchema(ctx context.Context, db *sql.DB, storage string, autoMigrate bool) error {
+	m, err := migrator.New(db, storage)
+	if err != nil {
+		return err
+	}
+
+	if autoMigrate {
+		if _, err := m.Up(ctx); err != nil {
+			return err
+		}
+	}
+
+	return m.Check(ctx)
+}
+
+func openMigrationDB(cfg *config.Config) (*sql.DB, error) {
+	switch cfg.Storage {
+	case config.StorageMemory:
+		return nil, fmt.Errorf("%w: %q", migrator.ErrUnsupportedStorage, cfg.Storage)
+	case config.StorageSQLite:
+		return sqlite.Open(cfg.SQLite.Path)
+	default:
+		return sql.Open("pgx", getPsqlDSN(cfg))
+	}
+}
@@ -0,0 +1,25 @@
+package actor
+
+import "context"
+
+// RoleSupervisor may approve flagged refunds and read audit log
+const RoleSupervisor = "supervisor"
+
+// Actor is the operator who issued the current call
+type Actor struct {
+	OperatorID string
+	Role       string
+	Method     string
+	ClientIP   string
+}
+
+type actorKey struct{}
+
+func NewContext(ctx context.Context, a Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, a)
+}
+
+func FromContext(ctx context.Context) (Actor, bool) {
+	a, ok := ctx.Value(actorKey{}).(Actor)
+	return a, ok
+}
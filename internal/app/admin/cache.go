@@ -0,0 +1,87 @@
+package admin
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Na322Pr/route256/internal/cache"
+	"github.com/Na322Pr/route256/internal/clock"
+	"github.com/Na322Pr/route256/internal/dto"
+	"github.com/go-chi/chi"
+)
+
+type OrderCache interface {
+	Peek(orderID int64) (*dto.OrderDTO, time.Time, bool)
+	Stats() cache.Stats
+	Flush() int
+}
+
+// Flusher is a cache emptied together with the order cache
+type Flusher interface {
+	Flush() int
+}
+
+type cachedOrder struct {
+	Order     *dto.OrderDTO `json:"order"`
+	ExpiresAt time.Time     `json:"expires_at"`
+	Expired   bool          `json:"expired"`
+}
+
+type flushResult struct {
+	Flushed int `json:"flushed"`
+}
+
+// RegisterCacheRoutes mounts order cache introspection:
+// GET /cache/stats, GET /cache/orders/{id} and POST /cache/flush.
+// Flush empties caches derived from orders too, otherwise they would keep serving what was flushed
+func RegisterCacheRoutes(r chi.Router, c OrderCache, clk clock.Clock, derived ...Flusher) {
+	r.Get("/cache/stats", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, c.Stats())
+	})
+
+	r.Get("/cache/orders/{id}", func(w http.ResponseWriter, r *http.Request) {
+		orderID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			http.Error(w, "invalid order id", http.StatusBadRequest)
+			return
+		}
+
+		order, expiresAt, ok := c.Peek(orderID)
+		if !ok {
+			http.Error(w, "order not in cache", http.StatusNotFound)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, cachedOrder{
+			Order:     order,
+			ExpiresAt: expiresAt,
+			Expired:   expiresAt.Before(clk.Now()),
+		})
+	})
+
+	r.Post("/cache/flush", func(w http.ResponseWriter, r *http.Request) {
+		orders := c.Flush()
+
+		derivedFlushed := 0
+		for _, d := range derived {
+			derivedFlushed += d.Flush()
+		}
+		log.Printf("[admin] flushed %d entries of order cache and %d of derived caches", orders, derivedFlushed)
+
+		flushed := orders + derivedFlushed
+
+		writeJSON(w, http.StatusOK, flushResult{Flushed: flushed})
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("[admin] failed to write response: %v", err)
+	}
+}
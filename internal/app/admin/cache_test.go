@@ -0,0 +1,89 @@
+package admin_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Na322Pr/route256/internal/app/admin"
+	"github.com/Na322Pr/route256/internal/cache"
+	"github.com/Na322Pr/route256/internal/clock"
+	"github.com/Na322Pr/route256/internal/dto"
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterCacheRoutes(t *testing.T) {
+	clk := clock.NewFake(time.Date(2030, time.January, 1, 12, 0, 0, 0, time.UTC))
+
+	orderCache := cache.NewOrderCache(time.Hour, 1, 0, 0, cache.WithClock[int64, *dto.OrderDTO](clk))
+	require.NoError(t, orderCache.Set(&dto.OrderDTO{ID: 1, ClientID: 10}, clk.Now()))
+	orderCache.Get(1)
+	orderCache.Get(2)
+
+	notFound := cache.NewNotFoundCache(time.Hour, 0)
+	notFound.Set(3, errors.New("not found"), clk.Now())
+
+	mux := chi.NewMux()
+	admin.RegisterCacheRoutes(mux, orderCache, clk, notFound)
+
+	do := func(method, target string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(method, target, nil))
+		return rec
+	}
+
+	t.Run("Stats", func(t *testing.T) {
+		rec := do(http.MethodGet, "/cache/stats")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var stats cache.Stats
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&stats))
+		assert.Equal(t, cache.OrderCacheName, stats.Name)
+		assert.Equal(t, 1, stats.Entries)
+		assert.Equal(t, uint64(1), stats.Hits)
+		assert.Equal(t, uint64(1), stats.Misses)
+		assert.Equal(t, uint64(1), stats.Sets)
+	})
+
+	t.Run("InspectOrder", func(t *testing.T) {
+		rec := do(http.MethodGet, "/cache/orders/1")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var got struct {
+			Order   dto.OrderDTO `json:"order"`
+			Expired bool         `json:"expired"`
+		}
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&got))
+		assert.Equal(t, int64(1), got.Order.ID)
+		assert.Equal(t, 10, got.Order.ClientID)
+		assert.False(t, got.Expired)
+
+		// expired order is shown until the janitor removes it
+		clk.Advance(2 * time.Hour)
+		rec = do(http.MethodGet, "/cache/orders/1")
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&got))
+		assert.True(t, got.Expired)
+
+		// inspection is not a cache hit
+		assert.Equal(t, uint64(1), orderCache.Stats().Hits)
+
+		assert.Equal(t, http.StatusNotFound, do(http.MethodGet, "/cache/orders/2").Code)
+		assert.Equal(t, http.StatusBadRequest, do(http.MethodGet, "/cache/orders/abc").Code)
+	})
+
+	t.Run("Flush", func(t *testing.T) {
+		rec := do(http.MethodPost, "/cache/flush")
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"flushed": 2}`, rec.Body.String())
+
+		assert.Zero(t, orderCache.Len())
+		assert.Zero(t, notFound.Len())
+		assert.Equal(t, http.StatusNotFound, do(http.MethodGet, "/cache/orders/1").Code)
+	})
+}
@@ -0,0 +1,38 @@
+package mw
+
+import (
+	"context"
+	"net/textproto"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/metadata"
+)
+
+// forwardedHeaders are http headers the gateway passes to grpc metadata without prefix
+var forwardedHeaders = map[string]string{
+	textproto.CanonicalMIMEHeaderKey(IdempotencyKeyHeader): IdempotencyKeyHeader,
+	textproto.CanonicalMIMEHeaderKey(OperatorIDHeader):     OperatorIDHeader,
+}
+
+// HeaderMatcher forwards Idempotency-Key and X-Operator-Id http headers to grpc metadata
+func HeaderMatcher(key string) (string, bool) {
+	if header, ok := forwardedHeaders[textproto.CanonicalMIMEHeaderKey(key)]; ok {
+		return header, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+func metadataValue(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
@@ -0,0 +1,168 @@
+package mw
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Na322Pr/route256/internal/clock"
+	"github.com/Na322Pr/route256/internal/dto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	// IdempotencyKeyHeader is read from grpc metadata, http gateway forwards Idempotency-Key header into it
+	IdempotencyKeyHeader = "idempotency-key"
+	// IdempotencyReplayedHeader is set on responses replayed from storage
+	IdempotencyReplayedHeader = "idempotency-replayed"
+
+	maxIdempotencyKeyLen = 255
+)
+
+type IdempotencyStore interface {
+	// Reserve saves pending record unless the key holds an unexpired one, which is returned instead
+	Reserve(ctx context.Context, record dto.IdempotencyRecordDTO) (*dto.IdempotencyRecordDTO, error)
+	// Complete saves the response and keeps the record until expiresAt
+	Complete(ctx context.Context, key string, response []byte, expiresAt time.Time) error
+	Release(ctx context.Context, key string) error
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
+
+// Idempotency replays stored response for requests repeated with the same idempotency key.
+// Only listed methods are guarded, requests without key are passed through.
+// Key is reserved for lease while the request is in progress, so a key left pending by a crashed
+// replica is taken over after lease, completed response is kept for ttl.
+// Lease must outlast the longest request, otherwise it may be executed twice
+func Idempotency(
+	store IdempotencyStore,
+	ttl time.Duration,
+	lease time.Duration,
+	clk clock.Clock,
+	methods ...string,
+) grpc.UnaryServerInterceptor {
+	guarded := make(map[string]struct{}, len(methods))
+	for _, method := range methods {
+		guarded[method] = struct{}{}
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if _, ok := guarded[info.FullMethod]; !ok {
+			return handler(ctx, req)
+		}
+
+		key := metadataValue(ctx, IdempotencyKeyHeader)
+		if key == "" {
+			return handler(ctx, req)
+		}
+
+		if len(key) > maxIdempotencyKeyLen {
+			return nil, status.Errorf(codes.InvalidArgument, "idempotency key is longer than %d", maxIdempotencyKeyLen)
+		}
+
+		hash, err := requestHash(info.FullMethod, req)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		now := clk.Now()
+		existing, err := store.Reserve(ctx, dto.IdempotencyRecordDTO{
+			Key:         key,
+			RequestHash: hash,
+			CreatedAt:   now,
+			ExpiresAt:   now.Add(lease),
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		if existing != nil {
+			return replay(ctx, *existing, hash)
+		}
+
+		// the outcome is saved even if the client has gone away
+		storeCtx := context.WithoutCancel(ctx)
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			if releaseErr := store.Release(storeCtx, key); releaseErr != nil {
+				log.Printf("[interceptor.Idempotency] method: %s; release error: %s", info.FullMethod, releaseErr.Error())
+			}
+			return nil, err
+		}
+
+		payload, err := marshalResponse(resp)
+		if err == nil {
+			err = store.Complete(storeCtx, key, payload, clk.Now().Add(ttl))
+		}
+		if err != nil {
+			log.Printf("[interceptor.Idempotency] method: %s; complete error: %s", info.FullMethod, err.Error())
+		}
+
+		return resp, nil
+	}
+}
+
+func requestHash(method string, req any) (string, error) {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return "", fmt.Errorf("request %T is not a proto message", req)
+	}
+
+	payload, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	h.Write([]byte(method))
+	h.Write([]byte{0})
+	h.Write(payload)
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func replay(ctx context.Context, record dto.IdempotencyRecordDTO, hash string) (any, error) {
+	if record.RequestHash != hash {
+		return nil, status.Error(codes.InvalidArgument, "idempotency key is already used with another request")
+	}
+
+	if record.Response == nil {
+		return nil, status.Error(codes.Aborted, "request with this idempotency key is in progress")
+	}
+
+	var wrapped anypb.Any
+	if err := proto.Unmarshal(record.Response, &wrapped); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp, err := wrapped.UnmarshalNew()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs(IdempotencyReplayedHeader, "true"))
+
+	return resp, nil
+}
+
+func marshalResponse(resp any) ([]byte, error) {
+	msg, ok := resp.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("response %T is not a proto message", resp)
+	}
+
+	wrapped, err := anypb.New(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(wrapped)
+}
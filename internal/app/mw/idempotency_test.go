@@ -0,0 +1,150 @@
+package mw_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Na322Pr/route256/internal/app/mw"
+	"github.com/Na322Pr/route256/internal/clock"
+	"github.com/Na322Pr/route256/internal/repository/memory"
+	desc "github.com/Na322Pr/route256/pkg/pvz-service/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+var giveOutItemsInfo = &grpc.UnaryServerInfo{FullMethod: desc.PVZService_GiveOutItems_FullMethodName}
+
+func withKey(key string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(mw.IdempotencyKeyHeader, key))
+}
+
+type countingHandler struct {
+	calls int
+	err   error
+}
+
+func (h *countingHandler) handle(ctx context.Context, req any) (any, error) {
+	h.calls++
+	if h.err != nil {
+		return nil, h.err
+	}
+
+	r := req.(*desc.GiveOutItemsRequest)
+	return &desc.GiveOutItemsResponse{Order: &desc.Order{Id: r.GetOrderId(), Status: "pickedUp"}}, nil
+}
+
+func TestIdempotency_ReplaysResponse(t *testing.T) {
+	interceptor := mw.Idempotency(memory.NewIdempotencyStore(), time.Hour, time.Minute, clock.Real, desc.PVZService_GiveOutItems_FullMethodName)
+	handler := &countingHandler{}
+	req := &desc.GiveOutItemsRequest{OrderId: 1, Skus: []string{"phone"}}
+
+	first, err := interceptor(withKey("key-1"), req, giveOutItemsInfo, handler.handle)
+	require.NoError(t, err)
+
+	second, err := interceptor(withKey("key-1"), req, giveOutItemsInfo, handler.handle)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, handler.calls)
+	assert.True(t, proto.Equal(first.(proto.Message), second.(proto.Message)))
+
+	_, err = interceptor(withKey("key-2"), req, giveOutItemsInfo, handler.handle)
+	require.NoError(t, err)
+	assert.Equal(t, 2, handler.calls)
+}
+
+func TestIdempotency_RejectsDifferentPayload(t *testing.T) {
+	interceptor := mw.Idempotency(memory.NewIdempotencyStore(), time.Hour, time.Minute, clock.Real, desc.PVZService_GiveOutItems_FullMethodName)
+	handler := &countingHandler{}
+
+	_, err := interceptor(withKey("key-1"), &desc.GiveOutItemsRequest{OrderId: 1, Skus: []string{"phone"}}, giveOutItemsInfo, handler.handle)
+	require.NoError(t, err)
+
+	_, err = interceptor(withKey("key-1"), &desc.GiveOutItemsRequest{OrderId: 2, Skus: []string{"phone"}}, giveOutItemsInfo, handler.handle)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, 1, handler.calls)
+}
+
+func TestIdempotency_InProgress(t *testing.T) {
+	interceptor := mw.Idempotency(memory.NewIdempotencyStore(), time.Hour, time.Minute, clock.Real, desc.PVZService_GiveOutItems_FullMethodName)
+	req := &desc.GiveOutItemsRequest{OrderId: 1, Skus: []string{"phone"}}
+
+	var nestedErr error
+	_, err := interceptor(withKey("key-1"), req, giveOutItemsInfo, func(ctx context.Context, req any) (any, error) {
+		_, nestedErr = interceptor(withKey("key-1"), req, giveOutItemsInfo, (&countingHandler{}).handle)
+		return &desc.GiveOutItemsResponse{}, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, codes.Aborted, status.Code(nestedErr))
+}
+
+func TestIdempotency_ReleasesKeyOnError(t *testing.T) {
+	interceptor := mw.Idempotency(memory.NewIdempotencyStore(), time.Hour, time.Minute, clock.Real, desc.PVZService_GiveOutItems_FullMethodName)
+	handler := &countingHandler{err: errors.New("storage is down")}
+	req := &desc.GiveOutItemsRequest{OrderId: 1, Skus: []string{"phone"}}
+
+	_, err := interceptor(withKey("key-1"), req, giveOutItemsInfo, handler.handle)
+	require.Error(t, err)
+
+	handler.err = nil
+	resp, err := interceptor(withKey("key-1"), req, giveOutItemsInfo, handler.handle)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), resp.(*desc.GiveOutItemsResponse).GetOrder().GetId())
+	assert.Equal(t, 2, handler.calls)
+}
+
+func TestIdempotency_TakesOverExpiredLease(t *testing.T) {
+	now := time.Date(2030, time.January, 1, 12, 0, 0, 0, time.UTC)
+	clk := clock.NewFake(now)
+	store := memory.NewIdempotencyStore()
+	interceptor := mw.Idempotency(store, time.Hour, time.Minute, clk, desc.PVZService_GiveOutItems_FullMethodName)
+	handler := &countingHandler{}
+	req := &desc.GiveOutItemsRequest{OrderId: 1, Skus: []string{"phone"}}
+
+	// the replica that reserved the key crashed before completing it
+	_, err := interceptor(withKey("key-1"), req, giveOutItemsInfo, func(ctx context.Context, req any) (any, error) {
+		return nil, nil
+	})
+	require.NoError(t, err)
+
+	_, err = interceptor(withKey("key-1"), req, giveOutItemsInfo, handler.handle)
+	assert.Equal(t, codes.Aborted, status.Code(err))
+
+	clk.Advance(2 * time.Minute)
+	_, err = interceptor(withKey("key-1"), req, giveOutItemsInfo, handler.handle)
+	require.NoError(t, err)
+	assert.Equal(t, 1, handler.calls)
+
+	// completed response outlives the lease
+	clk.Advance(30 * time.Minute)
+	_, err = interceptor(withKey("key-1"), req, giveOutItemsInfo, handler.handle)
+	require.NoError(t, err)
+	assert.Equal(t, 1, handler.calls)
+}
+
+func TestIdempotency_PassThrough(t *testing.T) {
+	interceptor := mw.Idempotency(memory.NewIdempotencyStore(), time.Hour, time.Minute, clock.Real, desc.PVZService_GiveOutItems_FullMethodName)
+	handler := &countingHandler{}
+	req := &desc.GiveOutItemsRequest{OrderId: 1, Skus: []string{"phone"}}
+
+	// no key
+	_, err := interceptor(context.Background(), req, giveOutItemsInfo, handler.handle)
+	require.NoError(t, err)
+	_, err = interceptor(context.Background(), req, giveOutItemsInfo, handler.handle)
+	require.NoError(t, err)
+
+	// method is not guarded
+	otherInfo := &grpc.UnaryServerInfo{FullMethod: desc.PVZService_OrderList_FullMethodName}
+	_, err = interceptor(withKey("key-1"), req, otherInfo, handler.handle)
+	require.NoError(t, err)
+	_, err = interceptor(withKey("key-1"), req, otherInfo, handler.handle)
+	require.NoError(t, err)
+
+	assert.Equal(t, 4, handler.calls)
+}
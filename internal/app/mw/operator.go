@@ -0,0 +1,117 @@
+package mw
+
+import (
+	"context"
+	"log"
+	"net"
+	"path"
+	"strings"
+
+	"github.com/Na322Pr/route256/internal/actor"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// OperatorIDHeader identifies the operator, http gateway forwards X-Operator-Id header into it
+	OperatorIDHeader = "x-operator-id"
+
+	// forwardedForHeader is set by http gateway to the address of http client
+	forwardedForHeader = "x-forwarded-for"
+
+	maxOperatorIDLen = 255
+)
+
+// Operator puts the calling operator into context for audit log.
+// Listed methods without operator id are attributed to defaultID,
+// they are rejected when defaultID is empty. Operators listed in supervisors
+// get supervisor role, the default operator never does
+func Operator(defaultID string, supervisors []string, methods ...string) grpc.UnaryServerInterceptor {
+	required := make(map[string]struct{}, len(methods))
+	for _, method := range methods {
+		required[method] = struct{}{}
+	}
+
+	roles := make(map[string]string, len(supervisors))
+	for _, operatorID := range supervisors {
+		roles[operatorID] = actor.RoleSupervisor
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		operatorID := metadataValue(ctx, OperatorIDHeader)
+		role := roles[operatorID]
+		if operatorID == "" {
+			if _, ok := required[info.FullMethod]; !ok {
+				return handler(ctx, req)
+			}
+
+			if defaultID == "" {
+				return nil, status.Error(codes.Unauthenticated, "operator id is required")
+			}
+
+			log.Printf("[interceptor.Operator] method: %s; called without operator id, default %q is used", info.FullMethod, defaultID)
+			operatorID, role = defaultID, ""
+		}
+
+		if len(operatorID) > maxOperatorIDLen {
+			return nil, status.Errorf(codes.InvalidArgument, "operator id is longer than %d", maxOperatorIDLen)
+		}
+
+		ctx = actor.NewContext(ctx, actor.Actor{
+			OperatorID: operatorID,
+			Role:       role,
+			Method:     path.Base(info.FullMethod),
+			ClientIP:   clientIP(ctx),
+		})
+
+		return handler(ctx, req)
+	}
+}
+
+// clientIP prefers the original http client over the gateway connected to grpc server
+func clientIP(ctx context.Context) string {
+	if forwarded := metadataValue(ctx, forwardedForHeader); forwarded != "" {
+		first, _, _ := strings.Cut(forwarded, ",")
+		return strings.TrimSpace(first)
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+
+	return host
+}
+
+// Supervisor rejects listed methods unless the operator has supervisor role,
+// it must be chained after Operator
+func Supervisor(methods ...string) grpc.UnaryServerInterceptor {
+	restricted := make(map[string]struct{}, len(methods))
+	for _, method := range methods {
+		restricted[method] = struct{}{}
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if _, ok := restricted[info.FullMethod]; !ok {
+			return handler(ctx, req)
+		}
+
+		a, ok := actor.FromContext(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "operator id is required")
+		}
+
+		if a.Role != actor.RoleSupervisor {
+			return nil, status.Error(codes.PermissionDenied, "supervisor role is required")
+		}
+
+		return handler(ctx, req)
+	}
+}
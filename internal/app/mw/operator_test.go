@@ -0,0 +1,158 @@
+package mw_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/Na322Pr/route256/internal/actor"
+	"github.com/Na322Pr/route256/internal/app/mw"
+	desc "github.com/Na322Pr/route256/pkg/pvz-service/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestOperator(t *testing.T) {
+	interceptor := mw.Operator("", []string{"operator-1"}, desc.PVZService_GiveOutItems_FullMethodName)
+	req := &desc.GiveOutItemsRequest{OrderId: 1}
+
+	var got actor.Actor
+	var found bool
+	handler := func(ctx context.Context, req any) (any, error) {
+		got, found = actor.FromContext(ctx)
+		return &desc.GiveOutItemsResponse{}, nil
+	}
+
+	t.Run("FromGateway", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			mw.OperatorIDHeader, "operator-1",
+			"x-forwarded-for", "10.0.0.7, 127.0.0.1",
+		))
+
+		_, err := interceptor(ctx, req, giveOutItemsInfo, handler)
+		require.NoError(t, err)
+
+		require.True(t, found)
+		assert.Equal(t, actor.Actor{
+			OperatorID: "operator-1",
+			Role:       actor.RoleSupervisor,
+			Method:     "GiveOutItems",
+			ClientIP:   "10.0.0.7",
+		}, got)
+	})
+
+	t.Run("FromPeer", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(mw.OperatorIDHeader, "operator-2"))
+		ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(192, 168, 1, 5), Port: 5555}})
+
+		_, err := interceptor(ctx, req, giveOutItemsInfo, handler)
+		require.NoError(t, err)
+
+		assert.Equal(t, "192.168.1.5", got.ClientIP)
+		assert.Empty(t, got.Role)
+	})
+
+	t.Run("RoleHeaderIgnored", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			mw.OperatorIDHeader, "operator-2",
+			"x-operator-role", actor.RoleSupervisor,
+		))
+
+		_, err := interceptor(ctx, req, giveOutItemsInfo, handler)
+		require.NoError(t, err)
+		assert.Empty(t, got.Role)
+	})
+
+	t.Run("ErrorOperatorRequired", func(t *testing.T) {
+		_, err := interceptor(context.Background(), req, giveOutItemsInfo, handler)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("DefaultOperator", func(t *testing.T) {
+		interceptor := mw.Operator("legacy-client", []string{"legacy-client"}, desc.PVZService_GiveOutItems_FullMethodName)
+
+		_, err := interceptor(context.Background(), req, giveOutItemsInfo, handler)
+		require.NoError(t, err)
+
+		require.True(t, found)
+		assert.Equal(t, "legacy-client", got.OperatorID)
+		assert.Empty(t, got.Role)
+	})
+
+	t.Run("NotRequired", func(t *testing.T) {
+		found = true
+		info := &grpc.UnaryServerInfo{FullMethod: desc.PVZService_OrderList_FullMethodName}
+
+		_, err := interceptor(context.Background(), &desc.OrderListRequest{}, info, handler)
+		require.NoError(t, err)
+		assert.False(t, found)
+	})
+}
+
+func TestSupervisor(t *testing.T) {
+	interceptor := mw.Supervisor(desc.PVZService_ApproveRefund_FullMethodName)
+	info := &grpc.UnaryServerInfo{FullMethod: desc.PVZService_ApproveRefund_FullMethodName}
+	req := &desc.ApproveRefundRequest{OrderId: 1}
+
+	handler := func(ctx context.Context, req any) (any, error) {
+		return &desc.ApproveRefundResponse{}, nil
+	}
+
+	t.Run("Supervisor", func(t *testing.T) {
+		ctx := actor.NewContext(context.Background(), actor.Actor{OperatorID: "operator-1", Role: actor.RoleSupervisor})
+
+		_, err := interceptor(ctx, req, info, handler)
+		assert.NoError(t, err)
+	})
+
+	t.Run("ErrorNotSupervisor", func(t *testing.T) {
+		ctx := actor.NewContext(context.Background(), actor.Actor{OperatorID: "operator-1", Role: "operator"})
+
+		_, err := interceptor(ctx, req, info, handler)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("ErrorNoOperator", func(t *testing.T) {
+		_, err := interceptor(context.Background(), req, info, handler)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("ErrorRoleHeader", func(t *testing.T) {
+		operator := mw.Operator("", []string{"supervisor-1"})
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			mw.OperatorIDHeader, "operator-1",
+			"x-operator-role", actor.RoleSupervisor,
+		))
+
+		_, err := operator(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+			return interceptor(ctx, req, info, handler)
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("NotRestricted", func(t *testing.T) {
+		_, err := interceptor(context.Background(), &desc.GiveOutItemsRequest{OrderId: 1}, giveOutItemsInfo, handler)
+		assert.NoError(t, err)
+	})
+}
+
+func TestHeaderMatcher(t *testing.T) {
+	key, ok := mw.HeaderMatcher("Idempotency-Key")
+	assert.True(t, ok)
+	assert.Equal(t, mw.IdempotencyKeyHeader, key)
+
+	key, ok = mw.HeaderMatcher("X-Operator-Id")
+	assert.True(t, ok)
+	assert.Equal(t, mw.OperatorIDHeader, key)
+
+	_, ok = mw.HeaderMatcher("X-Operator-Role")
+	assert.False(t, ok)
+
+	_, ok = mw.HeaderMatcher("X-Unknown")
+	assert.False(t, ok)
+}
@@ -0,0 +1,22 @@
+package pvz_service
+
+import (
+	"context"
+
+	desc "github.com/Na322Pr/route256/pkg/pvz-service/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Implementation) ApproveRefund(ctx context.Context, req *desc.ApproveRefundRequest) (*desc.ApproveRefundResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err := s.usecase.ApproveRefund(ctx, req.OrderId, req.Reject)
+	if err != nil {
+		return nil, updateError(err)
+	}
+
+	return &desc.ApproveRefundResponse{}, nil
+}
@@ -0,0 +1,22 @@
+package pvz_service
+
+import (
+	"context"
+
+	desc "github.com/Na322Pr/route256/pkg/pvz-service/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Implementation) ArchiveOrders(ctx context.Context, req *desc.ArchiveOrdersRequest) (*desc.ArchiveOrdersResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	archived, err := s.usecase.ArchiveOrders(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &desc.ArchiveOrdersResponse{Archived: int64(archived)}, nil
+}
@@ -0,0 +1,31 @@
+package pvz_service
+
+import (
+	"context"
+
+	desc "github.com/Na322Pr/route256/pkg/pvz-service/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Implementation) CheckoutClient(ctx context.Context, req *desc.CheckoutClientRequest) (*desc.CheckoutClientResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if len(req.KeptOrdersIds)+len(req.RefusedOrdersIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no order IDs")
+	}
+
+	orders, err := s.usecase.CheckoutClient(ctx, req.KeptOrdersIds, req.RefusedOrdersIds)
+	if err != nil {
+		return nil, updateError(err)
+	}
+
+	respOrders := make([]*desc.Order, 0, len(orders.Orders))
+	for _, order := range orders.Orders {
+		respOrders = append(respOrders, orderToProto(order))
+	}
+
+	return &desc.CheckoutClientResponse{Orders: respOrders}, nil
+}
@@ -15,7 +15,7 @@ func (s *Implementation) GiveOutClient(ctx context.Context, req *desc.GiveOutCli
 
 	err := s.usecase.GiveOrderToClient(ctx, req.OrdersIds)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, updateError(err)
 	}
 
 	return &desc.GiveOutClientResponse{}, nil
@@ -0,0 +1,22 @@
+package pvz_service
+
+import (
+	"context"
+
+	desc "github.com/Na322Pr/route256/pkg/pvz-service/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Implementation) GiveOutItems(ctx context.Context, req *desc.GiveOutItemsRequest) (*desc.GiveOutItemsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	order, err := s.usecase.GiveOutOrderItems(ctx, req.OrderId, req.Skus)
+	if err != nil {
+		return nil, updateError(err)
+	}
+
+	return &desc.GiveOutItemsResponse{Order: orderToProto(*order)}, nil
+}
@@ -0,0 +1,35 @@
+package pvz_service
+
+import (
+	"context"
+
+	desc "github.com/Na322Pr/route256/pkg/pvz-service/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Implementation) ListFlaggedClients(ctx context.Context, req *desc.ListFlaggedClientsRequest) (*desc.ListFlaggedClientsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	clients, err := s.usecase.ListFlaggedClients(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	respClients := make([]*desc.FlaggedClient, 0, len(clients))
+
+	for _, client := range clients {
+		respClients = append(respClients, &desc.FlaggedClient{
+			ClientId:         int32(client.ClientID),
+			Issued:           int32(client.Issued),
+			Refunds:          int32(client.Refunds),
+			HighValueRefunds: int32(client.HighValueRefunds),
+			RefundRatio:      client.RefundRatio,
+			Reasons:          client.Reasons,
+		})
+	}
+
+	return &desc.ListFlaggedClientsResponse{Clients: respClients}, nil
+}
@@ -3,6 +3,7 @@ package pvz_service
 import (
 	"context"
 
+	"github.com/Na322Pr/route256/internal/dto"
 	desc "github.com/Na322Pr/route256/pkg/pvz-service/v1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -31,8 +32,41 @@ func (s *Implementation) OrderList(ctx context.Context, req *desc.OrderListReque
 			Weight:     int32(order.Weight),
 			Packages:   order.Packages,
 			PickUpTime: timestamppb.New(order.StoreUntil),
+			Items:      orderItemsToProto(order.Items),
+			PaidAmount: int32(order.PaidAmount),
 		})
 	}
 
 	return &desc.OrderListResponse{Orders: respOrderList}, nil
 }
+
+func orderToProto(order dto.OrderDTO) *desc.Order {
+	return &desc.Order{
+		Id:         order.ID,
+		ClientId:   int32(order.ClientID),
+		StoreUntil: timestamppb.New(order.StoreUntil),
+		Status:     order.Status,
+		Cost:       int32(order.Cost),
+		Weight:     int32(order.Weight),
+		Packages:   order.Packages,
+		PickUpTime: timestamppb.New(order.PickUpTime.Time),
+		Items:      orderItemsToProto(order.Items),
+		PaidAmount: int32(order.PaidAmount),
+	}
+}
+
+func orderItemsToProto(items []dto.OrderItemDTO) []*desc.OrderItem {
+	respItems := make([]*desc.OrderItem, 0, len(items))
+
+	for _, item := range items {
+		respItems = append(respItems, &desc.OrderItem{
+			Sku:       item.SKU,
+			Name:      item.Name,
+			Quantity:  int32(item.Quantity),
+			UnitPrice: int32(item.UnitPrice),
+			Status:    item.Status,
+		})
+	}
+
+	return respItems
+}
@@ -0,0 +1,55 @@
+package pvz_service
+
+import (
+	"context"
+
+	"github.com/Na322Pr/route256/internal/dto"
+	desc "github.com/Na322Pr/route256/pkg/pvz-service/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Implementation) QueryAuditLog(ctx context.Context, req *desc.QueryAuditLogRequest) (*desc.QueryAuditLogResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	filter := dto.AuditLogFilterDTO{
+		OperatorID: req.GetOperatorId(),
+		Method:     req.GetMethod(),
+		OrderID:    req.GetOrderId(),
+		Limit:      int(req.GetLimit()),
+		Offset:     int(req.GetOffset()),
+	}
+
+	if req.From != nil {
+		filter.From = req.GetFrom().AsTime()
+	}
+
+	if req.To != nil {
+		filter.To = req.GetTo().AsTime()
+	}
+
+	entries, err := s.usecase.QueryAuditLog(ctx, filter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	respEntries := make([]*desc.AuditLogEntry, 0, len(entries.Entries))
+
+	for _, entry := range entries.Entries {
+		respEntries = append(respEntries, &desc.AuditLogEntry{
+			Id:         entry.ID,
+			OperatorId: entry.OperatorID,
+			Method:     entry.Method,
+			OrderIds:   entry.OrderIDs,
+			Before:     string(entry.Before),
+			After:      string(entry.After),
+			ClientIp:   entry.ClientIP,
+			CreatedAt:  timestamppb.New(entry.CreatedAt),
+		})
+	}
+
+	return &desc.QueryAuditLogResponse{Entries: respEntries}, nil
+}
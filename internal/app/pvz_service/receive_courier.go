@@ -21,6 +21,16 @@ func (s *Implementation) ReceiveCourier(ctx context.Context, req *desc.ReceiveCo
 		Cost:       int(req.Cost),
 		Weight:     int(req.Weight),
 		Packages:   req.Packages,
+		Items:      make([]dto.AddOrderItem, 0, len(req.Items)),
+	}
+
+	for _, item := range req.Items {
+		addOrderDTO.Items = append(addOrderDTO.Items, dto.AddOrderItem{
+			SKU:       item.Sku,
+			Name:      item.Name,
+			Quantity:  int(item.Quantity),
+			UnitPrice: int(item.UnitPrice),
+		})
 	}
 
 	err := s.usecase.ReceiveOrderFromCourier(ctx, addOrderDTO)
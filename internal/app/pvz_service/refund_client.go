@@ -3,6 +3,7 @@ package pvz_service
 import (
 	"context"
 
+	"github.com/Na322Pr/route256/internal/dto"
 	desc "github.com/Na322Pr/route256/pkg/pvz-service/v1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -13,10 +14,23 @@ func (s *Implementation) RefundClient(ctx context.Context, req *desc.RefundClien
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	err := s.usecase.GetRefundFromСlient(ctx, int(req.ClientId), req.OrderId)
+	refundOrderDTO := dto.RefundOrder{
+		OrderID:             req.OrderId,
+		ClientID:            int(req.ClientId),
+		Reason:              req.Reason,
+		Comment:             req.Comment,
+		InspectionCondition: req.InspectionCondition,
+		InspectionOutcome:   req.InspectionOutcome,
+		SKUs:                req.Skus,
+	}
+
+	order, err := s.usecase.GetRefundFromСlient(ctx, refundOrderDTO)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, updateError(err)
 	}
 
-	return &desc.RefundClientResponse{}, nil
+	return &desc.RefundClientResponse{
+		Status:       order.Status,
+		RefundAmount: int32(order.RefundAmount),
+	}, nil
 }
@@ -14,7 +14,7 @@ func (s *Implementation) RefundList(ctx context.Context, req *desc.RefundListReq
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	orders, err := s.usecase.RefundList(ctx, int(*req.Limit), int(*req.Offset))
+	orders, err := s.usecase.RefundList(ctx, req.Filter, int(req.GetLimit()), int(req.GetOffset()))
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -31,6 +31,15 @@ func (s *Implementation) RefundList(ctx context.Context, req *desc.RefundListReq
 			Weight:     int32(order.Weight),
 			Packages:   order.Packages,
 			PickUpTime: timestamppb.New(order.StoreUntil),
+
+			RefundReason:        order.RefundReason,
+			RefundComment:       order.RefundComment,
+			InspectionCondition: order.InspectionCondition,
+			InspectionOutcome:   order.InspectionOutcome,
+
+			Items:        orderItemsToProto(order.Items),
+			PaidAmount:   int32(order.PaidAmount),
+			RefundAmount: int32(order.RefundAmount),
 		})
 	}
 
@@ -15,7 +15,7 @@ func (s *Implementation) ReturnCourier(ctx context.Context, req *desc.ReturnCour
 
 	err := s.usecase.ReturnOrderToCourier(ctx, req.OrderId)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, updateError(err)
 	}
 
 	return &desc.ReturnCourierResponse{}, nil
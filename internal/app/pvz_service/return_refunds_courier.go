@@ -0,0 +1,32 @@
+package pvz_service
+
+import (
+	"context"
+
+	desc "github.com/Na322Pr/route256/pkg/pvz-service/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Implementation) ReturnRefundsToCourier(ctx context.Context, req *desc.ReturnRefundsToCourierRequest) (*desc.ReturnRefundsToCourierResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	handover, err := s.usecase.ReturnRefundsToCourier(ctx, req.Courier, req.OrdersIds)
+	if err != nil {
+		return nil, updateError(err)
+	}
+
+	ordersIDs := make([]int64, 0, len(handover.Orders))
+	for _, order := range handover.Orders {
+		ordersIDs = append(ordersIDs, order.ID)
+	}
+
+	return &desc.ReturnRefundsToCourierResponse{
+		HandoverId:   handover.ID,
+		HandedOverAt: timestamppb.New(handover.HandedOverAt),
+		OrdersIds:    ordersIDs,
+	}, nil
+}
@@ -1,10 +1,35 @@
 package pvz_service
 
 import (
+	"errors"
+
+	"github.com/Na322Pr/route256/internal/domain"
 	"github.com/Na322Pr/route256/internal/usecase"
 	desc "github.com/Na322Pr/route256/pkg/pvz-service/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// MutatingMethods change orders, retries of these are deduplicated by idempotency key
+var MutatingMethods = []string{
+	desc.PVZService_ReceiveCourier_FullMethodName,
+	desc.PVZService_ReturnCourier_FullMethodName,
+	desc.PVZService_GiveOutClient_FullMethodName,
+	desc.PVZService_CheckoutClient_FullMethodName,
+	desc.PVZService_GiveOutItems_FullMethodName,
+	desc.PVZService_RefundClient_FullMethodName,
+	desc.PVZService_ReturnRefundsToCourier_FullMethodName,
+	desc.PVZService_ApproveRefund_FullMethodName,
+	desc.PVZService_ArchiveOrders_FullMethodName,
+}
+
+// SupervisorMethods are allowed to operators with supervisor role only
+var SupervisorMethods = []string{
+	desc.PVZService_ApproveRefund_FullMethodName,
+	desc.PVZService_QueryAuditLog_FullMethodName,
+	desc.PVZService_ArchiveOrders_FullMethodName,
+}
+
 type Implementation struct {
 	usecase usecase.OrderUseCase
 
@@ -14,3 +39,17 @@ type Implementation struct {
 func NewImplementation(usecase usecase.OrderUseCase) *Implementation {
 	return &Implementation{usecase: usecase}
 }
+
+// updateError tells clients to retry when the order was changed by another operator,
+// archived orders are never changed
+func updateError(err error) error {
+	if errors.Is(err, domain.ErrConcurrentModification) {
+		return status.Error(codes.Aborted, err.Error())
+	}
+
+	if errors.Is(err, usecase.ErrOrderArchived) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
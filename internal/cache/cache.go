@@ -1,39 +1,414 @@
 package cache
 
 import (
+	"container/list"
+	"context"
 	"sync"
 	"time"
+
+	"github.com/Na322Pr/route256/internal/clock"
+	"github.com/Na322Pr/route256/internal/metrics"
 )
 
+// Option configures CacheClient limits and callbacks
+type Option[K comparable, V any] func(*CacheClient[K, V])
+
+// WithMaxEntries bounds the number of entries, least recently used ones are evicted first
+func WithMaxEntries[K comparable, V any](maxEntries int) Option[K, V] {
+	return func(c *CacheClient[K, V]) {
+		c.maxEntries = maxEntries
+	}
+}
+
+// WithMaxBytes bounds the total size of entries estimated by sizeOf
+func WithMaxBytes[K comparable, V any](maxBytes int64, sizeOf func(K, V) int64) Option[K, V] {
+	return func(c *CacheClient[K, V]) {
+		c.maxBytes = maxBytes
+		c.sizeOf = sizeOf
+	}
+}
+
+// WithOnEvict sets callback called for entries removed by the cache itself,
+// either over limits or expired. It is not called for Delete and overwrites
+func WithOnEvict[K comparable, V any](onEvict func(K, V)) Option[K, V] {
+	return func(c *CacheClient[K, V]) {
+		c.onEvict = onEvict
+	}
+}
+
+// WithName labels cache metrics, unnamed cache is not reported to prometheus
+func WithName[K comparable, V any](name string) Option[K, V] {
+	return func(c *CacheClient[K, V]) {
+		c.name = name
+	}
+}
+
+// WithClock replaces system clock used to expire entries on lookup and by janitor
+func WithClock[K comparable, V any](clk clock.Clock) Option[K, V] {
+	return func(c *CacheClient[K, V]) {
+		c.clock = clk
+	}
+}
+
+// Stats are counted since the cache was created
+type Stats struct {
+	Name      string `json:"name"`
+	Entries   int    `json:"entries"`
+	Bytes     int64  `json:"bytes"`
+	Hits      uint64 `json:"hits"`
+	Misses    uint64 `json:"misses"`
+	Sets      uint64 `json:"sets"`
+	Evictions uint64 `json:"evictions"`
+}
+
+// Entry is a cache entry as saved to snapshot
+type Entry[K comparable, V any] struct {
+	Key       K
+	Value     V
+	ExpiresAt time.Time
+}
+
+type entry[K comparable, V any] struct {
+	key    K
+	cached *Cached[V]
+	size   int64
+}
+
 type CacheClient[K comparable, V any] struct {
 	ttl  time.Duration
-	lock sync.RWMutex
-	data map[K]*Cached[V]
+	lock sync.Mutex
+	data map[K]*list.Element
+	// lru holds entries from most to least recently used
+	lru *list.List
+
+	maxEntries int
+	maxBytes   int64
+	sizeOf     func(K, V) int64
+	bytes      int64
+	onEvict    func(K, V)
+
+	clock clock.Clock
+
+	name  string
+	stats Stats
+	// reported is the number of entries last added to the gauge
+	reported int
 }
 
-func NewCacheClient[K comparable, V any](ttl time.Duration) *CacheClient[K, V] {
-	return &CacheClient[K, V]{
-		ttl:  ttl,
-		data: make(map[K]*Cached[V]),
+func NewCacheClient[K comparable, V any](ttl time.Duration, opts ...Option[K, V]) *CacheClient[K, V] {
+	c := &CacheClient[K, V]{
+		ttl:   ttl,
+		data:  make(map[K]*list.Element),
+		lru:   list.New(),
+		clock: clock.Real,
 	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
 }
 
 func (c *CacheClient[K, V]) Get(key K) (V, bool) {
-	c.lock.RLock()
-	v, ok := c.data[key]
-	c.lock.RUnlock()
+	var zero V
+
+	c.lock.Lock()
+
+	el, ok := c.data[key]
+	if !ok {
+		c.stats.Misses++
+		c.lock.Unlock()
+
+		c.reportMiss()
+		return zero, false
+	}
 
-	if ok && !v.Expired(time.Now()) {
-		return v.Value(), true
+	e := el.Value.(*entry[K, V])
+	if e.cached.Expired(c.clock.Now()) {
+		c.stats.Misses++
+		c.stats.Evictions++
+		c.remove(el)
+		c.reportEntries()
+		c.lock.Unlock()
+
+		c.reportMiss()
+		c.notifyEvicted([]*entry[K, V]{e})
+		return zero, false
+	}
+
+	c.stats.Hits++
+	c.lru.MoveToFront(el)
+	c.lock.Unlock()
+
+	if c.name != "" {
+		metrics.IncCacheHits(c.name)
 	}
 
-	return (&Cached[V]{}).Value(), false
+	return e.cached.Value(), true
+}
+
+// Peek returns entry value and expiration time without marking it as used,
+// expired entry not yet removed is returned as well
+func (c *CacheClient[K, V]) Peek(key K) (V, time.Time, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	el, ok := c.data[key]
+	if !ok {
+		var zero V
+		return zero, time.Time{}, false
+	}
+
+	e := el.Value.(*entry[K, V])
+	return e.cached.Value(), e.cached.ExpiredAt(), true
 }
 
 func (c *CacheClient[K, V]) Set(key K, value V, now time.Time) {
-	wrapped := NewCached(time.Now().Add(c.ttl), value)
+	wrapped := NewCached(now.Add(c.ttl), value)
+
+	var size int64
+	if c.sizeOf != nil {
+		size = c.sizeOf(key, value)
+	}
+
+	c.lock.Lock()
+
+	c.put(key, wrapped, size)
+	c.stats.Sets++
+	evicted := c.evictOverLimits()
+	c.reportEntries()
+	c.lock.Unlock()
+
+	if c.name != "" {
+		metrics.IncCacheSets(c.name)
+	}
+
+	c.notifyEvicted(evicted)
+}
+
+func (c *CacheClient[K, V]) Delete(key K) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if el, ok := c.data[key]; ok {
+		c.remove(el)
+		c.reportEntries()
+	}
+}
+
+// DeleteFunc removes entries with keys matched by match and returns their number
+func (c *CacheClient[K, V]) DeleteFunc(match func(K) bool) int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	n := 0
+	for key, el := range c.data {
+		if match(key) {
+			c.remove(el)
+			n++
+		}
+	}
+	c.reportEntries()
+
+	return n
+}
+
+// Flush removes all entries and returns their number, OnEvict callback is not called
+func (c *CacheClient[K, V]) Flush() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
 
+	n := c.lru.Len()
+	c.data = make(map[K]*list.Element)
+	c.lru.Init()
+	c.bytes = 0
+	c.reportEntries()
+
+	return n
+}
+
+func (c *CacheClient[K, V]) Stats() Stats {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	stats := c.stats
+	stats.Name = c.name
+	stats.Entries = c.lru.Len()
+	stats.Bytes = c.bytes
+
+	return stats
+}
+
+// Len returns the number of stored entries including expired ones not yet removed
+func (c *CacheClient[K, V]) Len() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.lru.Len()
+}
+
+// DeleteExpired removes entries expired at now and returns their number
+func (c *CacheClient[K, V]) DeleteExpired(now time.Time) int {
+	c.lock.Lock()
+
+	var evicted []*entry[K, V]
+	for el := c.lru.Back(); el != nil; {
+		prev := el.Prev()
+
+		if e := el.Value.(*entry[K, V]); e.cached.Expired(now) {
+			c.remove(el)
+			evicted = append(evicted, e)
+		}
+
+		el = prev
+	}
+
+	c.stats.Evictions += uint64(len(evicted))
+	c.reportEntries()
+	c.lock.Unlock()
+
+	c.notifyEvicted(evicted)
+	return len(evicted)
+}
+
+// RunJanitor removes expired entries every interval until ctx is done
+func (c *CacheClient[K, V]) RunJanitor(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.DeleteExpired(c.clock.Now())
+		}
+	}
+}
+
+// Entries returns entries not expired at now from least to most recently used,
+// so restoring them in this order keeps recency
+func (c *CacheClient[K, V]) Entries(now time.Time) []Entry[K, V] {
 	c.lock.Lock()
-	c.data[key] = wrapped
+	defer c.lock.Unlock()
+
+	entries := make([]Entry[K, V], 0, c.lru.Len())
+	for el := c.lru.Back(); el != nil; el = el.Prev() {
+		e := el.Value.(*entry[K, V])
+		if e.cached.Expired(now) {
+			continue
+		}
+
+		entries = append(entries, Entry[K, V]{
+			Key:       e.key,
+			Value:     e.cached.Value(),
+			ExpiresAt: e.cached.ExpiredAt(),
+		})
+	}
+
+	return entries
+}
+
+// Restore puts entries keeping their expiration time, entries expired at now are skipped.
+// It returns the number of restored entries, some of them may be evicted over limits right away
+func (c *CacheClient[K, V]) Restore(entries []Entry[K, V], now time.Time) int {
+	c.lock.Lock()
+
+	n := 0
+	for _, e := range entries {
+		wrapped := NewCached(e.ExpiresAt, e.Value)
+		if wrapped.Expired(now) {
+			continue
+		}
+
+		var size int64
+		if c.sizeOf != nil {
+			size = c.sizeOf(e.Key, e.Value)
+		}
+
+		c.put(e.Key, wrapped, size)
+		n++
+	}
+
+	evicted := c.evictOverLimits()
+	c.reportEntries()
 	c.lock.Unlock()
+
+	c.notifyEvicted(evicted)
+	return n
+}
+
+// put stores entry as most recently used, lock must be held
+func (c *CacheClient[K, V]) put(key K, wrapped *Cached[V], size int64) {
+	if el, ok := c.data[key]; ok {
+		e := el.Value.(*entry[K, V])
+		c.bytes += size - e.size
+		e.cached, e.size = wrapped, size
+		c.lru.MoveToFront(el)
+		return
+	}
+
+	c.data[key] = c.lru.PushFront(&entry[K, V]{key: key, cached: wrapped, size: size})
+	c.bytes += size
+}
+
+// evictOverLimits drops least recently used entries until limits are met, lock must be held
+func (c *CacheClient[K, V]) evictOverLimits() []*entry[K, V] {
+	var evicted []*entry[K, V]
+
+	for c.lru.Len() > 0 && c.overLimits() {
+		el := c.lru.Back()
+		c.remove(el)
+		evicted = append(evicted, el.Value.(*entry[K, V]))
+	}
+
+	c.stats.Evictions += uint64(len(evicted))
+	return evicted
+}
+
+func (c *CacheClient[K, V]) overLimits() bool {
+	return (c.maxEntries > 0 && c.lru.Len() > c.maxEntries) ||
+		(c.maxBytes > 0 && c.bytes > c.maxBytes)
+}
+
+func (c *CacheClient[K, V]) remove(el *list.Element) {
+	e := c.lru.Remove(el).(*entry[K, V])
+	delete(c.data, e.key)
+	c.bytes -= e.size
+}
+
+// notifyEvicted reports evicted entries and runs callback outside of the lock, so it may use the cache
+func (c *CacheClient[K, V]) notifyEvicted(evicted []*entry[K, V]) {
+	if len(evicted) == 0 {
+		return
+	}
+
+	if c.name != "" {
+		metrics.AddCacheEvictions(len(evicted), c.name)
+	}
+
+	if c.onEvict == nil {
+		return
+	}
+
+	for _, e := range evicted {
+		c.onEvict(e.key, e.cached.Value())
+	}
+}
+
+func (c *CacheClient[K, V]) reportMiss() {
+	if c.name != "" {
+		metrics.IncCacheMisses(c.name)
+	}
+}
+
+// reportEntries updates size gauge by the change since last report, lock must be held
+func (c *CacheClient[K, V]) reportEntries() {
+	if c.name == "" || c.lru.Len() == c.reported {
+		return
+	}
+
+	metrics.AddCacheEntries(c.lru.Len()-c.reported, c.name)
+	c.reported = c.lru.Len()
 }
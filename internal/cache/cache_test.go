@@ -0,0 +1,291 @@
+package cache_test
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Na322Pr/route256/internal/cache"
+	"github.com/Na322Pr/route256/internal/clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheClient_LRUEviction(t *testing.T) {
+	var evicted []string
+	c := cache.NewCacheClient(time.Hour,
+		cache.WithMaxEntries[string, int](2),
+		cache.WithOnEvict(func(key string, _ int) { evicted = append(evicted, key) }),
+	)
+
+	c.Set("a", 1, time.Now())
+	c.Set("b", 2, time.Now())
+
+	// a becomes most recently used, so b is evicted
+	_, ok := c.Get("a")
+	require.True(t, ok)
+
+	c.Set("c", 3, time.Now())
+
+	_, ok = c.Get("b")
+	assert.False(t, ok)
+	assert.Equal(t, []string{"b"}, evicted)
+	assert.Equal(t, 2, c.Len())
+
+	// overwrite does not grow the cache
+	c.Set("a", 10, time.Now())
+	v, ok := c.Get("a")
+	require.True(t, ok)
+	assert.Equal(t, 10, v)
+	assert.Equal(t, 2, c.Len())
+	assert.Equal(t, []string{"b"}, evicted)
+}
+
+func TestCacheClient_MaxBytes(t *testing.T) {
+	c := cache.NewCacheClient(time.Hour,
+		cache.WithMaxBytes(10, func(_ string, v string) int64 { return int64(len(v)) }),
+	)
+
+	c.Set("a", "12345", time.Now())
+	c.Set("b", "1234", time.Now())
+	assert.Equal(t, 2, c.Len())
+
+	c.Set("c", "123", time.Now())
+	assert.Equal(t, 2, c.Len())
+
+	_, ok := c.Get("a")
+	assert.False(t, ok)
+
+	// growing an entry in place evicts others
+	c.Set("c", "1234567", time.Now())
+	_, ok = c.Get("b")
+	assert.False(t, ok)
+	assert.Equal(t, 1, c.Len())
+}
+
+func TestCacheClient_Expiration(t *testing.T) {
+	var evicted []string
+	c := cache.NewCacheClient(-time.Second,
+		cache.WithOnEvict(func(key string, _ int) { evicted = append(evicted, key) }),
+	)
+
+	c.Set("a", 1, time.Now())
+	c.Set("b", 2, time.Now())
+
+	_, ok := c.Get("a")
+	assert.False(t, ok)
+	assert.Equal(t, 1, c.Len())
+
+	assert.Equal(t, 1, c.DeleteExpired(time.Now()))
+	assert.Zero(t, c.Len())
+	assert.Equal(t, []string{"a", "b"}, evicted)
+}
+
+func TestCacheClient_Clock(t *testing.T) {
+	clk := clock.NewFake(time.Date(2024, time.October, 1, 12, 0, 0, 0, time.UTC))
+	c := cache.NewCacheClient(time.Hour, cache.WithClock[string, int](clk))
+
+	c.Set("a", 1, clk.Now())
+	// ttl counts from the moment passed to Set
+	c.Set("b", 2, clk.Now().Add(-30*time.Minute))
+
+	clk.Advance(45 * time.Minute)
+	_, ok := c.Get("a")
+	assert.True(t, ok)
+	_, ok = c.Get("b")
+	assert.False(t, ok)
+
+	clk.Advance(15*time.Minute + time.Second)
+	_, ok = c.Get("a")
+	assert.False(t, ok)
+	assert.Zero(t, c.Len())
+}
+
+func TestCacheClient_Delete(t *testing.T) {
+	evictions := 0
+	c := cache.NewCacheClient(time.Hour,
+		cache.WithOnEvict(func(string, int) { evictions++ }),
+	)
+
+	c.Set("a", 1, time.Now())
+	c.Delete("a")
+	c.Delete("missing")
+
+	_, ok := c.Get("a")
+	assert.False(t, ok)
+	assert.Zero(t, c.Len())
+	assert.Zero(t, evictions)
+}
+
+func TestCacheClient_RunJanitor(t *testing.T) {
+	c := cache.NewCacheClient[string, int](time.Millisecond)
+	c.Set("a", 1, time.Now())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		c.RunJanitor(ctx, time.Millisecond)
+		close(done)
+	}()
+
+	assert.Eventually(t, func() bool { return c.Len() == 0 }, time.Second, time.Millisecond)
+
+	cancel()
+	<-done
+}
+
+func TestCacheClient_Stats(t *testing.T) {
+	c := cache.NewCacheClient(time.Hour,
+		cache.WithName[string, int]("test"),
+		cache.WithMaxEntries[string, int](1),
+	)
+
+	c.Set("a", 1, time.Now())
+	c.Get("a")
+	c.Get("b")
+	c.Set("b", 2, time.Now())
+
+	assert.Equal(t, cache.Stats{
+		Name:      "test",
+		Entries:   1,
+		Hits:      1,
+		Misses:    1,
+		Sets:      2,
+		Evictions: 1,
+	}, c.Stats())
+
+	v, expiresAt, ok := c.Peek("b")
+	require.True(t, ok)
+	assert.Equal(t, 2, v)
+	assert.True(t, expiresAt.After(time.Now()))
+	assert.Equal(t, uint64(1), c.Stats().Hits)
+
+	assert.Equal(t, 1, c.Flush())
+	assert.Zero(t, c.Len())
+	assert.Equal(t, uint64(1), c.Stats().Evictions)
+}
+
+func TestCacheClient_EntriesRestore(t *testing.T) {
+	now := time.Now()
+	c := cache.NewCacheClient[string, int](time.Hour)
+	c.Set("a", 1, now)
+	c.Set("b", 2, now)
+	c.Get("a")
+
+	entries := c.Entries(now)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "b", entries[0].Key)
+	assert.Equal(t, "a", entries[1].Key)
+
+	entries = append(entries, cache.Entry[string, int]{Key: "old", Value: 3, ExpiresAt: now.Add(-time.Second)})
+
+	// recency survives restoring into a smaller cache
+	restored := cache.NewCacheClient(time.Hour, cache.WithMaxEntries[string, int](1))
+	assert.Equal(t, 2, restored.Restore(entries, now))
+
+	v, ok := restored.Get("a")
+	require.True(t, ok)
+	assert.Equal(t, 1, v)
+	assert.Equal(t, 1, restored.Len())
+}
+
+// mapCache is the unbounded cache CacheClient replaced, kept as benchmark baseline
+type mapCache[K comparable, V any] struct {
+	ttl  time.Duration
+	lock sync.RWMutex
+	data map[K]*cache.Cached[V]
+}
+
+func newMapCache[K comparable, V any](ttl time.Duration) *mapCache[K, V] {
+	return &mapCache[K, V]{ttl: ttl, data: make(map[K]*cache.Cached[V])}
+}
+
+func (c *mapCache[K, V]) Get(key K) (V, bool) {
+	c.lock.RLock()
+	v, ok := c.data[key]
+	c.lock.RUnlock()
+
+	if ok && !v.Expired(time.Now()) {
+		return v.Value(), true
+	}
+
+	var zero V
+	return zero, false
+}
+
+func (c *mapCache[K, V]) Set(key K, value V, now time.Time) {
+	wrapped := cache.NewCached(now.Add(c.ttl), value)
+
+	c.lock.Lock()
+	c.data[key] = wrapped
+	c.lock.Unlock()
+}
+
+type benchCache interface {
+	Get(key int64) (string, bool)
+	Set(key int64, value string, now time.Time)
+}
+
+const benchKeys = 1 << 16
+
+func benchmarkCaches(b *testing.B, run func(b *testing.B, c benchCache)) {
+	caches := []struct {
+		name string
+		new  func() benchCache
+	}{
+		{"Map", func() benchCache { return newMapCache[int64, string](time.Hour) }},
+		{"LRU", func() benchCache { return cache.NewCacheClient[int64, string](time.Hour) }},
+		{"LRUBounded", func() benchCache {
+			return cache.NewCacheClient(time.Hour, cache.WithMaxEntries[int64, string](benchKeys/4))
+		}},
+		{"Sharded", func() benchCache { return cache.NewShardedCache[int64, string](time.Hour, 16, cache.HashInt64) }},
+	}
+
+	for _, bc := range caches {
+		b.Run(bc.name, func(b *testing.B) {
+			c := bc.new()
+			for i := int64(0); i < benchKeys; i++ {
+				c.Set(i, strconv.FormatInt(i, 10), time.Now())
+			}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			run(b, c)
+		})
+	}
+}
+
+func BenchmarkCache_Get(b *testing.B) {
+	benchmarkCaches(b, func(b *testing.B, c benchCache) {
+		for i := 0; i < b.N; i++ {
+			c.Get(int64(i % benchKeys))
+		}
+	})
+}
+
+func BenchmarkCache_Set(b *testing.B) {
+	benchmarkCaches(b, func(b *testing.B, c benchCache) {
+		for i := 0; i < b.N; i++ {
+			c.Set(int64(i%(2*benchKeys)), "value", time.Now())
+		}
+	})
+}
+
+func BenchmarkCache_ParallelMixed(b *testing.B) {
+	benchmarkCaches(b, func(b *testing.B, c benchCache) {
+		b.RunParallel(func(pb *testing.PB) {
+			i := 0
+			for pb.Next() {
+				key := int64(i % (2 * benchKeys))
+				if i%4 == 0 {
+					c.Set(key, "value", time.Now())
+				} else {
+					c.Get(key)
+				}
+				i++
+			}
+		})
+	})
+}
@@ -3,5 +3,6 @@ package cache
 import "errors"
 
 var (
-	ErrNotInCache = errors.New("order not in cache")
+	ErrNotInCache      = errors.New("order not in cache")
+	ErrSnapshotVersion = errors.New("unsupported cache snapshot version")
 )
@@ -0,0 +1,93 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/Na322Pr/route256/internal/dto"
+)
+
+const (
+	// ClientOrdersCacheName labels client order lists cache metrics
+	ClientOrdersCacheName = "client_orders"
+	// RefundsCacheName labels refund pages cache metrics
+	RefundsCacheName = "refunds"
+)
+
+// ListCache keeps order lists by key. Every invalidation advances generation,
+// and list loaded before it is not cached, so a slow load can not bring back a dropped list
+type ListCache[K comparable] struct {
+	lock sync.Mutex
+	cli  *CacheClient[K, *dto.ListOrdersDTO]
+	gen  uint64
+}
+
+// NewListCache keeps at most maxEntries lists, zero limit is not applied
+func NewListCache[K comparable](
+	name string,
+	ttl time.Duration,
+	maxEntries int,
+	opts ...Option[K, *dto.ListOrdersDTO],
+) *ListCache[K] {
+	opts = append([]Option[K, *dto.ListOrdersDTO]{
+		WithName[K, *dto.ListOrdersDTO](name),
+		WithMaxEntries[K, *dto.ListOrdersDTO](maxEntries),
+	}, opts...)
+
+	return &ListCache[K]{
+		cli: NewCacheClient(ttl, opts...),
+	}
+}
+
+func (c *ListCache[K]) Get(key K) (*dto.ListOrdersDTO, bool) {
+	return c.cli.Get(key)
+}
+
+// Generation is taken before loading a list and passed to Set
+func (c *ListCache[K]) Generation() uint64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.gen
+}
+
+// Set caches list loaded at generation gen unless anything was invalidated since then
+func (c *ListCache[K]) Set(key K, list *dto.ListOrdersDTO, gen uint64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if gen != c.gen {
+		return
+	}
+
+	c.cli.Set(key, list, c.cli.clock.Now())
+}
+
+func (c *ListCache[K]) Delete(key K) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.gen++
+	c.cli.Delete(key)
+}
+
+// DeleteFunc removes lists with keys matched by match
+func (c *ListCache[K]) DeleteFunc(match func(K) bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.gen++
+	c.cli.DeleteFunc(match)
+}
+
+func (c *ListCache[K]) Stats() Stats {
+	return c.cli.Stats()
+}
+
+func (c *ListCache[K]) Flush() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.gen++
+	return c.cli.Flush()
+}
@@ -0,0 +1,31 @@
+package cache_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Na322Pr/route256/internal/cache"
+	"github.com/Na322Pr/route256/internal/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListCache_Generation(t *testing.T) {
+	c := cache.NewListCache[int](cache.ClientOrdersCacheName, time.Hour, 0)
+	list := &dto.ListOrdersDTO{Orders: []dto.OrderDTO{{ID: 1, ClientID: 10}}}
+
+	c.Set(10, list, c.Generation())
+	got, ok := c.Get(10)
+	assert.True(t, ok)
+	assert.Equal(t, list, got)
+
+	// list loaded before invalidation is not cached
+	gen := c.Generation()
+	c.Delete(20)
+	c.Set(20, list, gen)
+	_, ok = c.Get(20)
+	assert.False(t, ok)
+
+	c.DeleteFunc(func(clientID int) bool { return clientID == 10 })
+	_, ok = c.Get(10)
+	assert.False(t, ok)
+}
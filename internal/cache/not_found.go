@@ -0,0 +1,51 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/Na322Pr/route256/internal/metrics"
+)
+
+// NotFoundCache remembers lookups of orders missing from storage, so repeated scans
+// of a wrong barcode do not reach storage. Its ttl is kept short, as an order may be received any moment
+type NotFoundCache struct {
+	cli *CacheClient[int64, error]
+}
+
+// NewNotFoundCache keeps at most maxEntries missing orders, zero limit is not applied
+func NewNotFoundCache(ttl time.Duration, maxEntries int, opts ...Option[int64, error]) *NotFoundCache {
+	opts = append([]Option[int64, error]{
+		WithMaxEntries[int64, error](maxEntries),
+	}, opts...)
+
+	return &NotFoundCache{
+		cli: NewCacheClient(ttl, opts...),
+	}
+}
+
+// Get returns error storage returned for the order, nil if the order is not known to be missing
+func (c *NotFoundCache) Get(orderID int64) error {
+	err, ok := c.cli.Get(orderID)
+	if !ok {
+		return nil
+	}
+
+	metrics.IncCacheNegativeHits(OrderCacheName)
+	return err
+}
+
+func (c *NotFoundCache) Set(orderID int64, err error, now time.Time) {
+	c.cli.Set(orderID, err, now)
+}
+
+func (c *NotFoundCache) Delete(orderID int64) {
+	c.cli.Delete(orderID)
+}
+
+func (c *NotFoundCache) Len() int {
+	return c.cli.Len()
+}
+
+func (c *NotFoundCache) Flush() int {
+	return c.cli.Flush()
+}
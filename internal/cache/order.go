@@ -1,18 +1,37 @@
 package cache
 
 import (
+	"context"
 	"time"
+	"unsafe"
 
 	"github.com/Na322Pr/route256/internal/dto"
 )
 
+// OrderCacheName labels order cache metrics
+const OrderCacheName = "orders"
+
 type OrderCache struct {
-	cli *CacheClient[int64, *dto.OrderDTO]
+	cli *ShardedCache[int64, *dto.OrderDTO]
 }
 
-func NewOrderCache(ttl time.Duration) *OrderCache {
+// NewOrderCache keeps at most maxEntries orders of about maxBytes in total split over shards,
+// zero limit is not applied
+func NewOrderCache(
+	ttl time.Duration,
+	shards int,
+	maxEntries int,
+	maxBytes int64,
+	opts ...Option[int64, *dto.OrderDTO],
+) *OrderCache {
+	opts = append([]Option[int64, *dto.OrderDTO]{
+		WithName[int64, *dto.OrderDTO](OrderCacheName),
+		WithMaxEntries[int64, *dto.OrderDTO](maxEntries),
+		WithMaxBytes(maxBytes, orderSize),
+	}, opts...)
+
 	return &OrderCache{
-		cli: NewCacheClient[int64, *dto.OrderDTO](ttl),
+		cli: NewShardedCache(ttl, shards, HashInt64, opts...),
 	}
 }
 
@@ -20,7 +39,63 @@ func (c *OrderCache) Get(orderID int64) (*dto.OrderDTO, bool) {
 	return c.cli.Get(orderID)
 }
 
+// GetMany looks orders up one by one, orders not found are missing from the result
+func (c *OrderCache) GetMany(orderIDs []int64) map[int64]*dto.OrderDTO {
+	res := make(map[int64]*dto.OrderDTO, len(orderIDs))
+	for _, orderID := range orderIDs {
+		if order, ok := c.cli.Get(orderID); ok {
+			res[orderID] = order
+		}
+	}
+
+	return res
+}
+
 func (c *OrderCache) Set(orderDTO *dto.OrderDTO, now time.Time) error {
 	c.cli.Set(orderDTO.ID, orderDTO, now)
 	return nil
 }
+
+func (c *OrderCache) Delete(orderID int64) {
+	c.cli.Delete(orderID)
+}
+
+func (c *OrderCache) Len() int {
+	return c.cli.Len()
+}
+
+// Peek returns cached order with its expiration time, not counting it as cache hit
+func (c *OrderCache) Peek(orderID int64) (*dto.OrderDTO, time.Time, bool) {
+	return c.cli.Peek(orderID)
+}
+
+func (c *OrderCache) Stats() Stats {
+	return c.cli.Stats()
+}
+
+func (c *OrderCache) Flush() int {
+	return c.cli.Flush()
+}
+
+// RunJanitor removes expired orders every interval until ctx is done
+func (c *OrderCache) RunJanitor(ctx context.Context, interval time.Duration) {
+	c.cli.RunJanitor(ctx, interval)
+}
+
+// orderSize estimates memory held by a cached order
+func orderSize(_ int64, order *dto.OrderDTO) int64 {
+	size := int64(unsafe.Sizeof(*order))
+
+	size += int64(len(order.Status) + len(order.RefundReason) + len(order.RefundComment) +
+		len(order.InspectionCondition) + len(order.InspectionOutcome))
+
+	for _, p := range order.Packages {
+		size += int64(unsafe.Sizeof(p)) + int64(len(p))
+	}
+
+	for _, item := range order.Items {
+		size += int64(unsafe.Sizeof(item)) + int64(len(item.SKU)+len(item.Name)+len(item.Status))
+	}
+
+	return size
+}
@@ -0,0 +1,223 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/Na322Pr/route256/internal/clock"
+	"github.com/Na322Pr/route256/internal/dto"
+	"github.com/Na322Pr/route256/internal/metrics"
+	"github.com/redis/go-redis/v9"
+)
+
+// scanBatchSize hints how many keys Flush and Stats scan at once
+const scanBatchSize = 1000
+
+// RedisOrderCache keeps orders in redis shared by all service replicas,
+// so an order updated by one replica is not served stale by another
+type RedisOrderCache struct {
+	client    *redis.Client
+	ttl       time.Duration
+	keyPrefix string
+	clock     clock.Clock
+
+	hits   atomic.Uint64
+	misses atomic.Uint64
+	sets   atomic.Uint64
+}
+
+// NewRedisOrderCache leaves expiration to redis, clk only turns remaining ttl into a moment in Peek
+func NewRedisOrderCache(client *redis.Client, ttl time.Duration, keyPrefix string, clk clock.Clock) *RedisOrderCache {
+	return &RedisOrderCache{
+		client:    client,
+		ttl:       ttl,
+		keyPrefix: keyPrefix,
+		clock:     clk,
+	}
+}
+
+// Get treats redis failure as cache miss, so the order is read from storage
+func (c *RedisOrderCache) Get(orderID int64) (*dto.OrderDTO, bool) {
+	data, err := c.client.Get(context.Background(), c.key(orderID)).Bytes()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		log.Printf("[RedisOrderCache.Get] failed to get order %d: %s", orderID, err.Error())
+	}
+
+	order, ok := c.decode(orderID, data, err)
+	c.countLookup(ok)
+
+	return order, ok
+}
+
+// GetMany looks orders up in one pipeline, orders not found are missing from the result
+func (c *RedisOrderCache) GetMany(orderIDs []int64) map[int64]*dto.OrderDTO {
+	res := make(map[int64]*dto.OrderDTO, len(orderIDs))
+	if len(orderIDs) == 0 {
+		return res
+	}
+
+	cmds := make([]*redis.StringCmd, 0, len(orderIDs))
+	_, err := c.client.Pipelined(context.Background(), func(pipe redis.Pipeliner) error {
+		for _, orderID := range orderIDs {
+			cmds = append(cmds, pipe.Get(context.Background(), c.key(orderID)))
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		log.Printf("[RedisOrderCache.GetMany] failed to get orders: %s", err.Error())
+	}
+
+	for i, cmd := range cmds {
+		data, err := cmd.Bytes()
+
+		order, ok := c.decode(orderIDs[i], data, err)
+		c.countLookup(ok)
+
+		if ok {
+			res[orderIDs[i]] = order
+		}
+	}
+
+	return res
+}
+
+func (c *RedisOrderCache) Set(orderDTO *dto.OrderDTO, now time.Time) error {
+	data, err := json.Marshal(orderDTO)
+	if err != nil {
+		return err
+	}
+
+	if err := c.client.Set(context.Background(), c.key(orderDTO.ID), data, c.ttl).Err(); err != nil {
+		return err
+	}
+
+	c.sets.Add(1)
+	metrics.IncCacheSets(OrderCacheName)
+
+	return nil
+}
+
+func (c *RedisOrderCache) Delete(orderID int64) {
+	if err := c.client.Del(context.Background(), c.key(orderID)).Err(); err != nil {
+		log.Printf("[RedisOrderCache.Delete] failed to delete order %d: %s", orderID, err.Error())
+	}
+}
+
+// Peek returns cached order with its expiration time, not counting it as cache hit
+func (c *RedisOrderCache) Peek(orderID int64) (*dto.OrderDTO, time.Time, bool) {
+	var (
+		get *redis.StringCmd
+		ttl *redis.DurationCmd
+	)
+
+	_, err := c.client.Pipelined(context.Background(), func(pipe redis.Pipeliner) error {
+		get = pipe.Get(context.Background(), c.key(orderID))
+		ttl = pipe.PTTL(context.Background(), c.key(orderID))
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		log.Printf("[RedisOrderCache.Peek] failed to get order %d: %s", orderID, err.Error())
+	}
+
+	data, err := get.Bytes()
+	order, ok := c.decode(orderID, data, err)
+	if !ok {
+		return nil, time.Time{}, false
+	}
+
+	return order, c.clock.Now().Add(ttl.Val()), true
+}
+
+// Stats counts lookups made by this replica only, entries are shared by all of them
+func (c *RedisOrderCache) Stats() Stats {
+	stats := Stats{
+		Name:   OrderCacheName,
+		Hits:   c.hits.Load(),
+		Misses: c.misses.Load(),
+		Sets:   c.sets.Load(),
+	}
+
+	err := c.scan(func(keys []string) error {
+		stats.Entries += len(keys)
+		return nil
+	})
+	if err != nil {
+		log.Printf("[RedisOrderCache.Stats] failed to count orders: %s", err.Error())
+	}
+
+	return stats
+}
+
+// Flush removes orders cached by all replicas and returns their number
+func (c *RedisOrderCache) Flush() int {
+	var flushed int
+
+	err := c.scan(func(keys []string) error {
+		n, err := c.client.Del(context.Background(), keys...).Result()
+		flushed += int(n)
+		return err
+	})
+	if err != nil {
+		log.Printf("[RedisOrderCache.Flush] failed to delete orders: %s", err.Error())
+	}
+
+	return flushed
+}
+
+func (c *RedisOrderCache) key(orderID int64) string {
+	return c.keyPrefix + strconv.FormatInt(orderID, 10)
+}
+
+// decode reports miss for absent, unavailable or malformed entry
+func (c *RedisOrderCache) decode(orderID int64, data []byte, err error) (*dto.OrderDTO, bool) {
+	if err != nil {
+		return nil, false
+	}
+
+	var order dto.OrderDTO
+	if err := json.Unmarshal(data, &order); err != nil {
+		log.Printf("[RedisOrderCache] malformed order %d: %s", orderID, err.Error())
+		return nil, false
+	}
+
+	return &order, true
+}
+
+func (c *RedisOrderCache) countLookup(hit bool) {
+	if hit {
+		c.hits.Add(1)
+		metrics.IncCacheHits(OrderCacheName)
+		return
+	}
+
+	c.misses.Add(1)
+	metrics.IncCacheMisses(OrderCacheName)
+}
+
+// scan passes keys of cached orders to fn in batches
+func (c *RedisOrderCache) scan(fn func(keys []string) error) error {
+	var cursor uint64
+
+	for {
+		keys, next, err := c.client.Scan(context.Background(), cursor, c.keyPrefix+"*", scanBatchSize).Result()
+		if err != nil {
+			return err
+		}
+
+		if len(keys) > 0 {
+			if err := fn(keys); err != nil {
+				return err
+			}
+		}
+
+		if next == 0 {
+			return nil
+		}
+		cursor = next
+	}
+}
@@ -0,0 +1,118 @@
+package cache_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/Na322Pr/route256/internal/cache"
+	"github.com/Na322Pr/route256/internal/clock"
+	"github.com/Na322Pr/route256/internal/dto"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRedisOrderCache(t *testing.T, clk clock.Clock) (*cache.RedisOrderCache, *miniredis.Miniredis) {
+	t.Helper()
+
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	return cache.NewRedisOrderCache(client, time.Hour, "pvz:order:", clk), mr
+}
+
+func TestRedisOrderCache_GetSet(t *testing.T) {
+	c, mr := newRedisOrderCache(t, clock.Real)
+
+	_, ok := c.Get(1)
+	assert.False(t, ok)
+
+	order := &dto.OrderDTO{
+		ID:         1,
+		ClientID:   10,
+		StoreUntil: time.Date(2030, time.January, 1, 12, 0, 0, 0, time.UTC),
+		Status:     "received",
+		PickUpTime: sql.NullTime{Time: time.Date(2029, time.June, 1, 10, 30, 0, 0, time.UTC), Valid: true},
+		Packages:   []string{"box"},
+		Items:      []dto.OrderItemDTO{{OrderID: 1, SKU: "phone", Quantity: 1, UnitPrice: 1000, Status: "received"}},
+		Version:    2,
+	}
+	require.NoError(t, c.Set(order, time.Now()))
+
+	got, ok := c.Get(1)
+	require.True(t, ok)
+	assert.Equal(t, order, got)
+	assert.Equal(t, time.Hour, mr.TTL("pvz:order:1"))
+
+	mr.FastForward(time.Hour)
+	_, ok = c.Get(1)
+	assert.False(t, ok)
+
+	require.NoError(t, c.Set(order, time.Now()))
+	c.Delete(1)
+	_, ok = c.Get(1)
+	assert.False(t, ok)
+}
+
+func TestRedisOrderCache_GetMany(t *testing.T) {
+	c, mr := newRedisOrderCache(t, clock.Real)
+
+	for _, id := range []int64{1, 2} {
+		require.NoError(t, c.Set(&dto.OrderDTO{ID: id, ClientID: 10}, time.Now()))
+	}
+	mr.Set("pvz:order:3", "not json")
+
+	got := c.GetMany([]int64{1, 2, 3, 4})
+	require.Len(t, got, 2)
+	assert.Equal(t, int64(1), got[1].ID)
+	assert.Equal(t, int64(2), got[2].ID)
+
+	assert.Empty(t, c.GetMany(nil))
+}
+
+func TestRedisOrderCache_Unavailable(t *testing.T) {
+	c, mr := newRedisOrderCache(t, clock.Real)
+	require.NoError(t, c.Set(&dto.OrderDTO{ID: 1}, time.Now()))
+
+	mr.Close()
+
+	_, ok := c.Get(1)
+	assert.False(t, ok)
+	assert.Empty(t, c.GetMany([]int64{1}))
+	assert.Error(t, c.Set(&dto.OrderDTO{ID: 1}, time.Now()))
+}
+
+func TestRedisOrderCache_Admin(t *testing.T) {
+	now := time.Date(2030, time.January, 1, 12, 0, 0, 0, time.UTC)
+	c, mr := newRedisOrderCache(t, clock.NewFake(now))
+	mr.Set("other", "kept")
+
+	for _, id := range []int64{1, 2} {
+		require.NoError(t, c.Set(&dto.OrderDTO{ID: id}, time.Now()))
+	}
+	c.Get(1)
+	c.Get(3)
+
+	order, expiresAt, ok := c.Peek(2)
+	require.True(t, ok)
+	assert.Equal(t, int64(2), order.ID)
+	assert.Equal(t, now.Add(time.Hour), expiresAt)
+
+	_, _, ok = c.Peek(3)
+	assert.False(t, ok)
+
+	assert.Equal(t, cache.Stats{
+		Name:    cache.OrderCacheName,
+		Entries: 2,
+		Hits:    1,
+		Misses:  1,
+		Sets:    2,
+	}, c.Stats())
+
+	assert.Equal(t, 2, c.Flush())
+	assert.Zero(t, c.Stats().Entries)
+	assert.True(t, mr.Exists("other"))
+}
@@ -0,0 +1,179 @@
+package cache
+
+import (
+	"context"
+	"hash/maphash"
+	"math/bits"
+	"time"
+
+	"github.com/Na322Pr/route256/internal/clock"
+)
+
+// ShardedCache spreads keys over independent CacheClient shards by key hash,
+// so concurrent callers working with different keys rarely wait for one lock.
+// Limits are split between shards evenly, so eviction is least recently used within a shard
+type ShardedCache[K comparable, V any] struct {
+	shards []*CacheClient[K, V]
+	mask   uint64
+	hash   func(K) uint64
+	name   string
+	clock  clock.Clock
+}
+
+// NewShardedCache rounds shards up to a power of two, opts are applied to every shard
+func NewShardedCache[K comparable, V any](
+	ttl time.Duration,
+	shards int,
+	hash func(K) uint64,
+	opts ...Option[K, V],
+) *ShardedCache[K, V] {
+	n := 1
+	if shards > 1 {
+		n = 1 << bits.Len(uint(shards-1))
+	}
+
+	c := &ShardedCache[K, V]{
+		shards: make([]*CacheClient[K, V], n),
+		mask:   uint64(n - 1),
+		hash:   hash,
+	}
+
+	for i := range c.shards {
+		shard := NewCacheClient(ttl, opts...)
+		shard.maxEntries = ceilDiv(shard.maxEntries, n)
+		shard.maxBytes = ceilDiv(shard.maxBytes, int64(n))
+
+		c.shards[i] = shard
+	}
+	c.name, c.clock = c.shards[0].name, c.shards[0].clock
+
+	return c
+}
+
+// HashInt64 mixes key bits, so sequential ids are spread over all shards
+func HashInt64(key int64) uint64 {
+	x := uint64(key)
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+
+	return x
+}
+
+var stringSeed = maphash.MakeSeed()
+
+func HashString(key string) uint64 {
+	return maphash.String(stringSeed, key)
+}
+
+func (c *ShardedCache[K, V]) Get(key K) (V, bool) {
+	return c.shard(key).Get(key)
+}
+
+func (c *ShardedCache[K, V]) Peek(key K) (V, time.Time, bool) {
+	return c.shard(key).Peek(key)
+}
+
+func (c *ShardedCache[K, V]) Set(key K, value V, now time.Time) {
+	c.shard(key).Set(key, value, now)
+}
+
+func (c *ShardedCache[K, V]) Delete(key K) {
+	c.shard(key).Delete(key)
+}
+
+// Flush empties shards one by one, entries set meanwhile to flushed shards are kept
+func (c *ShardedCache[K, V]) Flush() int {
+	n := 0
+	for _, shard := range c.shards {
+		n += shard.Flush()
+	}
+
+	return n
+}
+
+// Stats sums shard stats, they are not taken at one moment
+func (c *ShardedCache[K, V]) Stats() Stats {
+	stats := Stats{Name: c.name}
+	for _, shard := range c.shards {
+		s := shard.Stats()
+		stats.Entries += s.Entries
+		stats.Bytes += s.Bytes
+		stats.Hits += s.Hits
+		stats.Misses += s.Misses
+		stats.Sets += s.Sets
+		stats.Evictions += s.Evictions
+	}
+
+	return stats
+}
+
+func (c *ShardedCache[K, V]) Len() int {
+	n := 0
+	for _, shard := range c.shards {
+		n += shard.Len()
+	}
+
+	return n
+}
+
+// DeleteExpired locks one shard at a time and returns the number of removed entries
+func (c *ShardedCache[K, V]) DeleteExpired(now time.Time) int {
+	n := 0
+	for _, shard := range c.shards {
+		n += shard.DeleteExpired(now)
+	}
+
+	return n
+}
+
+// Entries returns entries not expired at now shard by shard, recency is kept within a shard
+func (c *ShardedCache[K, V]) Entries(now time.Time) []Entry[K, V] {
+	var entries []Entry[K, V]
+	for _, shard := range c.shards {
+		entries = append(entries, shard.Entries(now)...)
+	}
+
+	return entries
+}
+
+// Restore puts entries into their shards keeping expiration time, entries expired at now are skipped
+func (c *ShardedCache[K, V]) Restore(entries []Entry[K, V], now time.Time) int {
+	byShard := make([][]Entry[K, V], len(c.shards))
+	for _, e := range entries {
+		i := c.hash(e.Key) & c.mask
+		byShard[i] = append(byShard[i], e)
+	}
+
+	n := 0
+	for i, shard := range c.shards {
+		n += shard.Restore(byShard[i], now)
+	}
+
+	return n
+}
+
+// RunJanitor removes expired entries every interval until ctx is done
+func (c *ShardedCache[K, V]) RunJanitor(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.DeleteExpired(c.clock.Now())
+		}
+	}
+}
+
+func (c *ShardedCache[K, V]) shard(key K) *CacheClient[K, V] {
+	return c.shards[c.hash(key)&c.mask]
+}
+
+func ceilDiv[T int | int64](a, b T) T {
+	return (a + b - 1) / b
+}
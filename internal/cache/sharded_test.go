@@ -0,0 +1,114 @@
+package cache_test
+
+import (
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Na322Pr/route256/internal/cache"
+	"github.com/Na322Pr/route256/internal/dto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShardedCache_GetSetDelete(t *testing.T) {
+	c := cache.NewShardedCache[string, int](time.Hour, 8, cache.HashString)
+
+	for i := 0; i < 100; i++ {
+		c.Set(strconv.Itoa(i), i, time.Now())
+	}
+	assert.Equal(t, 100, c.Len())
+
+	for i := 0; i < 100; i++ {
+		v, ok := c.Get(strconv.Itoa(i))
+		require.True(t, ok)
+		assert.Equal(t, i, v)
+	}
+
+	c.Delete("1")
+	_, ok := c.Get("1")
+	assert.False(t, ok)
+
+	v, _, ok := c.Peek("2")
+	require.True(t, ok)
+	assert.Equal(t, 2, v)
+
+	assert.Equal(t, 99, c.Flush())
+	assert.Zero(t, c.Len())
+}
+
+func TestShardedCache_Limits(t *testing.T) {
+	var evictions int
+	// 3 shards are rounded up to 4 with 25 entries each
+	c := cache.NewShardedCache(time.Hour, 3, cache.HashInt64,
+		cache.WithMaxEntries[int64, int](100),
+		cache.WithOnEvict(func(int64, int) { evictions++ }),
+	)
+
+	for i := int64(0); i < 1000; i++ {
+		c.Set(i, int(i), time.Now())
+	}
+
+	assert.LessOrEqual(t, c.Len(), 100)
+	assert.Equal(t, 1000-c.Len(), evictions)
+}
+
+func TestShardedCache_StatsAndExpiration(t *testing.T) {
+	c := cache.NewShardedCache(-time.Second, 4, cache.HashInt64,
+		cache.WithName[int64, int]("sharded"),
+	)
+
+	for i := int64(0); i < 10; i++ {
+		c.Set(i, int(i), time.Now())
+	}
+	c.Get(0)
+
+	assert.Equal(t, cache.Stats{
+		Name:      "sharded",
+		Entries:   9,
+		Misses:    1,
+		Sets:      10,
+		Evictions: 1,
+	}, c.Stats())
+
+	assert.Equal(t, 9, c.DeleteExpired(time.Now()))
+	assert.Zero(t, c.Len())
+}
+
+// BenchmarkOrderCache_GiveOut mimics give out workers, each reading an order and writing it back updated
+func BenchmarkOrderCache_GiveOut(b *testing.B) {
+	const orders = 1 << 14
+
+	for _, shards := range []int{1, 4, 16, 64} {
+		b.Run(fmt.Sprintf("Shards%d", shards), func(b *testing.B) {
+			c := cache.NewOrderCache(time.Hour, shards, 0, 0)
+			for i := int64(0); i < orders; i++ {
+				require.NoError(b, c.Set(&dto.OrderDTO{ID: i, ClientID: int(i % 100), Status: "received"}, time.Now()))
+			}
+
+			var next atomic.Int64
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			// several workers per cpu, as the pool is sized regardless of GOMAXPROCS
+			b.SetParallelism(4)
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					id := next.Add(1) % orders
+
+					order, ok := c.Get(id)
+					if !ok {
+						order = &dto.OrderDTO{ID: id}
+					}
+
+					updated := *order
+					updated.Status = "pickedUp"
+					updated.Version++
+					_ = c.Set(&updated, time.Now())
+				}
+			})
+		})
+	}
+}
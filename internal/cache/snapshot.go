@@ -0,0 +1,114 @@
+package cache
+
+import (
+	"context"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Na322Pr/route256/internal/dto"
+)
+
+// snapshotVersion is bumped on incompatible snapshot changes, snapshots of other versions are rejected
+const snapshotVersion = 1
+
+type orderSnapshot struct {
+	Version int
+	SavedAt time.Time
+	Entries []Entry[int64, *dto.OrderDTO]
+}
+
+// SaveSnapshot writes orders not expired at now to path and returns their number.
+// Snapshot is written to a temporary file first, so a crash never leaves a truncated one
+func (c *OrderCache) SaveSnapshot(path string, now time.Time) (int, error) {
+	const op = "OrderCache.SaveSnapshot"
+
+	snap := orderSnapshot{
+		Version: snapshotVersion,
+		SavedAt: now,
+		Entries: c.cli.Entries(now),
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := gob.NewEncoder(tmp).Encode(snap); err != nil {
+		tmp.Close()
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return len(snap.Entries), nil
+}
+
+// LoadSnapshot restores orders from path and returns their number, missing snapshot is not an error.
+// Orders may have been changed while the service was down, so they expire within maxTTL from now
+// at the latest, zero maxTTL keeps their expiration time
+func (c *OrderCache) LoadSnapshot(path string, now time.Time, maxTTL time.Duration) (int, error) {
+	const op = "OrderCache.LoadSnapshot"
+
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer f.Close()
+
+	var snap orderSnapshot
+	if err := gob.NewDecoder(f).Decode(&snap); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if snap.Version != snapshotVersion {
+		return 0, fmt.Errorf("%s: %w", op, ErrSnapshotVersion)
+	}
+
+	if maxTTL > 0 {
+		latest := now.Add(maxTTL)
+		for i := range snap.Entries {
+			if snap.Entries[i].ExpiresAt.After(latest) {
+				snap.Entries[i].ExpiresAt = latest
+			}
+		}
+	}
+
+	return c.cli.Restore(snap.Entries, now), nil
+}
+
+// RunSnapshots saves snapshot to path every interval until ctx is done
+func (c *OrderCache) RunSnapshots(ctx context.Context, path string, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := c.SaveSnapshot(path, c.cli.clock.Now()); err != nil {
+				log.Printf("[OrderCache.RunSnapshots] failed to save snapshot: %s", err.Error())
+			}
+		}
+	}
+}
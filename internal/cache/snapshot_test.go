@@ -0,0 +1,80 @@
+package cache_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Na322Pr/route256/internal/cache"
+	"github.com/Na322Pr/route256/internal/dto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderCache_Snapshot(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data", "order-cache.gob")
+	now := time.Now()
+
+	c := cache.NewOrderCache(time.Hour, 4, 0, 0)
+	for _, id := range []int64{1, 2, 3} {
+		require.NoError(t, c.Set(&dto.OrderDTO{ID: id, ClientID: 10, Status: "received", Packages: []string{"box"}}, now))
+	}
+
+	saved, err := c.SaveSnapshot(path, now)
+	require.NoError(t, err)
+	assert.Equal(t, 3, saved)
+
+	// shard count may change between restarts
+	restored := cache.NewOrderCache(time.Hour, 8, 0, 0)
+	n, err := restored.LoadSnapshot(path, now, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	order, expiresAt, ok := restored.Peek(2)
+	require.True(t, ok)
+	assert.Equal(t, &dto.OrderDTO{ID: 2, ClientID: 10, Status: "received", Packages: []string{"box"}}, order)
+
+	_, want, _ := c.Peek(2)
+	assert.True(t, want.Equal(expiresAt))
+
+	// orders expired while the service was down are skipped
+	n, err = cache.NewOrderCache(time.Hour, 1, 0, 0).LoadSnapshot(path, now.Add(2*time.Hour), 0)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+
+	// restored orders are kept at most for maxTTL
+	capped := cache.NewOrderCache(time.Hour, 1, 0, 0)
+	n, err = capped.LoadSnapshot(path, now, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	_, expiresAt, ok = capped.Peek(2)
+	require.True(t, ok)
+	assert.True(t, now.Add(time.Minute).Equal(expiresAt))
+
+	// and expire as before if that comes earlier
+	loose := cache.NewOrderCache(time.Hour, 1, 0, 0)
+	_, err = loose.LoadSnapshot(path, now, 2*time.Hour)
+	require.NoError(t, err)
+
+	_, expiresAt, ok = loose.Peek(2)
+	require.True(t, ok)
+	assert.True(t, want.Equal(expiresAt))
+}
+
+func TestOrderCache_LoadSnapshotErrors(t *testing.T) {
+	dir := t.TempDir()
+	c := cache.NewOrderCache(time.Hour, 1, 0, 0)
+
+	n, err := c.LoadSnapshot(filepath.Join(dir, "missing.gob"), time.Now(), 0)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+
+	broken := filepath.Join(dir, "broken.gob")
+	require.NoError(t, os.WriteFile(broken, []byte("not gob"), 0o644))
+
+	_, err = c.LoadSnapshot(broken, time.Now(), 0)
+	assert.Error(t, err)
+	assert.Zero(t, c.Len())
+}
@@ -18,6 +18,10 @@ func (c *Cached[V]) Expired(now time.Time) bool {
 	return c.expiredAt.Before(now)
 }
 
+func (c *Cached[V]) ExpiredAt() time.Time {
+	return c.expiredAt
+}
+
 func (c *Cached[V]) Value() V {
 	return c.value
 }
@@ -22,22 +22,54 @@ import (
 
 type CLI struct {
 	serviceURL string
+	operatorID string
 	rootCmd    *cobra.Command
 }
 
+// operatorIDHeader identifies the operator in service audit log
+const operatorIDHeader = "X-Operator-Id"
+
 type OrderIDRequest struct {
 	OrderID int64 `json:"order_id"`
 }
 
-type OrderCLientIDRequest struct {
-	OrderID  int64 `json:"order_id"`
-	ClientID int   `json:"client_id"`
+type RefundClientRequest struct {
+	OrderID             int64  `json:"order_id"`
+	ClientID            int    `json:"client_id"`
+	Reason              string `json:"reason"`
+	Comment             string `json:"comment"`
+	InspectionCondition string `json:"inspection_condition"`
+	InspectionOutcome   string `json:"inspection_outcome"`
+}
+
+type GiveOutItemsRequest struct {
+	OrderID int64    `json:"order_id"`
+	SKUs    []string `json:"skus"`
+}
+
+type ApproveRefundRequest struct {
+	OrderID int64 `json:"order_id"`
+	Reject  bool  `json:"reject"`
 }
 
 type OrdersIDsRequest struct {
 	OrdersIDs []int64 `json:"orders_ids"`
 }
 
+type CheckoutClientRequest struct {
+	KeptOrdersIDs    []int64 `json:"kept_orders_ids"`
+	RefusedOrdersIDs []int64 `json:"refused_orders_ids"`
+}
+
+type ReturnRefundsRequest struct {
+	Courier   string  `json:"courier"`
+	OrdersIDs []int64 `json:"orders_ids"`
+}
+
+type ArchiveOrdersResponce struct {
+	Archived string `json:"archived"`
+}
+
 type OrderResponce struct {
 	ID         string    `json:"id"`
 	ClientID   int       `json:"clientId"`
@@ -74,22 +106,36 @@ func (cli *CLI) getRequest(method string, params url.Values) (*OrdersResponce, e
 }
 
 func (cli *CLI) postRequest(method string, data any) (int, error) {
+	return cli.postRequestWithResponse(method, data, nil)
+}
+
+// postRequestWithResponse decodes successful response body into resp if it is not nil
+func (cli *CLI) postRequestWithResponse(method string, data any, resp any) (int, error) {
 	jsonData, err := json.Marshal(data)
 	if err != nil {
 		return 0, err
 	}
 
-	resp, err := http.Post(
-		fmt.Sprintf("%s/%s", cli.serviceURL, method),
-		"application/json",
-		bytes.NewBuffer(jsonData),
-	)
+	httpReq, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/%s", cli.serviceURL, method), bytes.NewBuffer(jsonData))
 	if err != nil {
 		return 0, err
 	}
-	defer resp.Body.Close()
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set(operatorIDHeader, cli.operatorID)
+
+	httpResp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return 0, err
+	}
+	defer httpResp.Body.Close()
+
+	if resp != nil && httpResp.StatusCode == http.StatusOK {
+		if err := json.NewDecoder(httpResp.Body).Decode(resp); err != nil {
+			return httpResp.StatusCode, err
+		}
+	}
 
-	return resp.StatusCode, nil
+	return httpResp.StatusCode, nil
 }
 
 func (cli *CLI) ReturnReceiveOrderFromCourierCmd() *cobra.Command {
@@ -133,15 +179,7 @@ Example: receive-courier 1 1 2024-10-01 15:20:00 1200 7 bag tape`,
 				fmt.Println("weight is incorrect")
 			}
 
-			packages := []string{"unknown", "unknown"}
-
-			if len(args) >= 7 {
-				packages[0] = args[6]
-			}
-
-			if len(args) == 8 {
-				packages[1] = args[7]
-			}
+			packages := args[6:]
 
 			req := dto.AddOrder{
 				ID:         int64(orderID),
@@ -227,6 +265,87 @@ Example: give-out-client 1 2 3 4`,
 	}
 }
 
+func (cli *CLI) ReturnCheckoutClientCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "checkout-client",
+		Short: "Give out kept orders and queue refused ones for return to courier",
+		Long: `Usage: checkout-client [keptOrderIDs...] [-- refusedOrderIDs...]
+Example: checkout-client 1 2 -- 3 4`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) < 1 {
+				fmt.Println("No arguments. Expected arguments: [keptOrderIDs...] [-- refusedOrderIDs...]")
+				return
+			}
+
+			keptArgs := args
+			var refusedArgs []string
+
+			if dash := cmd.ArgsLenAtDash(); dash >= 0 {
+				keptArgs, refusedArgs = args[:dash], args[dash:]
+			}
+
+			req := CheckoutClientRequest{}
+
+			for _, arg := range keptArgs {
+				orderID, err := strconv.Atoi(arg)
+				if err != nil {
+					fmt.Println("One of kept orderIDs is incorrect")
+					return
+				}
+
+				req.KeptOrdersIDs = append(req.KeptOrdersIDs, int64(orderID))
+			}
+
+			for _, arg := range refusedArgs {
+				orderID, err := strconv.Atoi(arg)
+				if err != nil {
+					fmt.Println("One of refused orderIDs is incorrect")
+					return
+				}
+
+				req.RefusedOrdersIDs = append(req.RefusedOrdersIDs, int64(orderID))
+			}
+
+			status, err := cli.postRequest("CheckoutClient", req)
+			if err != nil || status != 200 {
+				fmt.Println("Error with client checkout")
+				return
+			}
+
+			fmt.Println("Kept orders issued, refused orders queued for return to courier")
+		},
+	}
+}
+
+func (cli *CLI) ReturnGiveOutItemsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "give-out-items",
+		Short: "Give out part of order items to client",
+		Long: `Usage: give-out-items orderID [skus...]
+Example: give-out-items 12 sku-1 sku-2`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) < 2 {
+				fmt.Println("Incorrect args count. Expected at least 2 arguments: orderID [skus...]")
+				return
+			}
+
+			orderID, err := strconv.Atoi(args[0])
+			if err != nil {
+				fmt.Println("orderID is incorrect")
+				return
+			}
+
+			status, err := cli.postRequest("GiveOutItems", GiveOutItemsRequest{OrderID: int64(orderID), SKUs: args[1:]})
+			if err != nil || status != 200 {
+				fmt.Println("Error with order items issue")
+				return
+			}
+
+			fmt.Println("Order items successfully issued to the client")
+		},
+	}
+}
+
 func (cli *CLI) ReturnGetOrderListCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "order-list",
@@ -276,11 +395,14 @@ func (cli *CLI) ReturnRefundFromCustomerCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "refund-client",
 		Short: "Refund order from client",
-		Long: `Usage: refund-client clientID orderID
-Example: refund-client 10 12`,
+		Long: `Usage: refund-client clientID orderID reason condition outcome [comment...]
+Reasons: defect, wrongItem, changedMind, notAsDescribed, damagedInTransit, other
+Conditions: intact, damaged, incomplete
+Outcomes: accepted, rejected
+Example: refund-client 10 12 defect damaged accepted screen is broken`,
 		Run: func(cmd *cobra.Command, args []string) {
-			if len(args) != 2 {
-				fmt.Println("Incorrect args count. Expected 2 arguments: clientID orderID")
+			if len(args) < 5 {
+				fmt.Println("Incorrect args count. Expected at least 5 arguments: clientID orderID reason condition outcome [comment...]")
 				return
 			}
 
@@ -296,7 +418,16 @@ Example: refund-client 10 12`,
 				return
 			}
 
-			status, err := cli.postRequest("RefundClient", OrderCLientIDRequest{OrderID: int64(orderID), ClientID: clientID})
+			req := RefundClientRequest{
+				OrderID:             int64(orderID),
+				ClientID:            clientID,
+				Reason:              args[2],
+				InspectionCondition: args[3],
+				InspectionOutcome:   args[4],
+				Comment:             strings.Join(args[5:], " "),
+			}
+
+			status, err := cli.postRequest("RefundClient", req)
 			if err != nil || status != 200 {
 				fmt.Println("Error with order refund")
 				return
@@ -311,15 +442,17 @@ func (cli *CLI) ReturnGetRefundListCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "refund-list",
 		Short: "Get refund list",
-		Long: `Usage: refund-list [limit] [offset]
-Example 1, return all refunds: 			 order-list 10,
-Example 2, return n refunds: 			 order-list 10 10,
-Example 3, return n refunds with offset: order-list 10 10`,
+		Long: `Usage: refund-list [limit] [offset] [filter]
+Filters: awaitingReturn, returned
+Example 1, return all refunds: 			 refund-list,
+Example 2, return n refunds: 			 refund-list 10,
+Example 3, return n refunds with offset: refund-list 10 10,
+Example 4, return refunds awaiting return: refund-list 10 0 awaitingReturn`,
 		Run: func(cmd *cobra.Command, args []string) {
 			var err error
 
-			if len(args) > 2 {
-				fmt.Println("Incorrect args count. Expected 2 or less arguments: [limit] [offset]")
+			if len(args) > 3 {
+				fmt.Println("Incorrect args count. Expected 3 or less arguments: [limit] [offset] [filter]")
 				return
 			}
 
@@ -333,7 +466,7 @@ Example 3, return n refunds with offset: order-list 10 10`,
 				}
 			}
 
-			if len(args) == 2 {
+			if len(args) >= 2 {
 				offset, err = strconv.Atoi(args[1])
 				if err != nil {
 					fmt.Println("offset is incorrect")
@@ -345,6 +478,10 @@ Example 3, return n refunds with offset: order-list 10 10`,
 			params.Add("limit", fmt.Sprintf("%d", limit))
 			params.Add("offset", fmt.Sprintf("%d", offset))
 
+			if len(args) == 3 {
+				params.Add("filter", args[2])
+			}
+
 			refunds, err := cli.getRequest("RefundList", params)
 			if err != nil {
 				fmt.Println("Error while refund order")
@@ -359,6 +496,107 @@ Example 3, return n refunds with offset: order-list 10 10`,
 	}
 }
 
+func (cli *CLI) ReturnRefundsToCourierCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "return-refunds-courier",
+		Short: "Return refunded orders to seller via courier",
+		Long: `Usage: return-refunds-courier courier [orderIDs...]
+Example: return-refunds-courier courier-1 1 2 3`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) < 2 {
+				fmt.Println("Incorrect args count. Expected arguments: courier [orderIDs...]")
+				return
+			}
+
+			var orderIDs []int64
+
+			for i := 1; i < len(args); i++ {
+				orderID, err := strconv.Atoi(args[i])
+				if err != nil {
+					fmt.Println("One of orderIDs is incorrect")
+					return
+				}
+
+				orderIDs = append(orderIDs, int64(orderID))
+			}
+
+			status, err := cli.postRequest("ReturnRefundsToCourier", ReturnRefundsRequest{Courier: args[0], OrdersIDs: orderIDs})
+			if err != nil || status != 200 {
+				fmt.Println("Error returning refunds to courier")
+				return
+			}
+
+			fmt.Println("Refunds handed over to courier successfully")
+		},
+	}
+}
+
+func (cli *CLI) ReturnApproveRefundCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "approve-refund",
+		Short: "Approve or reject refund pending approval",
+		Long: `Usage: approve-refund orderID [reject]
+Example 1, approve refund: approve-refund 12
+Example 2, reject refund:  approve-refund 12 reject`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) < 1 || len(args) > 2 {
+				fmt.Println("Incorrect args count. Expected 1-2 arguments: orderID [reject]")
+				return
+			}
+
+			orderID, err := strconv.Atoi(args[0])
+			if err != nil {
+				fmt.Println("orderID is incorrect")
+				return
+			}
+
+			reject := len(args) == 2 && args[1] == "reject"
+
+			status, err := cli.postRequest("ApproveRefund", ApproveRefundRequest{OrderID: int64(orderID), Reject: reject})
+			if err != nil || status != 200 {
+				fmt.Println("Error with refund approval")
+				return
+			}
+
+			if reject {
+				fmt.Println("Refund has been rejected")
+				return
+			}
+
+			fmt.Println("Refund has been approved")
+		},
+	}
+}
+
+func (cli *CLI) ReturnArchiveOrdersCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "archive-orders",
+		Short: "Move completed orders into archive",
+		Long: `Usage: archive-orders
+Example: archive-orders`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 0 {
+				fmt.Println("Incorrect args count. Expected no arguments")
+				return
+			}
+
+			var resp ArchiveOrdersResponce
+
+			status, err := cli.postRequestWithResponse("ArchiveOrders", struct{}{}, &resp)
+			if err != nil || status != 200 {
+				fmt.Println("Error archiving orders")
+				return
+			}
+
+			if resp.Archived == "" {
+				resp.Archived = "0"
+			}
+
+			fmt.Println("Orders archived:", resp.Archived)
+		},
+	}
+}
+
 func (cli *CLI) ReturnSetGoroutinsCountCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "set-goroutines-count",
@@ -442,9 +680,10 @@ func shutdown(syncChan chan<- struct{}) {
 	close(syncChan)
 }
 
-func NewCLI(serviceURL string) *CLI {
+func NewCLI(serviceURL, operatorID string) *CLI {
 	CLI := &CLI{
 		serviceURL: serviceURL,
+		operatorID: operatorID,
 		rootCmd: &cobra.Command{
 			Use:   "homework",
 			Short: "A brief description of your application",
@@ -460,9 +699,14 @@ func NewCLI(serviceURL string) *CLI {
 	CLI.rootCmd.AddCommand(CLI.ReturnReceiveOrderFromCourierCmd())
 	CLI.rootCmd.AddCommand(CLI.ReturnReturnOrderToCourierCmd())
 	CLI.rootCmd.AddCommand(CLI.ReturnGiveOutOrderToClientCmd())
+	CLI.rootCmd.AddCommand(CLI.ReturnCheckoutClientCmd())
+	CLI.rootCmd.AddCommand(CLI.ReturnGiveOutItemsCmd())
 	CLI.rootCmd.AddCommand(CLI.ReturnGetOrderListCmd())
 	CLI.rootCmd.AddCommand(CLI.ReturnRefundFromCustomerCmd())
 	CLI.rootCmd.AddCommand(CLI.ReturnGetRefundListCmd())
+	CLI.rootCmd.AddCommand(CLI.ReturnRefundsToCourierCmd())
+	CLI.rootCmd.AddCommand(CLI.ReturnApproveRefundCmd())
+	CLI.rootCmd.AddCommand(CLI.ReturnArchiveOrdersCmd())
 	CLI.rootCmd.AddCommand(CLI.ReturnSetGoroutinsCountCmd())
 	return CLI
 }
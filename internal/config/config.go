@@ -4,16 +4,39 @@ import (
 	"flag"
 	"log"
 	"os"
+	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
 )
 
+const (
+	StoragePostgres = "postgres"
+	StorageMemory   = "memory"
+	StorageSQLite   = "sqlite"
+)
+
+const (
+	CacheMemory = "memory"
+	CacheRedis  = "redis"
+)
+
 type Config struct {
-	PG    `yaml:"postgres"`
-	GRPC  `yaml:"grpc"`
-	HTTP  `yaml:"http"`
-	Admin `yaml:"admin"`
-	Kafka `yaml:"kafka"`
+	Storage string `yaml:"storage" env-default:"postgres"`
+
+	PG       `yaml:"postgres"`
+	Replicas `yaml:"replicas"`
+	SQLite   `yaml:"sqlite"`
+	GRPC     `yaml:"grpc"`
+	HTTP     `yaml:"http"`
+	Admin    `yaml:"admin"`
+	Kafka    `yaml:"kafka"`
+	Operator `yaml:"operator"`
+
+	RefundScoring `yaml:"refund_scoring"`
+	Idempotency   `yaml:"idempotency"`
+	Archive       `yaml:"archive"`
+	Cache         `yaml:"cache"`
+	Migrations    `yaml:"migrations"`
 }
 
 type PG struct {
@@ -24,6 +47,20 @@ type PG struct {
 	Password string `yaml:"password"`
 }
 
+// Replicas serve heavy list reads, an empty list keeps every query on primary.
+// ReadAfterWrite keeps reads on primary after writes of the same instance only,
+// writes of other instances reach its reads within MaxLag
+type Replicas struct {
+	DSNs                []string      `yaml:"dsns"`
+	HealthCheckInterval time.Duration `yaml:"health_check_interval" env-default:"5s"`
+	MaxLag              time.Duration `yaml:"max_lag" env-default:"5s"`
+	ReadAfterWrite      time.Duration `yaml:"read_after_write" env-default:"5s"`
+}
+
+type SQLite struct {
+	Path string `yaml:"path" env-default:"pvz.db"`
+}
+
 type GRPC struct {
 	Host string `yaml:"host"`
 }
@@ -38,6 +75,117 @@ type Admin struct {
 
 type Kafka struct {
 	Brokers []string `yaml:"brokers"`
+	// InstanceID tells events of this replica from others and names its cache invalidation group,
+	// it must be unique among replicas and stay the same across restarts. When empty, id is generated
+	// on the first start and kept in InstanceIDPath, replicas sharing the path must set their own ids
+	InstanceID     string `yaml:"instance_id" env:"INSTANCE_ID"`
+	InstanceIDPath string `yaml:"instance_id_path" env-default:"data/instance-id"`
+}
+
+type RefundScoring struct {
+	Window              time.Duration `yaml:"window"`
+	MinIssued           int           `yaml:"min_issued"`
+	MaxRefundRatio      float64       `yaml:"max_refund_ratio"`
+	MaxRefunds          int           `yaml:"max_refunds"`
+	HighValueCost       int           `yaml:"high_value_cost"`
+	MaxHighValueRefunds int           `yaml:"max_high_value_refunds"`
+	RequireApproval     bool          `yaml:"require_approval"`
+	MaxClients          int           `yaml:"max_clients" env-default:"100000"`
+	CleanupInterval     time.Duration `yaml:"cleanup_interval" env-default:"1h"`
+}
+
+// Operator.DefaultID is put into audit log for mutating calls without x-operator-id header,
+// it lets old clients work while they move to the header, empty id rejects such calls.
+// Supervisors lists operator ids granted supervisor role, the role is never taken from the request
+type Operator struct {
+	DefaultID   string   `yaml:"default_id"`
+	Supervisors []string `yaml:"supervisors"`
+}
+
+// Idempotency.Lease bounds a pending key, so a key left by a crashed replica is taken over
+// after it instead of TTL. It must outlast the longest mutating request
+type Idempotency struct {
+	TTL             time.Duration `yaml:"ttl" env-default:"24h"`
+	Lease           time.Duration `yaml:"lease" env-default:"1m"`
+	CleanupInterval time.Duration `yaml:"cleanup_interval" env-default:"1h"`
+}
+
+type Archive struct {
+	Enabled   bool          `yaml:"enabled"`
+	Interval  time.Duration `yaml:"interval" env-default:"1h"`
+	OlderThan time.Duration `yaml:"older_than" env-default:"720h"`
+	BatchSize int           `yaml:"batch_size" env-default:"500"`
+}
+
+// Cache limits order cache, zero max entries or max bytes is not applied.
+// Shards, limits and janitor apply to memory backend, redis evicts by its own policy
+type Cache struct {
+	Backend         string        `yaml:"backend" env-default:"memory"`
+	TTL             time.Duration `yaml:"ttl" env-default:"1h"`
+	Shards          int           `yaml:"shards" env-default:"16"`
+	MaxEntries      int           `yaml:"max_entries" env-default:"100000"`
+	MaxBytes        int64         `yaml:"max_bytes"`
+	JanitorInterval time.Duration `yaml:"janitor_interval" env-default:"1m"`
+
+	Redis        Redis             `yaml:"redis"`
+	Invalidation CacheInvalidation `yaml:"invalidation"`
+	Lists        CacheLists        `yaml:"lists"`
+	NotFound     CacheNotFound     `yaml:"not_found"`
+	WarmUp       CacheWarmUp       `yaml:"warm_up"`
+	Snapshot     CacheSnapshot     `yaml:"snapshot"`
+}
+
+// CacheNotFound remembers unknown order ids in memory of every replica for a short ttl
+type CacheNotFound struct {
+	Enabled    bool          `yaml:"enabled"`
+	TTL        time.Duration `yaml:"ttl" env-default:"10s"`
+	MaxEntries int           `yaml:"max_entries" env-default:"10000"`
+}
+
+// CacheWarmUp preloads orders awaiting pickup into the cache before serving
+type CacheWarmUp struct {
+	Enabled   bool `yaml:"enabled"`
+	BatchSize int  `yaml:"batch_size" env-default:"1000"`
+}
+
+// CacheSnapshot saves memory cache to a local file every interval and on shutdown,
+// snapshot is restored on startup with orders expired meanwhile skipped.
+// Restored orders keep their ttl, changes made by other replicas meanwhile are caught up by cache invalidation.
+// Non-zero RestoreTTL caps it for setups without invalidation
+type CacheSnapshot struct {
+	Enabled    bool          `yaml:"enabled"`
+	Path       string        `yaml:"path" env-default:"data/order-cache.gob"`
+	Interval   time.Duration `yaml:"interval" env-default:"5m"`
+	RestoreTTL time.Duration `yaml:"restore_ttl"`
+}
+
+// CacheLists keeps client order lists and refund pages in memory of every replica,
+// with redis backend lists changed by other replicas are refreshed only by ttl
+type CacheLists struct {
+	Enabled    bool          `yaml:"enabled"`
+	TTL        time.Duration `yaml:"ttl" env-default:"30s"`
+	MaxEntries int           `yaml:"max_entries" env-default:"10000"`
+}
+
+// Redis is shared by service replicas, so they do not serve each other stale orders
+type Redis struct {
+	Addr      string `yaml:"addr" env-default:"localhost:6379"`
+	Password  string `yaml:"password" env:"REDIS_PASSWORD"`
+	DB        int    `yaml:"db"`
+	KeyPrefix string `yaml:"key_prefix" env-default:"pvz:order:"`
+}
+
+// CacheInvalidation evicts orders changed by other replicas from memory cache,
+// every replica consumes the topic in its own group named after its instance id,
+// so after restart it resumes from the committed offset and catches up with changes it missed
+type CacheInvalidation struct {
+	Enabled     bool   `yaml:"enabled"`
+	Topic       string `yaml:"topic" env-default:"pvz.events-log"`
+	GroupPrefix string `yaml:"group_prefix" env-default:"pvz-service-cache"`
+}
+
+type Migrations struct {
+	AutoMigrate bool `yaml:"auto_migrate" env:"AUTO_MIGRATE"`
 }
 
 func MustLoad() *Config {
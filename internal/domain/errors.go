@@ -11,4 +11,19 @@ var (
 	ErrStoreTimeExpired = errors.New("store time expired")
 	ErrAlreadyPackaged  = errors.New("order already packaged")
 	ErrPackageTooHeavy  = errors.New("order too heavy")
+
+	ErrInvalidRefundReason        = errors.New("invalid refund reason")
+	ErrInvalidInspectionCondition = errors.New("invalid inspection condition")
+	ErrInvalidInspectionOutcome   = errors.New("invalid inspection outcome")
+
+	ErrInvalidItemSKU      = errors.New("invalid item SKU")
+	ErrInvalidItemQuantity = errors.New("invalid item quantity")
+	ErrInvalidItemPrice    = errors.New("invalid item price")
+	ErrDuplicateItemSKU    = errors.New("duplicate item SKU")
+	ErrItemsCostMismatch   = errors.New("items cost mismatch")
+	ErrOrderHasNoItems     = errors.New("order has no items")
+	ErrItemNotFound        = errors.New("item not found")
+	ErrItemStatusMismatch  = errors.New("item status mismatch")
+
+	ErrConcurrentModification = errors.New("order was modified concurrently")
 )
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/Na322Pr/route256/internal/clock"
 	"github.com/Na322Pr/route256/internal/dto"
 )
 
@@ -17,6 +18,10 @@ const (
 	OrderStatusPickedUp
 	OrderStatusRefunded
 	OrderStatusDelete
+	OrderStatusPendingApproval
+	OrderStatusReturnedToSeller
+	OrderStatusRefusedAtPickup
+	OrderStatusPartiallyRefunded
 )
 
 type OrderStatusEntry struct {
@@ -30,6 +35,10 @@ var orderStatusEntries = []OrderStatusEntry{
 	{OrderStatusPickedUp, "pickedUp"},
 	{OrderStatusRefunded, "refunded"},
 	{OrderStatusDelete, "deleted"},
+	{OrderStatusPendingApproval, "pendingApproval"},
+	{OrderStatusReturnedToSeller, "returnedToSeller"},
+	{OrderStatusRefusedAtPickup, "refusedAtPickup"},
+	{OrderStatusPartiallyRefunded, "partiallyRefunded"},
 }
 
 var OrderStatusMap = make(map[OrderStatus]string)
@@ -60,7 +69,86 @@ var orderPackageEntries = []OrderPackageEntry{
 var OrderPackageMap = make(map[OrderPackage]string)
 var OrderPackageStringMap = make(map[string]OrderPackage)
 
-// Set up Status and Package convertion maps
+// Refund reason
+type RefundReason int
+
+const (
+	RefundReasonUnknown RefundReason = iota
+	RefundReasonDefect
+	RefundReasonWrongItem
+	RefundReasonChangedMind
+	RefundReasonNotAsDescribed
+	RefundReasonDamagedInTransit
+	RefundReasonOther
+)
+
+type RefundReasonEntry struct {
+	Reason RefundReason
+	Name   string
+}
+
+var refundReasonEntries = []RefundReasonEntry{
+	{RefundReasonUnknown, ""},
+	{RefundReasonDefect, "defect"},
+	{RefundReasonWrongItem, "wrongItem"},
+	{RefundReasonChangedMind, "changedMind"},
+	{RefundReasonNotAsDescribed, "notAsDescribed"},
+	{RefundReasonDamagedInTransit, "damagedInTransit"},
+	{RefundReasonOther, "other"},
+}
+
+var RefundReasonMap = make(map[RefundReason]string)
+var RefundReasonStringMap = make(map[string]RefundReason)
+
+// Inspection condition
+type InspectionCondition int
+
+const (
+	InspectionConditionUnknown InspectionCondition = iota
+	InspectionConditionIntact
+	InspectionConditionDamaged
+	InspectionConditionIncomplete
+)
+
+type InspectionConditionEntry struct {
+	Condition InspectionCondition
+	Name      string
+}
+
+var inspectionConditionEntries = []InspectionConditionEntry{
+	{InspectionConditionUnknown, ""},
+	{InspectionConditionIntact, "intact"},
+	{InspectionConditionDamaged, "damaged"},
+	{InspectionConditionIncomplete, "incomplete"},
+}
+
+var InspectionConditionMap = make(map[InspectionCondition]string)
+var InspectionConditionStringMap = make(map[string]InspectionCondition)
+
+// Inspection outcome
+type InspectionOutcome int
+
+const (
+	InspectionOutcomeUnknown InspectionOutcome = iota
+	InspectionOutcomeAccepted
+	InspectionOutcomeRejected
+)
+
+type InspectionOutcomeEntry struct {
+	Outcome InspectionOutcome
+	Name    string
+}
+
+var inspectionOutcomeEntries = []InspectionOutcomeEntry{
+	{InspectionOutcomeUnknown, ""},
+	{InspectionOutcomeAccepted, "accepted"},
+	{InspectionOutcomeRejected, "rejected"},
+}
+
+var InspectionOutcomeMap = make(map[InspectionOutcome]string)
+var InspectionOutcomeStringMap = make(map[string]InspectionOutcome)
+
+// Set up Status, Package and Refund convertion maps
 func init() {
 	for _, entry := range orderStatusEntries {
 		OrderStatusMap[entry.Status] = entry.Name
@@ -71,6 +159,21 @@ func init() {
 		OrderPackageMap[entry.Package] = entry.Name
 		OrderPackageStringMap[entry.Name] = entry.Package
 	}
+
+	for _, entry := range refundReasonEntries {
+		RefundReasonMap[entry.Reason] = entry.Name
+		RefundReasonStringMap[entry.Name] = entry.Reason
+	}
+
+	for _, entry := range inspectionConditionEntries {
+		InspectionConditionMap[entry.Condition] = entry.Name
+		InspectionConditionStringMap[entry.Name] = entry.Condition
+	}
+
+	for _, entry := range inspectionOutcomeEntries {
+		InspectionOutcomeMap[entry.Outcome] = entry.Name
+		InspectionOutcomeStringMap[entry.Name] = entry.Outcome
+	}
 }
 
 // Package Options Builder
@@ -140,9 +243,19 @@ type Order struct {
 	weight     int
 	packages   []OrderPackage
 	pickUpTime time.Time
+
+	refundReason        RefundReason
+	refundComment       string
+	inspectionCondition InspectionCondition
+	inspectionOutcome   InspectionOutcome
+
+	items []OrderItem
+
+	version int64
 }
 
-func NewOrder(orderDTO dto.AddOrder) (*Order, error) {
+// NewOrder validates order received from courier, store until time is checked against clk
+func NewOrder(orderDTO dto.AddOrder, clk clock.Clock) (*Order, error) {
 	op := "Order.NewOrder"
 
 	order := Order{}
@@ -155,7 +268,7 @@ func NewOrder(orderDTO dto.AddOrder) (*Order, error) {
 		return nil, err
 	}
 
-	if orderDTO.StoreUntil.Before(time.Now()) {
+	if orderDTO.StoreUntil.Before(clk.Now()) {
 		return nil, ErrStoreTimeExpired
 	}
 	order.SetStoreUntil(orderDTO.StoreUntil)
@@ -168,6 +281,21 @@ func NewOrder(orderDTO dto.AddOrder) (*Order, error) {
 		return nil, err
 	}
 
+	for _, itemDTO := range orderDTO.Items {
+		item, err := NewOrderItem(itemDTO)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		if err := order.AddItem(*item); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if order.HasItems() && order.GetItemsAmount(OrderItemStatusReceived) != order.GetOrderCost() {
+		return nil, fmt.Errorf("%s: %w", op, ErrItemsCostMismatch)
+	}
+
 	for _, orderPackage := range orderDTO.Packages {
 		opt := OrderPackageOptions[OrderPackageStringMap[orderPackage]]
 
@@ -231,6 +359,29 @@ func (o *Order) SetPickUpTime(pickUpTime time.Time) {
 	o.pickUpTime = pickUpTime
 }
 
+func (o *Order) SetRefundInspection(reason, comment, condition, outcome string) error {
+	refundReason, ok := RefundReasonStringMap[reason]
+	if !ok || refundReason == RefundReasonUnknown {
+		return ErrInvalidRefundReason
+	}
+
+	inspectionCondition, ok := InspectionConditionStringMap[condition]
+	if !ok || inspectionCondition == InspectionConditionUnknown {
+		return ErrInvalidInspectionCondition
+	}
+
+	inspectionOutcome, ok := InspectionOutcomeStringMap[outcome]
+	if !ok || inspectionOutcome == InspectionOutcomeUnknown {
+		return ErrInvalidInspectionOutcome
+	}
+
+	o.refundReason = refundReason
+	o.refundComment = comment
+	o.inspectionCondition = inspectionCondition
+	o.inspectionOutcome = inspectionOutcome
+	return nil
+}
+
 func (o *Order) SetCost(cost int) error {
 	if cost < 0 {
 		return ErrInvalidCost
@@ -288,6 +439,35 @@ func (o *Order) GetOrderPickUpTime() time.Time {
 	return o.pickUpTime
 }
 
+func (o *Order) GetRefundReason() string {
+	return RefundReasonMap[o.refundReason]
+}
+
+func (o *Order) GetRefundComment() string {
+	return o.refundComment
+}
+
+func (o *Order) GetInspectionCondition() string {
+	return InspectionConditionMap[o.inspectionCondition]
+}
+
+func (o *Order) GetInspectionOutcome() string {
+	return InspectionOutcomeMap[o.inspectionOutcome]
+}
+
+func (o *Order) RefundRejected() bool {
+	return o.inspectionOutcome == InspectionOutcomeRejected
+}
+
+func (o *Order) GetVersion() int64 {
+	return o.version
+}
+
+// IncrementVersion follows successful storage update, which bumps stored version
+func (o *Order) IncrementVersion() {
+	o.version++
+}
+
 // DTO Conversion
 func (o *Order) ToDTO() *dto.OrderDTO {
 	orderDTO := dto.OrderDTO{
@@ -297,13 +477,36 @@ func (o *Order) ToDTO() *dto.OrderDTO {
 		Status:     OrderStatusMap[o.status],
 		Cost:       o.cost,
 		Weight:     o.weight,
-		PickUpTime: sql.NullTime{Time: o.pickUpTime, Valid: true},
+		PickUpTime: sql.NullTime{Time: o.pickUpTime, Valid: !o.pickUpTime.IsZero()},
+
+		RefundReason:        RefundReasonMap[o.refundReason],
+		RefundComment:       o.refundComment,
+		InspectionCondition: InspectionConditionMap[o.inspectionCondition],
+		InspectionOutcome:   InspectionOutcomeMap[o.inspectionOutcome],
+
+		Version: o.version,
 	}
 
 	for _, packageType := range o.packages {
 		orderDTO.Packages = append(orderDTO.Packages, OrderPackageMap[packageType])
 	}
 
+	for _, item := range o.items {
+		orderDTO.Items = append(orderDTO.Items, item.ToDTO(o.id))
+	}
+
+	switch o.status {
+	case OrderStatusRefunded, OrderStatusPartiallyRefunded, OrderStatusPendingApproval, OrderStatusReturnedToSeller:
+		orderDTO.PaidAmount = o.GetPaidAmount()
+		orderDTO.RefundAmount = o.GetRefundAmount()
+	case OrderStatusPickedUp:
+		orderDTO.PaidAmount = o.GetPaidAmount()
+		// client may keep some items of a refunded order after courier took the rest
+		if o.HasItems() {
+			orderDTO.RefundAmount = o.GetRefundAmount()
+		}
+	}
+
 	return &orderDTO
 }
 
@@ -325,6 +528,7 @@ func (o *Order) FromDTO(orderDTO dto.OrderDTO) error {
 	}
 
 	o.SetStoreUntil(orderDTO.StoreUntil)
+	o.version = orderDTO.Version
 
 	if orderDTO.PickUpTime.Valid {
 		o.SetPickUpTime(orderDTO.PickUpTime.Time)
@@ -342,5 +546,17 @@ func (o *Order) FromDTO(orderDTO dto.OrderDTO) error {
 		}
 	}
 
+	o.refundReason = RefundReasonStringMap[orderDTO.RefundReason]
+	o.refundComment = orderDTO.RefundComment
+	o.inspectionCondition = InspectionConditionStringMap[orderDTO.InspectionCondition]
+	o.inspectionOutcome = InspectionOutcomeStringMap[orderDTO.InspectionOutcome]
+
+	o.items = nil
+	for _, itemDTO := range orderDTO.Items {
+		var item OrderItem
+		item.FromDTO(itemDTO)
+		o.items = append(o.items, item)
+	}
+
 	return nil
 }
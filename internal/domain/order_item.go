@@ -0,0 +1,369 @@
+package domain
+
+import (
+	"github.com/Na322Pr/route256/internal/dto"
+)
+
+// Item status
+type OrderItemStatus int
+
+const (
+	OrderItemStatusUnknown OrderItemStatus = iota
+	OrderItemStatusReceived
+	OrderItemStatusPickedUp
+	OrderItemStatusRefused
+	OrderItemStatusRefunded
+	OrderItemStatusReturned
+	OrderItemStatusRefundPending
+	OrderItemStatusRefundReturned
+)
+
+type OrderItemStatusEntry struct {
+	Status OrderItemStatus
+	Name   string
+}
+
+var orderItemStatusEntries = []OrderItemStatusEntry{
+	{OrderItemStatusUnknown, "unknown"},
+	{OrderItemStatusReceived, "received"},
+	{OrderItemStatusPickedUp, "pickedUp"},
+	{OrderItemStatusRefused, "refused"},
+	{OrderItemStatusRefunded, "refunded"},
+	{OrderItemStatusReturned, "returned"},
+	{OrderItemStatusRefundPending, "refundPending"},
+	{OrderItemStatusRefundReturned, "refundReturned"},
+}
+
+var OrderItemStatusMap = make(map[OrderItemStatus]string)
+var OrderItemStatusStringMap = make(map[string]OrderItemStatus)
+
+func init() {
+	for _, entry := range orderItemStatusEntries {
+		OrderItemStatusMap[entry.Status] = entry.Name
+		OrderItemStatusStringMap[entry.Name] = entry.Status
+	}
+}
+
+// Order item
+type OrderItem struct {
+	sku       string
+	name      string
+	quantity  int
+	unitPrice int
+	status    OrderItemStatus
+}
+
+func NewOrderItem(itemDTO dto.AddOrderItem) (*OrderItem, error) {
+	if itemDTO.SKU == "" {
+		return nil, ErrInvalidItemSKU
+	}
+
+	if itemDTO.Quantity <= 0 {
+		return nil, ErrInvalidItemQuantity
+	}
+
+	if itemDTO.UnitPrice < 0 {
+		return nil, ErrInvalidItemPrice
+	}
+
+	return &OrderItem{
+		sku:       itemDTO.SKU,
+		name:      itemDTO.Name,
+		quantity:  itemDTO.Quantity,
+		unitPrice: itemDTO.UnitPrice,
+		status:    OrderItemStatusReceived,
+	}, nil
+}
+
+func (i *OrderItem) GetSKU() string {
+	return i.sku
+}
+
+func (i *OrderItem) GetStatus() string {
+	return OrderItemStatusMap[i.status]
+}
+
+func (i *OrderItem) Amount() int {
+	return i.quantity * i.unitPrice
+}
+
+func (i *OrderItem) ToDTO(orderID int64) dto.OrderItemDTO {
+	return dto.OrderItemDTO{
+		OrderID:   orderID,
+		SKU:       i.sku,
+		Name:      i.name,
+		Quantity:  i.quantity,
+		UnitPrice: i.unitPrice,
+		Status:    OrderItemStatusMap[i.status],
+	}
+}
+
+func (i *OrderItem) FromDTO(itemDTO dto.OrderItemDTO) {
+	i.sku = itemDTO.SKU
+	i.name = itemDTO.Name
+	i.quantity = itemDTO.Quantity
+	i.unitPrice = itemDTO.UnitPrice
+	i.status = OrderItemStatusStringMap[itemDTO.Status]
+}
+
+// Order items operations
+func (o *Order) AddItem(item OrderItem) error {
+	for _, existing := range o.items {
+		if existing.sku == item.sku {
+			return ErrDuplicateItemSKU
+		}
+	}
+
+	o.items = append(o.items, item)
+	return nil
+}
+
+func (o *Order) HasItems() bool {
+	return len(o.items) != 0
+}
+
+func (o *Order) GetOrderItems() []OrderItem {
+	return o.items
+}
+
+// GiveOutAll marks every received item as picked up
+func (o *Order) GiveOutAll() {
+	for i := range o.items {
+		if o.items[i].status == OrderItemStatusReceived {
+			o.items[i].status = OrderItemStatusPickedUp
+		}
+	}
+}
+
+// RefuseAll marks every received item as refused
+func (o *Order) RefuseAll() {
+	for i := range o.items {
+		if o.items[i].status == OrderItemStatusReceived {
+			o.items[i].status = OrderItemStatusRefused
+		}
+	}
+}
+
+// GiveOutItems marks listed items as picked up and the rest as refused
+func (o *Order) GiveOutItems(skus []string) error {
+	if !o.HasItems() {
+		return ErrOrderHasNoItems
+	}
+
+	if len(skus) == 0 {
+		return ErrItemNotFound
+	}
+
+	kept := make(map[string]struct{}, len(skus))
+	for _, sku := range skus {
+		if o.itemIndex(sku) < 0 {
+			return ErrItemNotFound
+		}
+		kept[sku] = struct{}{}
+	}
+
+	for i := range o.items {
+		if o.items[i].status != OrderItemStatusReceived {
+			return ErrItemStatusMismatch
+		}
+	}
+
+	for i := range o.items {
+		if _, ok := kept[o.items[i].sku]; ok {
+			o.items[i].status = OrderItemStatusPickedUp
+			continue
+		}
+		o.items[i].status = OrderItemStatusRefused
+	}
+
+	return nil
+}
+
+// RefundItems marks listed picked up items as waiting for refund, all of them if skus is empty.
+// Refund is completed by ConfirmItemsRefund or cancelled by CancelItemsRefund
+func (o *Order) RefundItems(skus []string) error {
+	if !o.HasItems() {
+		if len(skus) != 0 {
+			return ErrOrderHasNoItems
+		}
+		return nil
+	}
+
+	if len(skus) == 0 {
+		for i := range o.items {
+			if o.items[i].status == OrderItemStatusPickedUp {
+				skus = append(skus, o.items[i].sku)
+			}
+		}
+	}
+
+	for _, sku := range skus {
+		idx := o.itemIndex(sku)
+		if idx < 0 {
+			return ErrItemNotFound
+		}
+
+		if o.items[idx].status != OrderItemStatusPickedUp {
+			return ErrItemStatusMismatch
+		}
+	}
+
+	for _, sku := range skus {
+		o.items[o.itemIndex(sku)].status = OrderItemStatusRefundPending
+	}
+
+	return nil
+}
+
+// ConfirmItemsRefund marks items waiting for refund as refunded and returns them
+func (o *Order) ConfirmItemsRefund() []OrderItem {
+	var refunded []OrderItem
+	for i := range o.items {
+		if o.items[i].status == OrderItemStatusRefundPending {
+			o.items[i].status = OrderItemStatusRefunded
+			refunded = append(refunded, o.items[i])
+		}
+	}
+
+	return refunded
+}
+
+// RefundStatus is the order status after refund, partially refunded while client keeps some items
+func (o *Order) RefundStatus() OrderStatus {
+	if o.KeepsItems() {
+		return OrderStatusPartiallyRefunded
+	}
+
+	return OrderStatusRefunded
+}
+
+// HeldStatus is the order status when refund is rejected, partially refunded
+// while items of an earlier refund wait for courier
+func (o *Order) HeldStatus() OrderStatus {
+	for i := range o.items {
+		if o.items[i].status == OrderItemStatusRefunded {
+			return OrderStatusPartiallyRefunded
+		}
+	}
+
+	return OrderStatusPickedUp
+}
+
+// KeepsItems reports whether client still holds some of picked up items
+func (o *Order) KeepsItems() bool {
+	for i := range o.items {
+		if o.items[i].status == OrderItemStatusPickedUp {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ReturnRefundedItems marks refunded items and items refused at pick up as handed over to courier,
+// items kept by client are left as they are
+func (o *Order) ReturnRefundedItems() {
+	for i := range o.items {
+		switch o.items[i].status {
+		case OrderItemStatusRefunded:
+			o.items[i].status = OrderItemStatusRefundReturned
+		case OrderItemStatusRefused:
+			o.items[i].status = OrderItemStatusReturned
+		}
+	}
+}
+
+// HasRefusedItems reports whether items refused at pick up are still kept at the pick up point
+func (o *Order) HasRefusedItems() bool {
+	for i := range o.items {
+		if o.items[i].status == OrderItemStatusRefused {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ReturnRefusedItems marks items refused at pick up as returned to courier
+func (o *Order) ReturnRefusedItems() error {
+	if !o.HasRefusedItems() {
+		return ErrItemStatusMismatch
+	}
+
+	for i := range o.items {
+		if o.items[i].status == OrderItemStatusRefused {
+			o.items[i].status = OrderItemStatusReturned
+		}
+	}
+
+	return nil
+}
+
+// CancelItemsRefund returns items waiting for refund back to picked up
+func (o *Order) CancelItemsRefund() {
+	for i := range o.items {
+		if o.items[i].status == OrderItemStatusRefundPending {
+			o.items[i].status = OrderItemStatusPickedUp
+		}
+	}
+}
+
+func (o *Order) GetItemsAmount(statuses ...OrderItemStatus) int {
+	amount := 0
+
+	for _, item := range o.items {
+		for _, status := range statuses {
+			if item.status == status {
+				amount += item.Amount()
+				break
+			}
+		}
+	}
+
+	return amount
+}
+
+// GetPaidAmount returns cost of picked up items including packaging
+func (o *Order) GetPaidAmount() int {
+	if !o.HasItems() {
+		return o.cost
+	}
+
+	packaging := o.cost - o.GetItemsAmount(
+		OrderItemStatusReceived,
+		OrderItemStatusPickedUp,
+		OrderItemStatusRefused,
+		OrderItemStatusRefunded,
+		OrderItemStatusReturned,
+		OrderItemStatusRefundPending,
+		OrderItemStatusRefundReturned,
+	)
+
+	return packaging + o.GetItemsAmount(OrderItemStatusPickedUp) + o.GetItemsAmount(refundItemStatuses...)
+}
+
+// GetRefundAmount returns cost of refunded items, packaging is not refundable
+func (o *Order) GetRefundAmount() int {
+	if !o.HasItems() {
+		return o.cost
+	}
+
+	return o.GetItemsAmount(refundItemStatuses...)
+}
+
+// refundItemStatuses are statuses of items paid and then refunded
+var refundItemStatuses = []OrderItemStatus{
+	OrderItemStatusRefundPending,
+	OrderItemStatusRefunded,
+	OrderItemStatusRefundReturned,
+}
+
+func (o *Order) itemIndex(sku string) int {
+	for i := range o.items {
+		if o.items[i].sku == sku {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,166 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Na322Pr/route256/internal/clock"
+	"github.com/Na322Pr/route256/internal/dto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newOrderWithItems(t *testing.T) *Order {
+	t.Helper()
+
+	order, err := NewOrder(dto.AddOrder{
+		ID:         1,
+		ClientID:   1,
+		StoreUntil: time.Now().Add(48 * time.Hour),
+		Cost:       1000,
+		Weight:     5,
+		Items: []dto.AddOrderItem{
+			{SKU: "phone", Quantity: 1, UnitPrice: 700},
+			{SKU: "case", Quantity: 2, UnitPrice: 150},
+		},
+	}, clock.Real)
+	require.NoError(t, err)
+
+	return order
+}
+
+func TestNewOrder_Items(t *testing.T) {
+	tests := []struct {
+		name    string
+		items   []dto.AddOrderItem
+		wantErr error
+	}{
+		{
+			name: "ErrorItemsCostMismatch",
+			items: []dto.AddOrderItem{
+				{SKU: "phone", Quantity: 1, UnitPrice: 700},
+			},
+			wantErr: ErrItemsCostMismatch,
+		},
+		{
+			name: "ErrorDuplicateItemSKU",
+			items: []dto.AddOrderItem{
+				{SKU: "phone", Quantity: 1, UnitPrice: 500},
+				{SKU: "phone", Quantity: 1, UnitPrice: 500},
+			},
+			wantErr: ErrDuplicateItemSKU,
+		},
+		{
+			name: "ErrorInvalidItemQuantity",
+			items: []dto.AddOrderItem{
+				{SKU: "phone", Quantity: 0, UnitPrice: 1000},
+			},
+			wantErr: ErrInvalidItemQuantity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewOrder(dto.AddOrder{
+				ID:         1,
+				ClientID:   1,
+				StoreUntil: time.Now().Add(48 * time.Hour),
+				Cost:       1000,
+				Weight:     5,
+				Items:      tt.items,
+			}, clock.Real)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestOrder_GiveOutItems(t *testing.T) {
+	t.Parallel()
+
+	order := newOrderWithItems(t)
+
+	assert.ErrorIs(t, order.GiveOutItems([]string{"charger"}), ErrItemNotFound)
+	require.NoError(t, order.GiveOutItems([]string{"phone"}))
+
+	statuses := make(map[string]string)
+	for _, item := range order.GetOrderItems() {
+		statuses[item.GetSKU()] = item.GetStatus()
+	}
+
+	assert.Equal(t, map[string]string{"phone": "pickedUp", "case": "refused"}, statuses)
+	assert.Equal(t, 700, order.GetPaidAmount())
+	assert.ErrorIs(t, order.GiveOutItems([]string{"phone"}), ErrItemStatusMismatch)
+}
+
+func TestOrder_RefundItems(t *testing.T) {
+	t.Parallel()
+
+	order := newOrderWithItems(t)
+	order.SetStatus(OrderStatusPickedUp)
+	order.GiveOutAll()
+
+	assert.ErrorIs(t, order.RefundItems([]string{"charger"}), ErrItemNotFound)
+	require.NoError(t, order.RefundItems([]string{"case"}))
+	assert.Equal(t, 300, order.GetRefundAmount())
+	assert.ErrorIs(t, order.RefundItems([]string{"case"}), ErrItemStatusMismatch)
+
+	assert.Equal(t, OrderStatusPartiallyRefunded, order.RefundStatus())
+
+	order.CancelItemsRefund()
+	assert.Equal(t, 0, order.GetRefundAmount())
+
+	require.NoError(t, order.RefundItems(nil))
+	assert.Equal(t, 1000, order.GetRefundAmount())
+	assert.Equal(t, OrderStatusRefunded, order.RefundStatus())
+
+	refunded := order.ConfirmItemsRefund()
+	assert.Len(t, refunded, 2)
+	assert.Empty(t, order.ConfirmItemsRefund())
+	assert.Equal(t, 1000, order.GetRefundAmount())
+}
+
+func TestOrder_ReturnRefundedItems(t *testing.T) {
+	t.Parallel()
+
+	order := newOrderWithItems(t)
+	order.SetStatus(OrderStatusPickedUp)
+	require.NoError(t, order.GiveOutItems([]string{"phone", "case"}))
+
+	// the first refund waits for courier, the second one is rejected
+	require.NoError(t, order.RefundItems([]string{"case"}))
+	order.ConfirmItemsRefund()
+	require.NoError(t, order.RefundItems([]string{"phone"}))
+	order.CancelItemsRefund()
+	assert.Equal(t, OrderStatusPartiallyRefunded, order.HeldStatus())
+
+	order.ReturnRefundedItems()
+	assert.True(t, order.KeepsItems())
+	assert.Equal(t, OrderStatusPickedUp, order.HeldStatus())
+
+	statuses := make(map[string]string)
+	for _, item := range order.GetOrderItems() {
+		statuses[item.GetSKU()] = item.GetStatus()
+	}
+	assert.Equal(t, map[string]string{"phone": "pickedUp", "case": "refundReturned"}, statuses)
+	assert.Equal(t, 1000, order.GetPaidAmount())
+	assert.Equal(t, 300, order.GetRefundAmount())
+}
+
+func TestOrder_ReturnRefusedItems(t *testing.T) {
+	t.Parallel()
+
+	order := newOrderWithItems(t)
+	assert.False(t, order.HasRefusedItems())
+	assert.ErrorIs(t, order.ReturnRefusedItems(), ErrItemStatusMismatch)
+
+	require.NoError(t, order.GiveOutItems([]string{"phone"}))
+	order.SetStatus(OrderStatusPickedUp)
+	require.True(t, order.HasRefusedItems())
+
+	require.NoError(t, order.ReturnRefusedItems())
+	assert.False(t, order.HasRefusedItems())
+	assert.Equal(t, "returned", order.GetOrderItems()[1].GetStatus())
+	assert.Equal(t, "pickedUp", order.GetOrderStatus())
+	assert.Equal(t, 700, order.GetPaidAmount())
+}
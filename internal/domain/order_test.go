@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Na322Pr/route256/internal/clock"
 	"github.com/Na322Pr/route256/internal/dto"
 	"github.com/stretchr/testify/assert"
 )
@@ -15,7 +16,9 @@ func TestNewOrder(t *testing.T) {
 		domainError error
 	}
 
-	successStoreTime := time.Now().Add(48 * time.Hour)
+	now := time.Date(2024, time.October, 1, 12, 0, 0, 0, time.UTC)
+	clk := clock.NewFake(now)
+	successStoreTime := now.Add(48 * time.Hour)
 
 	tests := []struct {
 		name    string
@@ -131,7 +134,7 @@ func TestNewOrder(t *testing.T) {
 				orderDTO: dto.AddOrder{
 					ID:         1,
 					ClientID:   1,
-					StoreUntil: time.Now(),
+					StoreUntil: now.Add(-time.Second),
 					Cost:       1000,
 					Weight:     5,
 				},
@@ -145,7 +148,7 @@ func TestNewOrder(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := NewOrder(tt.args.orderDTO)
+			got, err := NewOrder(tt.args.orderDTO, clk)
 			if tt.wantErr {
 				assert.ErrorIs(t, err, tt.args.domainError)
 				return
@@ -154,3 +157,72 @@ func TestNewOrder(t *testing.T) {
 		})
 	}
 }
+
+func TestOrder_SetRefundInspection(t *testing.T) {
+	type args struct {
+		reason    string
+		comment   string
+		condition string
+		outcome   string
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+	}{
+		{
+			name: "SuccessSetRefundInspection",
+			args: args{
+				reason:    "defect",
+				comment:   "broken screen",
+				condition: "damaged",
+				outcome:   "accepted",
+			},
+		},
+		{
+			name: "ErrorInvalidRefundReason",
+			args: args{
+				reason:    "boring",
+				condition: "intact",
+				outcome:   "accepted",
+			},
+			wantErr: ErrInvalidRefundReason,
+		},
+		{
+			name: "ErrorInvalidInspectionCondition",
+			args: args{
+				reason:  "defect",
+				outcome: "accepted",
+			},
+			wantErr: ErrInvalidInspectionCondition,
+		},
+		{
+			name: "ErrorInvalidInspectionOutcome",
+			args: args{
+				reason:    "defect",
+				condition: "intact",
+				outcome:   "maybe",
+			},
+			wantErr: ErrInvalidInspectionOutcome,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var order Order
+			err := order.SetRefundInspection(tt.args.reason, tt.args.comment, tt.args.condition, tt.args.outcome)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.args.reason, order.GetRefundReason())
+			assert.Equal(t, tt.args.comment, order.GetRefundComment())
+			assert.Equal(t, tt.args.condition, order.GetInspectionCondition())
+			assert.Equal(t, tt.args.outcome, order.GetInspectionOutcome())
+		})
+	}
+}
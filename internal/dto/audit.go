@@ -0,0 +1,32 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type AuditLogDTO struct {
+	ID         int64           `json:"id" db:"id"`
+	OperatorID string          `json:"operatorId" db:"operator_id"`
+	Method     string          `json:"method" db:"method"`
+	OrderIDs   []int64         `json:"orderIds" db:"order_ids"`
+	Before     json.RawMessage `json:"before" db:"before"`
+	After      json.RawMessage `json:"after" db:"after"`
+	ClientIP   string          `json:"clientIp" db:"client_ip"`
+	CreatedAt  time.Time       `json:"createdAt" db:"created_at"`
+}
+
+// AuditLogFilterDTO zero fields do not filter
+type AuditLogFilterDTO struct {
+	OperatorID string
+	Method     string
+	OrderID    int64
+	From       time.Time
+	To         time.Time
+	Limit      int
+	Offset     int
+}
+
+type ListAuditLogDTO struct {
+	Entries []AuditLogDTO `json:"entries"`
+}
@@ -0,0 +1,11 @@
+package dto
+
+type ClientRefundScoreDTO struct {
+	ClientID         int      `json:"clientId"`
+	Issued           int      `json:"issued"`
+	Refunds          int      `json:"refunds"`
+	HighValueRefunds int      `json:"highValueRefunds"`
+	RefundRatio      float64  `json:"refundRatio"`
+	Flagged          bool     `json:"flagged"`
+	Reasons          []string `json:"reasons,omitempty"`
+}
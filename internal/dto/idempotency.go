@@ -0,0 +1,13 @@
+package dto
+
+import "time"
+
+// IdempotencyRecordDTO is a stored outcome of request sent with idempotency key,
+// Response stays nil while the request is in progress
+type IdempotencyRecordDTO struct {
+	Key         string    `json:"key" db:"idempotency_key"`
+	RequestHash string    `json:"requestHash" db:"request_hash"`
+	Response    []byte    `json:"response" db:"response"`
+	CreatedAt   time.Time `json:"createdAt" db:"created_at"`
+	ExpiresAt   time.Time `json:"expiresAt" db:"expires_at"`
+}
@@ -14,6 +14,29 @@ type OrderDTO struct {
 	Weight     int          `json:"weight" db:"weight"`
 	Packages   []string     `json:"packages" db:"packages"`
 	PickUpTime sql.NullTime `json:"pickUpTime,omitempty" db:"pick_up_time"`
+
+	RefundReason        string `json:"refundReason,omitempty" db:"refund_reason"`
+	RefundComment       string `json:"refundComment,omitempty" db:"refund_comment"`
+	InspectionCondition string `json:"inspectionCondition,omitempty" db:"inspection_condition"`
+	InspectionOutcome   string `json:"inspectionOutcome,omitempty" db:"inspection_outcome"`
+
+	Version int64 `json:"version" db:"version"`
+
+	Items        []OrderItemDTO `json:"items,omitempty" db:"-"`
+	PaidAmount   int            `json:"paidAmount,omitempty" db:"-"`
+	RefundAmount int            `json:"refundAmount,omitempty" db:"-"`
+
+	// Archived order is read from archive tables and can not be written
+	Archived bool `json:"archived,omitempty" db:"-"`
+}
+
+type OrderItemDTO struct {
+	OrderID   int64  `json:"orderId" db:"order_id"`
+	SKU       string `json:"sku" db:"sku"`
+	Name      string `json:"name" db:"name"`
+	Quantity  int    `json:"quantity" db:"quantity"`
+	UnitPrice int    `json:"unitPrice" db:"unit_price"`
+	Status    string `json:"status" db:"status"`
 }
 
 type ListOrdersDTO struct {
@@ -27,4 +50,39 @@ type AddOrder struct {
 	Cost       int       `json:"cost"`
 	Weight     int       `json:"weight"`
 	Packages   []string  `json:"packages"`
+
+	Items []AddOrderItem `json:"items,omitempty"`
+}
+
+type AddOrderItem struct {
+	SKU       string `json:"sku"`
+	Name      string `json:"name"`
+	Quantity  int    `json:"quantity"`
+	UnitPrice int    `json:"unitPrice"`
+}
+
+type RefundOrder struct {
+	OrderID             int64    `json:"orderId"`
+	ClientID            int      `json:"clientId"`
+	Reason              string   `json:"reason"`
+	Comment             string   `json:"comment"`
+	InspectionCondition string   `json:"inspectionCondition"`
+	InspectionOutcome   string   `json:"inspectionOutcome"`
+	SKUs                []string `json:"skus,omitempty"`
+}
+
+type HandoverDTO struct {
+	ID           int64      `json:"id"`
+	Courier      string     `json:"courier"`
+	HandedOverAt time.Time  `json:"handedOverAt"`
+	Orders       []OrderDTO `json:"orders"`
+}
+
+// ArchiveOrdersDTO selects a batch of orders to be moved into archive
+type ArchiveOrdersDTO struct {
+	Statuses []string `json:"statuses"`
+	// Before bounds pick up time, or store until time for orders never picked up
+	Before     time.Time `json:"before"`
+	ArchivedAt time.Time `json:"archivedAt"`
+	Limit      int       `json:"limit"`
 }
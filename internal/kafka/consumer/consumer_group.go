@@ -0,0 +1,58 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/Na322Pr/route256/internal/config"
+)
+
+// retryBackoff delays the next session after consuming failed
+const retryBackoff = time.Second
+
+// NewConsumerGroup joins groupID starting from the newest offset when the group has none committed
+func NewConsumerGroup(cfg config.Kafka, groupID string) (sarama.ConsumerGroup, error) {
+	c := sarama.NewConfig()
+	c.Consumer.Offsets.Initial = sarama.OffsetNewest
+	c.Consumer.Return.Errors = true
+
+	group, err := sarama.NewConsumerGroup(cfg.Brokers, groupID, c)
+	if err != nil {
+		return nil, fmt.Errorf("NewConsumerGroup failed: %w", err)
+	}
+
+	return group, nil
+}
+
+// Run consumes topics with handler until ctx is done, rejoining the group after every rebalance
+func Run(ctx context.Context, group sarama.ConsumerGroup, topics []string, handler sarama.ConsumerGroupHandler) {
+	go func() {
+		for err := range group.Errors() {
+			log.Printf("[consumer.Run] consumer group error: %s", err.Error())
+		}
+	}()
+
+	for {
+		err := group.Consume(ctx, topics, handler)
+		if errors.Is(err, sarama.ErrClosedConsumerGroup) {
+			return
+		}
+
+		if err != nil {
+			log.Printf("[consumer.Run] failed to consume %v: %s", topics, err.Error())
+
+			select {
+			case <-ctx.Done():
+			case <-time.After(retryBackoff):
+			}
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
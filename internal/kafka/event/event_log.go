@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/IBM/sarama"
+	"github.com/Na322Pr/route256/internal/clock"
 	"github.com/Na322Pr/route256/internal/dto"
 )
 
@@ -15,12 +16,29 @@ const (
 	EventTypeReceive EventType = "receive"
 	EventTypeGiveOut EventType = "giveout"
 	EventTypeRefund  EventType = "refund"
+
+	EventTypeRefusedAtPickup EventType = "refusedAtPickup"
+
+	EventTypeRefundPending  EventType = "refundPending"
+	EventTypeRefundRejected EventType = "refundRejected"
+
+	EventTypeReturnToSeller EventType = "returnToSeller"
+
+	EventTypeItemGiveOut EventType = "itemGiveout"
+	EventTypeItemRefused EventType = "itemRefused"
+	EventTypeItemRefund  EventType = "itemRefund"
+)
+
+const (
+	HeaderAppName    = "app-name"
+	HeaderInstanceID = "instance-id"
 )
 
 type Event struct {
-	Order           dto.OrderDTO `json:"order_info"`
-	EventType       string       `json:"event"`
-	OperationMoment time.Time    `json:"moment"`
+	Order           dto.OrderDTO      `json:"order_info"`
+	Item            *dto.OrderItemDTO `json:"item_info,omitempty"`
+	EventType       string            `json:"event"`
+	OperationMoment time.Time         `json:"moment"`
 }
 
 type ProdFacade interface {
@@ -28,17 +46,43 @@ type ProdFacade interface {
 }
 
 type EventLogProducer struct {
-	prod    ProdFacade
-	topic   string
-	appName string
+	prod       ProdFacade
+	topic      string
+	appName    string
+	instanceID string
+	clock      clock.Clock
+}
+
+type ProducerOption func(*EventLogProducer)
+
+// WithInstanceID marks events with the producing service replica,
+// so the replica can tell its own events from others
+func WithInstanceID(instanceID string) ProducerOption {
+	return func(ep *EventLogProducer) {
+		ep.instanceID = instanceID
+	}
 }
 
-func NewEventLogProducer(prod ProdFacade, topic, appName string) (*EventLogProducer, error) {
-	return &EventLogProducer{
+// WithClock replaces system clock used for event moments and message timestamps
+func WithClock(clk clock.Clock) ProducerOption {
+	return func(ep *EventLogProducer) {
+		ep.clock = clk
+	}
+}
+
+func NewEventLogProducer(prod ProdFacade, topic, appName string, opts ...ProducerOption) (*EventLogProducer, error) {
+	ep := &EventLogProducer{
 		prod:    prod,
 		topic:   topic,
 		appName: appName,
-	}, nil
+		clock:   clock.Real,
+	}
+
+	for _, opt := range opts {
+		opt(ep)
+	}
+
+	return ep, nil
 }
 
 func (ep *EventLogProducer) ProduceEvent(order dto.OrderDTO, eventType EventType) error {
@@ -47,12 +91,37 @@ func (ep *EventLogProducer) ProduceEvent(order dto.OrderDTO, eventType EventType
 	event := &Event{
 		Order:           order,
 		EventType:       string(eventType),
-		OperationMoment: time.Now(),
+		OperationMoment: ep.clock.Now(),
+	}
+
+	if err := ep.produce(event); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (ep *EventLogProducer) ProduceItemEvent(order dto.OrderDTO, item dto.OrderItemDTO, eventType EventType) error {
+	op := "EventLogProducer.ProduceItemEvent"
+
+	event := &Event{
+		Order:           order,
+		Item:            &item,
+		EventType:       string(eventType),
+		OperationMoment: ep.clock.Now(),
 	}
 
+	if err := ep.produce(event); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (ep *EventLogProducer) produce(event *Event) error {
 	bytes, err := json.Marshal(event)
 	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+		return err
 	}
 
 	msg := &sarama.ProducerMessage{
@@ -60,17 +129,20 @@ func (ep *EventLogProducer) ProduceEvent(order dto.OrderDTO, eventType EventType
 		Value: sarama.ByteEncoder(bytes),
 		Headers: []sarama.RecordHeader{
 			{
-				Key:   []byte("app-name"),
+				Key:   []byte(HeaderAppName),
 				Value: []byte(ep.appName),
 			},
 		},
-		Timestamp: time.Now(),
+		Timestamp: ep.clock.Now(),
 	}
 
-	_, _, err = ep.prod.SendMessage(msg)
-	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+	if ep.instanceID != "" {
+		msg.Headers = append(msg.Headers, sarama.RecordHeader{
+			Key:   []byte(HeaderInstanceID),
+			Value: []byte(ep.instanceID),
+		})
 	}
 
-	return nil
+	_, _, err = ep.prod.SendMessage(msg)
+	return err
 }
@@ -1,9 +1,12 @@
 package event_test
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
+	"github.com/IBM/sarama"
+	"github.com/Na322Pr/route256/internal/clock"
 	"github.com/Na322Pr/route256/internal/dto"
 	"github.com/Na322Pr/route256/internal/kafka/event"
 	"github.com/Na322Pr/route256/internal/kafka/event/mock"
@@ -67,3 +70,51 @@ func TestEventLogProducer_ProduceEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestEventLogProducer_Headers(t *testing.T) {
+	ctrl := minimock.NewController(t)
+	prodMock := mock.NewProdFacadeMock(ctrl)
+
+	var headers map[string]string
+	prodMock.SendMessageMock.Set(func(msg *sarama.ProducerMessage) (int32, int64, error) {
+		headers = make(map[string]string, len(msg.Headers))
+		for _, header := range msg.Headers {
+			headers[string(header.Key)] = string(header.Value)
+		}
+		return 0, 0, nil
+	})
+
+	ep, _ := event.NewEventLogProducer(prodMock, "pvz.events-log", "pvz-service", event.WithInstanceID("replica-1"))
+
+	err := ep.ProduceEvent(dto.OrderDTO{ID: 1}, event.EventTypeReceive)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		event.HeaderAppName:    "pvz-service",
+		event.HeaderInstanceID: "replica-1",
+	}, headers)
+}
+
+func TestEventLogProducer_Clock(t *testing.T) {
+	ctrl := minimock.NewController(t)
+	prodMock := mock.NewProdFacadeMock(ctrl)
+
+	now := time.Date(2024, time.October, 1, 12, 0, 0, 0, time.UTC)
+
+	var sent *sarama.ProducerMessage
+	prodMock.SendMessageMock.Set(func(msg *sarama.ProducerMessage) (int32, int64, error) {
+		sent = msg
+		return 0, 0, nil
+	})
+
+	ep, _ := event.NewEventLogProducer(prodMock, "pvz.events-log", "pvz-service", event.WithClock(clock.NewFake(now)))
+	assert.NoError(t, ep.ProduceEvent(dto.OrderDTO{ID: 1}, event.EventTypeReceive))
+
+	assert.Equal(t, now, sent.Timestamp)
+
+	value, err := sent.Value.Encode()
+	assert.NoError(t, err)
+
+	var got event.Event
+	assert.NoError(t, json.Unmarshal(value, &got))
+	assert.True(t, now.Equal(got.OperationMoment))
+}
@@ -0,0 +1,77 @@
+package event
+
+import (
+	"encoding/json"
+	"log"
+
+	"github.com/IBM/sarama"
+	"github.com/Na322Pr/route256/internal/dto"
+)
+
+type OrderCacheFacade interface {
+	Invalidate(orderDTO dto.OrderDTO)
+}
+
+// CacheInvalidator evicts orders changed by other service replicas and lists they are on from the local cache.
+// Orders are evicted rather than replaced with the event copy, so late or reordered events
+// can not bring an older version back, the next read loads the order from storage
+type CacheInvalidator struct {
+	cache      OrderCacheFacade
+	instanceID string
+}
+
+func NewCacheInvalidator(cache OrderCacheFacade, instanceID string) *CacheInvalidator {
+	return &CacheInvalidator{
+		cache:      cache,
+		instanceID: instanceID,
+	}
+}
+
+func (ci *CacheInvalidator) Setup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (ci *CacheInvalidator) Cleanup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (ci *CacheInvalidator) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for {
+		select {
+		case <-session.Context().Done():
+			return nil
+		case msg, ok := <-claim.Messages():
+			if !ok {
+				return nil
+			}
+
+			ci.handle(msg)
+			session.MarkMessage(msg, "")
+		}
+	}
+}
+
+func (ci *CacheInvalidator) handle(msg *sarama.ConsumerMessage) {
+	// own changes are already written through to the cache
+	if ci.ownEvent(msg) {
+		return
+	}
+
+	var event Event
+	if err := json.Unmarshal(msg.Value, &event); err != nil {
+		log.Printf("[CacheInvalidator] skip malformed event at offset %d: %s", msg.Offset, err.Error())
+		return
+	}
+
+	ci.cache.Invalidate(event.Order)
+}
+
+func (ci *CacheInvalidator) ownEvent(msg *sarama.ConsumerMessage) bool {
+	for _, header := range msg.Headers {
+		if header != nil && string(header.Key) == HeaderInstanceID {
+			return string(header.Value) == ci.instanceID
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,104 @@
+package event_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/Na322Pr/route256/internal/dto"
+	"github.com/Na322Pr/route256/internal/kafka/event"
+	"github.com/Na322Pr/route256/internal/kafka/event/mock"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSession struct {
+	sarama.ConsumerGroupSession
+
+	ctx    context.Context
+	marked []int64
+}
+
+func (s *fakeSession) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeSession) MarkMessage(msg *sarama.ConsumerMessage, _ string) {
+	s.marked = append(s.marked, msg.Offset)
+}
+
+type fakeClaim struct {
+	sarama.ConsumerGroupClaim
+
+	messages chan *sarama.ConsumerMessage
+}
+
+func (c *fakeClaim) Messages() <-chan *sarama.ConsumerMessage {
+	return c.messages
+}
+
+func newEventMessage(t *testing.T, offset int64, orderID int64, instanceID string) *sarama.ConsumerMessage {
+	t.Helper()
+
+	value, err := json.Marshal(event.Event{
+		Order:     dto.OrderDTO{ID: orderID},
+		EventType: string(event.EventTypeGiveOut),
+	})
+	require.NoError(t, err)
+
+	msg := &sarama.ConsumerMessage{
+		Offset: offset,
+		Value:  value,
+		Headers: []*sarama.RecordHeader{
+			{Key: []byte(event.HeaderAppName), Value: []byte("pvz-service")},
+		},
+	}
+
+	if instanceID != "" {
+		msg.Headers = append(msg.Headers, &sarama.RecordHeader{
+			Key:   []byte(event.HeaderInstanceID),
+			Value: []byte(instanceID),
+		})
+	}
+
+	return msg
+}
+
+func TestCacheInvalidator_ConsumeClaim(t *testing.T) {
+	ctrl := minimock.NewController(t)
+	cacheMock := mock.NewOrderCacheFacadeMock(ctrl)
+
+	var deleted []int64
+	cacheMock.InvalidateMock.Set(func(orderDTO dto.OrderDTO) {
+		deleted = append(deleted, orderDTO.ID)
+	})
+
+	claim := &fakeClaim{messages: make(chan *sarama.ConsumerMessage, 4)}
+	claim.messages <- newEventMessage(t, 1, 10, "replica-2")
+	claim.messages <- newEventMessage(t, 2, 11, "replica-1")
+	claim.messages <- &sarama.ConsumerMessage{Offset: 3, Value: []byte("not json")}
+	claim.messages <- newEventMessage(t, 4, 12, "")
+	close(claim.messages)
+
+	session := &fakeSession{ctx: context.Background()}
+
+	invalidator := event.NewCacheInvalidator(cacheMock, "replica-1")
+	require.NoError(t, invalidator.ConsumeClaim(session, claim))
+
+	// own and malformed events are skipped but still committed
+	assert.Equal(t, []int64{10, 12}, deleted)
+	assert.Equal(t, []int64{1, 2, 3, 4}, session.marked)
+}
+
+func TestCacheInvalidator_StopsWithSession(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	claim := &fakeClaim{messages: make(chan *sarama.ConsumerMessage)}
+	session := &fakeSession{ctx: ctx}
+
+	invalidator := event.NewCacheInvalidator(mock.NewOrderCacheFacadeMock(minimock.NewController(t)), "replica-1")
+	assert.NoError(t, invalidator.ConsumeClaim(session, claim))
+}
@@ -0,0 +1,356 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.0). DO NOT EDIT.
+
+package mock
+
+//go:generate minimock -i github.com/Na322Pr/route256/internal/kafka/event.OrderCacheFacade -o order_cache_facade_mock.go -n OrderCacheFacadeMock -p mock
+
+import (
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Na322Pr/route256/internal/dto"
+	"github.com/gojuno/minimock/v3"
+)
+
+// OrderCacheFacadeMock implements mm_event.OrderCacheFacade
+type OrderCacheFacadeMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcInvalidate          func(orderDTO dto.OrderDTO)
+	funcInvalidateOrigin    string
+	inspectFuncInvalidate   func(orderDTO dto.OrderDTO)
+	afterInvalidateCounter  uint64
+	beforeInvalidateCounter uint64
+	InvalidateMock          mOrderCacheFacadeMockInvalidate
+}
+
+// NewOrderCacheFacadeMock returns a mock for mm_event.OrderCacheFacade
+func NewOrderCacheFacadeMock(t minimock.Tester) *OrderCacheFacadeMock {
+	m := &OrderCacheFacadeMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.InvalidateMock = mOrderCacheFacadeMockInvalidate{mock: m}
+	m.InvalidateMock.callArgs = []*OrderCacheFacadeMockInvalidateParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mOrderCacheFacadeMockInvalidate struct {
+	optional           bool
+	mock               *OrderCacheFacadeMock
+	defaultExpectation *OrderCacheFacadeMockInvalidateExpectation
+	expectations       []*OrderCacheFacadeMockInvalidateExpectation
+
+	callArgs []*OrderCacheFacadeMockInvalidateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrderCacheFacadeMockInvalidateExpectation specifies expectation struct of the OrderCacheFacade.Invalidate
+type OrderCacheFacadeMockInvalidateExpectation struct {
+	mock               *OrderCacheFacadeMock
+	params             *OrderCacheFacadeMockInvalidateParams
+	paramPtrs          *OrderCacheFacadeMockInvalidateParamPtrs
+	expectationOrigins OrderCacheFacadeMockInvalidateExpectationOrigins
+
+	returnOrigin string
+	Counter      uint64
+}
+
+// OrderCacheFacadeMockInvalidateParams contains parameters of the OrderCacheFacade.Invalidate
+type OrderCacheFacadeMockInvalidateParams struct {
+	orderDTO dto.OrderDTO
+}
+
+// OrderCacheFacadeMockInvalidateParamPtrs contains pointers to parameters of the OrderCacheFacade.Invalidate
+type OrderCacheFacadeMockInvalidateParamPtrs struct {
+	orderDTO *dto.OrderDTO
+}
+
+// OrderCacheFacadeMockInvalidateOrigins contains origins of expectations of the OrderCacheFacade.Invalidate
+type OrderCacheFacadeMockInvalidateExpectationOrigins struct {
+	origin         string
+	originOrderDTO string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmInvalidate *mOrderCacheFacadeMockInvalidate) Optional() *mOrderCacheFacadeMockInvalidate {
+	mmInvalidate.optional = true
+	return mmInvalidate
+}
+
+// Expect sets up expected params for OrderCacheFacade.Invalidate
+func (mmInvalidate *mOrderCacheFacadeMockInvalidate) Expect(orderDTO dto.OrderDTO) *mOrderCacheFacadeMockInvalidate {
+	if mmInvalidate.mock.funcInvalidate != nil {
+		mmInvalidate.mock.t.Fatalf("OrderCacheFacadeMock.Invalidate mock is already set by Set")
+	}
+
+	if mmInvalidate.defaultExpectation == nil {
+		mmInvalidate.defaultExpectation = &OrderCacheFacadeMockInvalidateExpectation{}
+	}
+
+	if mmInvalidate.defaultExpectation.paramPtrs != nil {
+		mmInvalidate.mock.t.Fatalf("OrderCacheFacadeMock.Invalidate mock is already set by ExpectParams functions")
+	}
+
+	mmInvalidate.defaultExpectation.params = &OrderCacheFacadeMockInvalidateParams{orderDTO}
+	mmInvalidate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmInvalidate.expectations {
+		if minimock.Equal(e.params, mmInvalidate.defaultExpectation.params) {
+			mmInvalidate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmInvalidate.defaultExpectation.params)
+		}
+	}
+
+	return mmInvalidate
+}
+
+// ExpectOrderDTOParam1 sets up expected param orderDTO for OrderCacheFacade.Invalidate
+func (mmInvalidate *mOrderCacheFacadeMockInvalidate) ExpectOrderDTOParam1(orderDTO dto.OrderDTO) *mOrderCacheFacadeMockInvalidate {
+	if mmInvalidate.mock.funcInvalidate != nil {
+		mmInvalidate.mock.t.Fatalf("OrderCacheFacadeMock.Invalidate mock is already set by Set")
+	}
+
+	if mmInvalidate.defaultExpectation == nil {
+		mmInvalidate.defaultExpectation = &OrderCacheFacadeMockInvalidateExpectation{}
+	}
+
+	if mmInvalidate.defaultExpectation.params != nil {
+		mmInvalidate.mock.t.Fatalf("OrderCacheFacadeMock.Invalidate mock is already set by Expect")
+	}
+
+	if mmInvalidate.defaultExpectation.paramPtrs == nil {
+		mmInvalidate.defaultExpectation.paramPtrs = &OrderCacheFacadeMockInvalidateParamPtrs{}
+	}
+	mmInvalidate.defaultExpectation.paramPtrs.orderDTO = &orderDTO
+	mmInvalidate.defaultExpectation.expectationOrigins.originOrderDTO = minimock.CallerInfo(1)
+
+	return mmInvalidate
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrderCacheFacade.Invalidate
+func (mmInvalidate *mOrderCacheFacadeMockInvalidate) Inspect(f func(orderDTO dto.OrderDTO)) *mOrderCacheFacadeMockInvalidate {
+	if mmInvalidate.mock.inspectFuncInvalidate != nil {
+		mmInvalidate.mock.t.Fatalf("Inspect function is already set for OrderCacheFacadeMock.Invalidate")
+	}
+
+	mmInvalidate.mock.inspectFuncInvalidate = f
+
+	return mmInvalidate
+}
+
+// Return sets up results that will be returned by OrderCacheFacade.Invalidate
+func (mmInvalidate *mOrderCacheFacadeMockInvalidate) Return() *OrderCacheFacadeMock {
+	if mmInvalidate.mock.funcInvalidate != nil {
+		mmInvalidate.mock.t.Fatalf("OrderCacheFacadeMock.Invalidate mock is already set by Set")
+	}
+
+	if mmInvalidate.defaultExpectation == nil {
+		mmInvalidate.defaultExpectation = &OrderCacheFacadeMockInvalidateExpectation{mock: mmInvalidate.mock}
+	}
+
+	mmInvalidate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmInvalidate.mock
+}
+
+// Set uses given function f to mock the OrderCacheFacade.Invalidate method
+func (mmInvalidate *mOrderCacheFacadeMockInvalidate) Set(f func(orderDTO dto.OrderDTO)) *OrderCacheFacadeMock {
+	if mmInvalidate.defaultExpectation != nil {
+		mmInvalidate.mock.t.Fatalf("Default expectation is already set for the OrderCacheFacade.Invalidate method")
+	}
+
+	if len(mmInvalidate.expectations) > 0 {
+		mmInvalidate.mock.t.Fatalf("Some expectations are already set for the OrderCacheFacade.Invalidate method")
+	}
+
+	mmInvalidate.mock.funcInvalidate = f
+	mmInvalidate.mock.funcInvalidateOrigin = minimock.CallerInfo(1)
+	return mmInvalidate.mock
+}
+
+// Times sets number of times OrderCacheFacade.Invalidate should be invoked
+func (mmInvalidate *mOrderCacheFacadeMockInvalidate) Times(n uint64) *mOrderCacheFacadeMockInvalidate {
+	if n == 0 {
+		mmInvalidate.mock.t.Fatalf("Times of OrderCacheFacadeMock.Invalidate mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmInvalidate.expectedInvocations, n)
+	mmInvalidate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmInvalidate
+}
+
+func (mmInvalidate *mOrderCacheFacadeMockInvalidate) invocationsDone() bool {
+	if len(mmInvalidate.expectations) == 0 && mmInvalidate.defaultExpectation == nil && mmInvalidate.mock.funcInvalidate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmInvalidate.mock.afterInvalidateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmInvalidate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Invalidate implements mm_event.OrderCacheFacade
+func (mmInvalidate *OrderCacheFacadeMock) Invalidate(orderDTO dto.OrderDTO) {
+	mm_atomic.AddUint64(&mmInvalidate.beforeInvalidateCounter, 1)
+	defer mm_atomic.AddUint64(&mmInvalidate.afterInvalidateCounter, 1)
+
+	mmInvalidate.t.Helper()
+
+	if mmInvalidate.inspectFuncInvalidate != nil {
+		mmInvalidate.inspectFuncInvalidate(orderDTO)
+	}
+
+	mm_params := OrderCacheFacadeMockInvalidateParams{orderDTO}
+
+	// Record call args
+	mmInvalidate.InvalidateMock.mutex.Lock()
+	mmInvalidate.InvalidateMock.callArgs = append(mmInvalidate.InvalidateMock.callArgs, &mm_params)
+	mmInvalidate.InvalidateMock.mutex.Unlock()
+
+	for _, e := range mmInvalidate.InvalidateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmInvalidate.InvalidateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmInvalidate.InvalidateMock.defaultExpectation.Counter, 1)
+		mm_want := mmInvalidate.InvalidateMock.defaultExpectation.params
+		mm_want_ptrs := mmInvalidate.InvalidateMock.defaultExpectation.paramPtrs
+
+		mm_got := OrderCacheFacadeMockInvalidateParams{orderDTO}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.orderDTO != nil && !minimock.Equal(*mm_want_ptrs.orderDTO, mm_got.orderDTO) {
+				mmInvalidate.t.Errorf("OrderCacheFacadeMock.Invalidate got unexpected parameter orderDTO, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmInvalidate.InvalidateMock.defaultExpectation.expectationOrigins.originOrderDTO, *mm_want_ptrs.orderDTO, mm_got.orderDTO, minimock.Diff(*mm_want_ptrs.orderDTO, mm_got.orderDTO))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmInvalidate.t.Errorf("OrderCacheFacadeMock.Invalidate got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmInvalidate.InvalidateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmInvalidate.funcInvalidate != nil {
+		mmInvalidate.funcInvalidate(orderDTO)
+		return
+	}
+	mmInvalidate.t.Fatalf("Unexpected call to OrderCacheFacadeMock.Invalidate. %v", orderDTO)
+
+}
+
+// InvalidateAfterCounter returns a count of finished OrderCacheFacadeMock.Invalidate invocations
+func (mmInvalidate *OrderCacheFacadeMock) InvalidateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmInvalidate.afterInvalidateCounter)
+}
+
+// InvalidateBeforeCounter returns a count of OrderCacheFacadeMock.Invalidate invocations
+func (mmInvalidate *OrderCacheFacadeMock) InvalidateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmInvalidate.beforeInvalidateCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrderCacheFacadeMock.Invalidate.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmInvalidate *mOrderCacheFacadeMockInvalidate) Calls() []*OrderCacheFacadeMockInvalidateParams {
+	mmInvalidate.mutex.RLock()
+
+	argCopy := make([]*OrderCacheFacadeMockInvalidateParams, len(mmInvalidate.callArgs))
+	copy(argCopy, mmInvalidate.callArgs)
+
+	mmInvalidate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockInvalidateDone returns true if the count of the Invalidate invocations corresponds
+// the number of defined expectations
+func (m *OrderCacheFacadeMock) MinimockInvalidateDone() bool {
+	if m.InvalidateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.InvalidateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.InvalidateMock.invocationsDone()
+}
+
+// MinimockInvalidateInspect logs each unmet expectation
+func (m *OrderCacheFacadeMock) MinimockInvalidateInspect() {
+	for _, e := range m.InvalidateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrderCacheFacadeMock.Invalidate at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterInvalidateCounter := mm_atomic.LoadUint64(&m.afterInvalidateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.InvalidateMock.defaultExpectation != nil && afterInvalidateCounter < 1 {
+		if m.InvalidateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderCacheFacadeMock.Invalidate at\n%s", m.InvalidateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrderCacheFacadeMock.Invalidate at\n%s with params: %#v", m.InvalidateMock.defaultExpectation.expectationOrigins.origin, *m.InvalidateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcInvalidate != nil && afterInvalidateCounter < 1 {
+		m.t.Errorf("Expected call to OrderCacheFacadeMock.Invalidate at\n%s", m.funcInvalidateOrigin)
+	}
+
+	if !m.InvalidateMock.invocationsDone() && afterInvalidateCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderCacheFacadeMock.Invalidate at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.InvalidateMock.expectedInvocations), m.InvalidateMock.expectedInvocationsOrigin, afterInvalidateCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *OrderCacheFacadeMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockInvalidateInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *OrderCacheFacadeMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *OrderCacheFacadeMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockInvalidateDone()
+}
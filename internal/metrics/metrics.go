@@ -7,6 +7,7 @@ import (
 
 const (
 	orderLabel = "order"
+	cacheLabel = "cache"
 )
 
 var (
@@ -23,3 +24,72 @@ func AddIssuedOrdersTotal(cnt int, order string) {
 		orderLabel: order,
 	}).Add(float64(cnt))
 }
+
+var (
+	cacheHitsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pvzservice_cache_hits_total",
+		Help: "total number of cache lookups that found an entry",
+	}, []string{
+		cacheLabel,
+	})
+
+	cacheMissesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pvzservice_cache_misses_total",
+		Help: "total number of cache lookups that found no entry or an expired one",
+	}, []string{
+		cacheLabel,
+	})
+
+	cacheSetsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pvzservice_cache_sets_total",
+		Help: "total number of entries put into cache",
+	}, []string{
+		cacheLabel,
+	})
+
+	cacheEvictionsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pvzservice_cache_evictions_total",
+		Help: "total number of entries evicted over cache limits or expired",
+	}, []string{
+		cacheLabel,
+	})
+
+	cacheNegativeHitsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pvzservice_cache_negative_hits_total",
+		Help: "total number of lookups of missing entries answered by negative cache without storage",
+	}, []string{
+		cacheLabel,
+	})
+
+	cacheEntries = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pvzservice_cache_entries",
+		Help: "current number of entries in cache",
+	}, []string{
+		cacheLabel,
+	})
+)
+
+func IncCacheHits(cache string) {
+	cacheHitsTotal.With(prometheus.Labels{cacheLabel: cache}).Inc()
+}
+
+func IncCacheMisses(cache string) {
+	cacheMissesTotal.With(prometheus.Labels{cacheLabel: cache}).Inc()
+}
+
+func IncCacheSets(cache string) {
+	cacheSetsTotal.With(prometheus.Labels{cacheLabel: cache}).Inc()
+}
+
+func IncCacheNegativeHits(cache string) {
+	cacheNegativeHitsTotal.With(prometheus.Labels{cacheLabel: cache}).Inc()
+}
+
+func AddCacheEvictions(cnt int, cache string) {
+	cacheEvictionsTotal.With(prometheus.Labels{cacheLabel: cache}).Add(float64(cnt))
+}
+
+// AddCacheEntries changes entries gauge by delta, so shards of one cache report their sum
+func AddCacheEntries(delta int, cache string) {
+	cacheEntries.With(prometheus.Labels{cacheLabel: cache}).Add(float64(delta))
+}
@@ -0,0 +1,109 @@
+// Package migrator applies embedded goose migrations for the configured storage
+package migrator
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"github.com/Na322Pr/route256/internal/config"
+	"github.com/Na322Pr/route256/migrations"
+	"github.com/pressly/goose/v3"
+)
+
+var (
+	ErrUnsupportedStorage = errors.New("storage has no migrations")
+	ErrSchemaBehind       = errors.New("database schema is behind migrations")
+)
+
+type Migrator struct {
+	provider *goose.Provider
+}
+
+func New(db *sql.DB, storage string) (*Migrator, error) {
+	const op = "migrator.New"
+
+	var (
+		dialect goose.Dialect
+		fsys    fs.FS
+		err     error
+	)
+
+	switch storage {
+	case config.StoragePostgres:
+		dialect, fsys = goose.DialectPostgres, migrations.Postgres
+	case config.StorageSQLite:
+		dialect = goose.DialectSQLite3
+		fsys, err = fs.Sub(migrations.SQLite, migrations.SQLiteDir)
+	default:
+		err = fmt.Errorf("%w: %q", ErrUnsupportedStorage, storage)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	provider, err := goose.NewProvider(dialect, db, fsys)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &Migrator{provider: provider}, nil
+}
+
+// Up applies all pending migrations
+func (m *Migrator) Up(ctx context.Context) ([]*goose.MigrationResult, error) {
+	const op = "Migrator.Up"
+
+	results, err := m.provider.Up(ctx)
+	if err != nil {
+		return results, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return results, nil
+}
+
+// Down rolls back the latest applied migration
+func (m *Migrator) Down(ctx context.Context) (*goose.MigrationResult, error) {
+	const op = "Migrator.Down"
+
+	result, err := m.provider.Down(ctx)
+	if err != nil {
+		return result, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+func (m *Migrator) Status(ctx context.Context) ([]*goose.MigrationStatus, error) {
+	const op = "Migrator.Status"
+
+	statuses, err := m.provider.Status(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return statuses, nil
+}
+
+// Check returns ErrSchemaBehind if some of embedded migrations are not applied
+func (m *Migrator) Check(ctx context.Context) error {
+	const op = "Migrator.Check"
+
+	pending, err := m.provider.HasPending(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !pending {
+		return nil
+	}
+
+	current, target, err := m.provider.GetVersions(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return fmt.Errorf("%s: %w: database version %d, latest migration %d", op, ErrSchemaBehind, current, target)
+}
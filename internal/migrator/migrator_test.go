@@ -0,0 +1,65 @@
+package migrator_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/Na322Pr/route256/internal/config"
+	"github.com/Na322Pr/route256/internal/migrator"
+	"github.com/Na322Pr/route256/internal/repository/sqlite"
+	"github.com/pressly/goose/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrator_SQLite(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := sqlite.Open(filepath.Join(t.TempDir(), "pvz.db"))
+	require.NoError(t, err)
+	defer db.Close()
+
+	m, err := migrator.New(db, config.StorageSQLite)
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, m.Check(ctx), migrator.ErrSchemaBehind)
+
+	results, err := m.Up(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, results)
+	assert.NoError(t, m.Check(ctx))
+
+	statuses, err := m.Status(ctx)
+	require.NoError(t, err)
+	require.Len(t, statuses, len(results))
+	for _, status := range statuses {
+		assert.Equal(t, goose.StateApplied, status.State)
+	}
+
+	// every migration rolls back cleanly
+	for range results {
+		_, err := m.Down(ctx)
+		require.NoError(t, err)
+	}
+	assert.ErrorIs(t, m.Check(ctx), migrator.ErrSchemaBehind)
+
+	_, err = m.Up(ctx)
+	require.NoError(t, err)
+	assert.NoError(t, m.Check(ctx))
+}
+
+func TestMigrator_PostgresMigrationsEmbedded(t *testing.T) {
+	db, err := sqlite.Open(filepath.Join(t.TempDir(), "pvz.db"))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// provider only parses sources here, nothing is run against the database
+	_, err = migrator.New(db, config.StoragePostgres)
+	assert.NoError(t, err)
+}
+
+func TestMigrator_UnsupportedStorage(t *testing.T) {
+	_, err := migrator.New(nil, config.StorageMemory)
+	assert.ErrorIs(t, err, migrator.ErrUnsupportedStorage)
+}
@@ -0,0 +1,532 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Na322Pr/route256/internal/clock"
+	"github.com/Na322Pr/route256/internal/dto"
+	"github.com/Na322Pr/route256/internal/usecase"
+	"golang.org/x/sync/singleflight"
+)
+
+type OrderCacheFacade interface {
+	Get(orderID int64) (*dto.OrderDTO, bool)
+	GetMany(orderIDs []int64) map[int64]*dto.OrderDTO
+	Set(orderDTO *dto.OrderDTO, now time.Time) error
+	Delete(orderID int64)
+}
+
+// ListCacheFacade keeps order lists, list loaded at generation taken before an invalidation is not cached
+type ListCacheFacade[K comparable] interface {
+	Get(key K) (*dto.ListOrdersDTO, bool)
+	Generation() uint64
+	Set(key K, list *dto.ListOrdersDTO, gen uint64)
+	Delete(key K)
+	DeleteFunc(match func(K) bool)
+}
+
+// NotFoundCacheFacade remembers errors of lookups of orders missing from storage
+type NotFoundCacheFacade interface {
+	Get(orderID int64) error
+	Set(orderID int64, err error, now time.Time)
+	Delete(orderID int64)
+}
+
+// RefundsPage keys cached refund pages, statuses of the filter are joined with comma
+type RefundsPage struct {
+	Statuses string
+	Limit    int
+	Offset   int
+}
+
+func (p RefundsPage) has(statuses ...string) bool {
+	for _, status := range strings.Split(p.Statuses, ",") {
+		if slices.Contains(statuses, status) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type CachedFacadeOption func(*CachedFacade)
+
+// WithListCaches caches client order lists and refund pages, they are dropped
+// when an order on them changes or may appear on them
+func WithListCaches(clientOrders ListCacheFacade[int], refunds ListCacheFacade[RefundsPage]) CachedFacadeOption {
+	return func(f *CachedFacade) {
+		f.clientOrders = clientOrders
+		f.refunds = refunds
+	}
+}
+
+// WithNotFoundCache caches lookups failed with errNotFound, order added with the id is found right away
+func WithNotFoundCache(notFound NotFoundCacheFacade, errNotFound error) CachedFacadeOption {
+	return func(f *CachedFacade) {
+		f.notFound = notFound
+		f.errNotFound = errNotFound
+	}
+}
+
+// WithClock replaces system clock orders are cached by
+func WithClock(clk clock.Clock) CachedFacadeOption {
+	return func(f *CachedFacade) {
+		f.clock = clk
+	}
+}
+
+// CachedFacade reads orders through the cache and writes them through to it.
+// Lists are cached only if enabled, archiving and audit log go straight to the wrapped facade
+type CachedFacade struct {
+	usecase.OrderRepoFacade
+
+	cache OrderCacheFacade
+	group singleflight.Group
+	clock clock.Clock
+
+	clientOrders ListCacheFacade[int]
+	refunds      ListCacheFacade[RefundsPage]
+
+	notFound    NotFoundCacheFacade
+	errNotFound error
+
+	// gen advances on every write and invalidation of orders. Like ListCache does for lists,
+	// orders read before it moved are not cached, so a slow load can not bring back a stale order
+	genLock sync.Mutex
+	gen     uint64
+}
+
+func NewCachedFacade(repo usecase.OrderRepoFacade, cache OrderCacheFacade, opts ...CachedFacadeOption) *CachedFacade {
+	f := &CachedFacade{
+		OrderRepoFacade: repo,
+		cache:           cache,
+		clock:           clock.Real,
+	}
+
+	for _, opt := range opts {
+		opt(f)
+	}
+
+	return f
+}
+
+// pendingKey binds to ctx the orders written in a transaction started by WithinTx
+type pendingKey struct{}
+
+// pendingOrders are cached once the transaction commits, so rolled back changes never get there.
+// Lists are invalidated after the transaction ends either way
+type pendingOrders struct {
+	orders  []dto.OrderDTO
+	changes []orderChange
+	// dropped are removed from the cache again once the transaction ends,
+	// a reader could cache them as they were before the commit
+	dropped []int64
+}
+
+// orderChange is what list invalidation needs to know about a written order
+type orderChange struct {
+	clientID int
+	status   string
+	// prevStatus is the status readers have seen before the change, if prevKnown
+	prevStatus string
+	prevKnown  bool
+}
+
+func (f *CachedFacade) GetOrderByID(ctx context.Context, id int64) (*dto.OrderDTO, error) {
+	// transaction may read its own uncommitted changes, they must not get into the cache
+	if _, ok := ctx.Value(pendingKey{}).(*pendingOrders); ok {
+		return f.OrderRepoFacade.GetOrderByID(ctx, id)
+	}
+
+	if orderDTO, ok := f.cache.Get(id); ok {
+		return copyOrder(orderDTO), nil
+	}
+
+	if f.notFound != nil {
+		if err := f.notFound.Get(id); err != nil {
+			return nil, err
+		}
+	}
+
+	// loading is shared by concurrent callers, so it is not canceled with the first of them
+	loadCtx := context.WithoutCancel(ctx)
+	res := f.group.DoChan(strconv.FormatInt(id, 10), func() (interface{}, error) {
+		gen := f.generation()
+
+		orderDTO, err := f.OrderRepoFacade.GetOrderByID(loadCtx, id)
+		if err != nil {
+			if f.notFound != nil && errors.Is(err, f.errNotFound) {
+				f.fillNotFound(gen, id, err)
+			}
+
+			return nil, err
+		}
+
+		f.fill(gen, *orderDTO)
+		return orderDTO, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case r := <-res:
+		if r.Err != nil {
+			return nil, r.Err
+		}
+
+		// concurrent callers get the same loaded order
+		return copyOrder(r.Val.(*dto.OrderDTO)), nil
+	}
+}
+
+// GetOrdersByIDs takes cached orders and loads only the missing ones from storage
+func (f *CachedFacade) GetOrdersByIDs(ctx context.Context, ids []int64) (*dto.ListOrdersDTO, error) {
+	if _, ok := ctx.Value(pendingKey{}).(*pendingOrders); ok {
+		return f.OrderRepoFacade.GetOrdersByIDs(ctx, ids)
+	}
+
+	cached := f.cache.GetMany(ids)
+
+	list := &dto.ListOrdersDTO{Orders: make([]dto.OrderDTO, 0, len(ids))}
+	for _, orderDTO := range cached {
+		list.Orders = append(list.Orders, *copyOrder(orderDTO))
+	}
+
+	missing := make([]int64, 0, len(ids)-len(cached))
+	for _, id := range ids {
+		if _, ok := cached[id]; !ok {
+			missing = append(missing, id)
+		}
+	}
+
+	if len(missing) == 0 {
+		return list, nil
+	}
+
+	gen := f.generation()
+
+	loaded, err := f.OrderRepoFacade.GetOrdersByIDs(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+
+	f.fill(gen, loaded.Orders...)
+	list.Orders = append(list.Orders, loaded.Orders...)
+
+	return list, nil
+}
+
+func (f *CachedFacade) GetClientOrdersList(ctx context.Context, clientID int) (*dto.ListOrdersDTO, error) {
+	if _, ok := ctx.Value(pendingKey{}).(*pendingOrders); ok || f.clientOrders == nil {
+		return f.OrderRepoFacade.GetClientOrdersList(ctx, clientID)
+	}
+
+	return cachedList(f.clientOrders, clientID, func() (*dto.ListOrdersDTO, error) {
+		return f.OrderRepoFacade.GetClientOrdersList(ctx, clientID)
+	})
+}
+
+func (f *CachedFacade) GetRefundsList(ctx context.Context, statuses []string, limit, offset int) (*dto.ListOrdersDTO, error) {
+	if _, ok := ctx.Value(pendingKey{}).(*pendingOrders); ok || f.refunds == nil {
+		return f.OrderRepoFacade.GetRefundsList(ctx, statuses, limit, offset)
+	}
+
+	page := RefundsPage{Statuses: strings.Join(statuses, ","), Limit: limit, Offset: offset}
+	return cachedList(f.refunds, page, func() (*dto.ListOrdersDTO, error) {
+		return f.OrderRepoFacade.GetRefundsList(ctx, statuses, limit, offset)
+	})
+}
+
+func (f *CachedFacade) AddOrder(ctx context.Context, orderDTO dto.OrderDTO) error {
+	err := f.OrderRepoFacade.AddOrder(ctx, orderDTO)
+	f.written(ctx, err, orderDTO)
+	f.changed(ctx, orderChange{clientID: orderDTO.ClientID, status: orderDTO.Status, prevKnown: true})
+
+	return err
+}
+
+func (f *CachedFacade) UpdateOrder(ctx context.Context, orderDTO dto.OrderDTO) error {
+	changes := f.changes(orderDTO)
+	err := f.OrderRepoFacade.UpdateOrder(ctx, orderDTO)
+	f.written(ctx, err, updated(orderDTO)...)
+	f.changed(ctx, changes...)
+
+	return err
+}
+
+func (f *CachedFacade) UpdateOrders(ctx context.Context, ordersDTO []dto.OrderDTO) error {
+	changes := f.changes(ordersDTO...)
+	err := f.OrderRepoFacade.UpdateOrders(ctx, ordersDTO)
+	f.written(ctx, err, updated(ordersDTO...)...)
+	f.changed(ctx, changes...)
+
+	return err
+}
+
+func (f *CachedFacade) HandOverOrders(ctx context.Context, handoverDTO dto.HandoverDTO) (int64, error) {
+	changes := f.changes(handoverDTO.Orders...)
+	id, err := f.OrderRepoFacade.HandOverOrders(ctx, handoverDTO)
+	f.written(ctx, err, updated(handoverDTO.Orders...)...)
+	f.changed(ctx, changes...)
+
+	return id, err
+}
+
+// ArchiveOrders drops archived orders, so they are read again from archive as such,
+// all client lists and refund pages of archived statuses
+func (f *CachedFacade) ArchiveOrders(ctx context.Context, archiveDTO dto.ArchiveOrdersDTO) ([]int64, error) {
+	ids, err := f.OrderRepoFacade.ArchiveOrders(ctx, archiveDTO)
+
+	f.dropped(ctx, ids...)
+
+	if f.clientOrders != nil && (len(ids) > 0 || err != nil) {
+		f.clientOrders.DeleteFunc(func(int) bool { return true })
+		f.refunds.DeleteFunc(func(page RefundsPage) bool { return page.has(archiveDTO.Statuses...) })
+	}
+
+	return ids, err
+}
+
+// WarmUp loads orders in statuses into the cache page by page and returns their number.
+// Page read while orders were written or invalidated is not cached
+func (f *CachedFacade) WarmUp(ctx context.Context, statuses []string, batchSize int) (int, error) {
+	const op = "CachedFacade.WarmUp"
+
+	n := 0
+	for {
+		gen := f.generation()
+
+		list, err := f.OrderRepoFacade.ListOrdersByStatus(ctx, statuses, batchSize, n)
+		if err != nil {
+			return n, fmt.Errorf("%s: %w", op, err)
+		}
+
+		f.fill(gen, list.Orders...)
+		n += len(list.Orders)
+
+		if batchSize <= 0 || len(list.Orders) < batchSize {
+			return n, nil
+		}
+	}
+}
+
+// Invalidate drops an order changed by another replica from the cache together with lists it may be on
+func (f *CachedFacade) Invalidate(orderDTO dto.OrderDTO) {
+	f.invalidate(f.changes(orderDTO)...)
+	f.advance()
+	f.cache.Delete(orderDTO.ID)
+
+	if f.notFound != nil {
+		f.notFound.Delete(orderDTO.ID)
+	}
+}
+
+// WithinTx caches orders written by fn after commit and drops them from the cache on rollback
+func (f *CachedFacade) WithinTx(ctx context.Context, fn func(ctxTx context.Context) error) error {
+	// nested call joins the outer transaction and its pending orders
+	if _, ok := ctx.Value(pendingKey{}).(*pendingOrders); ok {
+		return f.OrderRepoFacade.WithinTx(ctx, fn)
+	}
+
+	pending := &pendingOrders{}
+	err := f.OrderRepoFacade.WithinTx(context.WithValue(ctx, pendingKey{}, pending), fn)
+
+	f.invalidate(pending.changes...)
+	if len(pending.orders) > 0 || len(pending.dropped) > 0 {
+		f.advance()
+	}
+
+	for _, id := range pending.dropped {
+		f.cache.Delete(id)
+	}
+
+	for _, orderDTO := range pending.orders {
+		if err != nil {
+			f.cache.Delete(orderDTO.ID)
+			continue
+		}
+
+		f.set(orderDTO)
+	}
+
+	return err
+}
+
+// written puts successfully written orders into the cache or defers it until commit,
+// failed write leaves the stored version unknown, so cached copies are dropped
+func (f *CachedFacade) written(ctx context.Context, err error, orders ...dto.OrderDTO) {
+	pending, inTx := ctx.Value(pendingKey{}).(*pendingOrders)
+	if err != nil || !inTx {
+		f.advance()
+	}
+
+	for _, orderDTO := range orders {
+		switch {
+		case err != nil:
+			f.cache.Delete(orderDTO.ID)
+		case inTx:
+			pending.orders = append(pending.orders, orderDTO)
+		default:
+			f.set(orderDTO)
+		}
+	}
+}
+
+// dropped removes orders gone from storage tables they were read from
+func (f *CachedFacade) dropped(ctx context.Context, ids ...int64) {
+	if len(ids) == 0 {
+		return
+	}
+
+	if pending, ok := ctx.Value(pendingKey{}).(*pendingOrders); ok {
+		pending.dropped = append(pending.dropped, ids...)
+	}
+
+	f.advance()
+	for _, id := range ids {
+		f.cache.Delete(id)
+	}
+}
+
+// changes takes previous statuses of orders about to be written from the cache
+func (f *CachedFacade) changes(ordersDTO ...dto.OrderDTO) []orderChange {
+	if f.clientOrders == nil {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(ordersDTO))
+	for _, orderDTO := range ordersDTO {
+		ids = append(ids, orderDTO.ID)
+	}
+	cached := f.cache.GetMany(ids)
+
+	changes := make([]orderChange, 0, len(ordersDTO))
+	for _, orderDTO := range ordersDTO {
+		change := orderChange{clientID: orderDTO.ClientID, status: orderDTO.Status}
+		if prev, ok := cached[orderDTO.ID]; ok {
+			change.prevStatus, change.prevKnown = prev.Status, true
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
+// changed invalidates lists right away or once the transaction ends
+func (f *CachedFacade) changed(ctx context.Context, changes ...orderChange) {
+	if pending, ok := ctx.Value(pendingKey{}).(*pendingOrders); ok {
+		pending.changes = append(pending.changes, changes...)
+		return
+	}
+
+	f.invalidate(changes...)
+}
+
+// invalidate drops client list and refund pages with the order status before or after the change,
+// pages of all filters are dropped if the previous status is unknown
+func (f *CachedFacade) invalidate(changes ...orderChange) {
+	if f.clientOrders == nil {
+		return
+	}
+
+	for _, change := range changes {
+		f.clientOrders.Delete(change.clientID)
+		f.refunds.DeleteFunc(func(page RefundsPage) bool {
+			return !change.prevKnown || page.has(change.status, change.prevStatus)
+		})
+	}
+}
+
+func (f *CachedFacade) generation() uint64 {
+	f.genLock.Lock()
+	defer f.genLock.Unlock()
+
+	return f.gen
+}
+
+// advance is called by writers after storage is written and before the cache is,
+// so a load filling the cache meanwhile is either skipped or overwritten
+func (f *CachedFacade) advance() {
+	f.genLock.Lock()
+	defer f.genLock.Unlock()
+
+	f.gen++
+}
+
+// fill caches orders loaded at generation gen unless any order was written or invalidated since then
+func (f *CachedFacade) fill(gen uint64, ordersDTO ...dto.OrderDTO) {
+	f.genLock.Lock()
+	defer f.genLock.Unlock()
+
+	if gen != f.gen {
+		return
+	}
+
+	for _, orderDTO := range ordersDTO {
+		f.set(orderDTO)
+	}
+}
+
+func (f *CachedFacade) fillNotFound(gen uint64, id int64, err error) {
+	f.genLock.Lock()
+	defer f.genLock.Unlock()
+
+	if gen == f.gen {
+		f.notFound.Set(id, err, f.clock.Now())
+	}
+}
+
+func (f *CachedFacade) set(orderDTO dto.OrderDTO) {
+	// not found entry left from before the order was added would resurface once the order is evicted
+	if f.notFound != nil {
+		f.notFound.Delete(orderDTO.ID)
+	}
+
+	if err := f.cache.Set(copyOrder(&orderDTO), f.clock.Now()); err != nil {
+		f.cache.Delete(orderDTO.ID)
+	}
+}
+
+// copyOrder keeps cached orders apart from callers, which may change items and packages of their orders
+func copyOrder(orderDTO *dto.OrderDTO) *dto.OrderDTO {
+	c := *orderDTO
+	c.Packages = slices.Clone(orderDTO.Packages)
+	c.Items = slices.Clone(orderDTO.Items)
+
+	return &c
+}
+
+// updated returns orders as stored after update, which bumps their version
+func updated(ordersDTO ...dto.OrderDTO) []dto.OrderDTO {
+	res := make([]dto.OrderDTO, 0, len(ordersDTO))
+	for _, orderDTO := range ordersDTO {
+		orderDTO.Version++
+		res = append(res, orderDTO)
+	}
+
+	return res
+}
+
+// cachedList returns cached list or loads it, list is not cached if invalidated while loading
+func cachedList[K comparable](c ListCacheFacade[K], key K, load func() (*dto.ListOrdersDTO, error)) (*dto.ListOrdersDTO, error) {
+	if list, ok := c.Get(key); ok {
+		return list, nil
+	}
+
+	gen := c.Generation()
+	list, err := load()
+	if err != nil {
+		return nil, err
+	}
+
+	c.Set(key, list, gen)
+	return list, nil
+}
@@ -0,0 +1,448 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Na322Pr/route256/internal/cache"
+	"github.com/Na322Pr/route256/internal/clock"
+	"github.com/Na322Pr/route256/internal/domain"
+	"github.com/Na322Pr/route256/internal/dto"
+	"github.com/Na322Pr/route256/internal/repository"
+	"github.com/Na322Pr/route256/internal/usecase/mock"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCachedFacade(t *testing.T, opts ...repository.CachedFacadeOption) (*repository.CachedFacade, *mock.OrderRepoFacadeMock) {
+	ctrl := minimock.NewController(t)
+	repoMock := mock.NewOrderRepoFacadeMock(ctrl)
+	repoMock.WithinTxMock.Optional().Set(func(ctx context.Context, fn func(ctxTx context.Context) error) error {
+		return fn(ctx)
+	})
+
+	return repository.NewCachedFacade(repoMock, cache.NewOrderCache(time.Hour, 1, 0, 0), opts...), repoMock
+}
+
+func withListCaches() repository.CachedFacadeOption {
+	return repository.WithListCaches(
+		cache.NewListCache[int](cache.ClientOrdersCacheName, time.Hour, 0),
+		cache.NewListCache[repository.RefundsPage](cache.RefundsCacheName, time.Hour, 0),
+	)
+}
+
+func TestCachedFacade_ReadThrough(t *testing.T) {
+	ctx := context.Background()
+	facade, repoMock := newCachedFacade(t)
+
+	order := dto.OrderDTO{ID: 1, ClientID: 10, Version: 2}
+	repoMock.GetOrderByIDMock.Expect(minimock.AnyContext, 1).Times(1).Return(&order, nil)
+
+	for i := 0; i < 2; i++ {
+		got, err := facade.GetOrderByID(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, order, *got)
+	}
+}
+
+func TestCachedFacade_ReadThroughSingleflight(t *testing.T) {
+	ctx := context.Background()
+	facade, repoMock := newCachedFacade(t)
+
+	var loads atomic.Int32
+	release := make(chan struct{})
+	repoMock.GetOrderByIDMock.Set(func(ctx context.Context, id int64) (*dto.OrderDTO, error) {
+		loads.Add(1)
+		<-release
+		return &dto.OrderDTO{ID: id}, nil
+	})
+
+	const callers = 10
+
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			got, err := facade.GetOrderByID(ctx, 1)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(1), got.ID)
+		}()
+	}
+
+	// callers coming after the load find the order in the cache, so one load is expected anyway
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), loads.Load())
+}
+
+func TestCachedFacade_ReadThroughStaleLoad(t *testing.T) {
+	ctx := context.Background()
+	facade, repoMock := newCachedFacade(t)
+
+	stale := dto.OrderDTO{ID: 1, ClientID: 10, Status: "received", Version: 2}
+	fresh := stale
+	fresh.Status = "pickedUp"
+
+	repoMock.UpdateOrderMock.Return(nil)
+	repoMock.GetOrderByIDMock.Times(1).Set(func(ctx context.Context, id int64) (*dto.OrderDTO, error) {
+		// order is written after the load has read it
+		require.NoError(t, facade.UpdateOrder(ctx, fresh))
+		return &stale, nil
+	})
+
+	got, err := facade.GetOrderByID(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, stale, *got)
+
+	got, err = facade.GetOrderByID(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "pickedUp", got.Status)
+	assert.Equal(t, int64(3), got.Version)
+}
+
+func TestCachedFacade_ReadThroughError(t *testing.T) {
+	ctx := context.Background()
+	facade, repoMock := newCachedFacade(t)
+
+	errNotFound := errors.New("not found")
+	repoMock.GetOrderByIDMock.Times(2).Return(nil, errNotFound)
+
+	for i := 0; i < 2; i++ {
+		_, err := facade.GetOrderByID(ctx, 1)
+		assert.ErrorIs(t, err, errNotFound)
+	}
+}
+
+func TestCachedFacade_GetOrdersByIDs(t *testing.T) {
+	ctx := context.Background()
+	facade, repoMock := newCachedFacade(t)
+
+	repoMock.AddOrderMock.Return(nil)
+	require.NoError(t, facade.AddOrder(ctx, dto.OrderDTO{ID: 1, ClientID: 10}))
+
+	// only orders missing from the cache are loaded
+	repoMock.GetOrdersByIDsMock.Expect(minimock.AnyContext, []int64{2, 3}).Times(1).Return(&dto.ListOrdersDTO{
+		Orders: []dto.OrderDTO{{ID: 2, ClientID: 10}},
+	}, nil)
+
+	list, err := facade.GetOrdersByIDs(ctx, []int64{1, 2, 3})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []dto.OrderDTO{{ID: 1, ClientID: 10}, {ID: 2, ClientID: 10}}, list.Orders)
+
+	// and cached for the next call
+	list, err = facade.GetOrdersByIDs(ctx, []int64{1, 2})
+	require.NoError(t, err)
+	assert.Len(t, list.Orders, 2)
+}
+
+func TestCachedFacade_CopiesOrders(t *testing.T) {
+	ctx := context.Background()
+	facade, repoMock := newCachedFacade(t)
+
+	newOrder := func(id int64) dto.OrderDTO {
+		return dto.OrderDTO{
+			ID:       id,
+			Packages: []string{"box"},
+			Items:    []dto.OrderItemDTO{{OrderID: id, SKU: "phone", Status: "received"}},
+		}
+	}
+
+	change := func(order *dto.OrderDTO) {
+		order.Packages[0] = "bag"
+		order.Items[0].Status = "pickedUp"
+	}
+
+	// order written to the cache is not shared with the writer
+	written := newOrder(1)
+	repoMock.AddOrderMock.Return(nil)
+	require.NoError(t, facade.AddOrder(ctx, written))
+	change(&written)
+
+	// nor with readers of single orders
+	got, err := facade.GetOrderByID(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, newOrder(1), *got)
+	change(got)
+
+	got, err = facade.GetOrderByID(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, newOrder(1), *got)
+
+	// and lists of orders, loaded or cached
+	repoMock.GetOrdersByIDsMock.Expect(minimock.AnyContext, []int64{2}).Times(1).Return(&dto.ListOrdersDTO{
+		Orders: []dto.OrderDTO{newOrder(2)},
+	}, nil)
+
+	for i := 0; i < 2; i++ {
+		list, err := facade.GetOrdersByIDs(ctx, []int64{1, 2})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []dto.OrderDTO{newOrder(1), newOrder(2)}, list.Orders)
+
+		for j := range list.Orders {
+			change(&list.Orders[j])
+		}
+	}
+}
+
+func TestCachedFacade_WriteThrough(t *testing.T) {
+	ctx := context.Background()
+	facade, repoMock := newCachedFacade(t)
+
+	order := dto.OrderDTO{ID: 1, ClientID: 10, Status: domain.OrderStatusMap[domain.OrderStatusReceived]}
+	repoMock.AddOrderMock.Return(nil)
+	require.NoError(t, facade.AddOrder(ctx, order))
+
+	got, err := facade.GetOrderByID(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, order, *got)
+
+	order.Status = domain.OrderStatusMap[domain.OrderStatusPickedUp]
+	repoMock.UpdateOrderMock.Return(nil)
+	require.NoError(t, facade.UpdateOrder(ctx, order))
+
+	// cached copy carries the version bumped by storage
+	got, err = facade.GetOrderByID(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, order.Status, got.Status)
+	assert.Equal(t, int64(1), got.Version)
+
+	second := dto.OrderDTO{ID: 2, ClientID: 10}
+	repoMock.HandOverOrdersMock.Return(1, nil)
+	_, err = facade.HandOverOrders(ctx, dto.HandoverDTO{Orders: []dto.OrderDTO{second}})
+	require.NoError(t, err)
+
+	got, err = facade.GetOrderByID(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), got.Version)
+}
+
+func TestCachedFacade_InvalidateOnFailure(t *testing.T) {
+	ctx := context.Background()
+	facade, repoMock := newCachedFacade(t)
+
+	order := dto.OrderDTO{ID: 1, ClientID: 10}
+	repoMock.AddOrderMock.Return(nil)
+	require.NoError(t, facade.AddOrder(ctx, order))
+
+	repoMock.UpdateOrderMock.Return(domain.ErrConcurrentModification)
+	assert.ErrorIs(t, facade.UpdateOrder(ctx, order), domain.ErrConcurrentModification)
+
+	fresh := dto.OrderDTO{ID: 1, ClientID: 10, Version: 3}
+	repoMock.GetOrderByIDMock.Expect(minimock.AnyContext, 1).Return(&fresh, nil)
+
+	got, err := facade.GetOrderByID(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, fresh, *got)
+}
+
+func TestCachedFacade_WithinTx(t *testing.T) {
+	ctx := context.Background()
+	facade, repoMock := newCachedFacade(t)
+
+	errAudit := errors.New("audit failed")
+	stored := dto.OrderDTO{ID: 1, ClientID: 10}
+	repoMock.GetOrderByIDMock.Return(&stored, nil)
+	repoMock.UpdateOrderMock.Return(nil)
+
+	// rolled back update does not reach the cache
+	err := facade.WithinTx(ctx, func(ctxTx context.Context) error {
+		if err := facade.UpdateOrder(ctxTx, dto.OrderDTO{ID: 1, ClientID: 20}); err != nil {
+			return err
+		}
+
+		return errAudit
+	})
+	assert.ErrorIs(t, err, errAudit)
+
+	got, err := facade.GetOrderByID(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 10, got.ClientID)
+
+	// committed update replaces cached copy, reads inside the transaction go to storage
+	err = facade.WithinTx(ctx, func(ctxTx context.Context) error {
+		if err := facade.UpdateOrder(ctxTx, dto.OrderDTO{ID: 1, ClientID: 30}); err != nil {
+			return err
+		}
+
+		got, err := facade.GetOrderByID(ctxTx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 10, got.ClientID)
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	got, err = facade.GetOrderByID(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 30, got.ClientID)
+	assert.Equal(t, uint64(2), repoMock.GetOrderByIDAfterCounter())
+}
+
+func TestCachedFacade_ClientOrdersList(t *testing.T) {
+	ctx := context.Background()
+	facade, repoMock := newCachedFacade(t, withListCaches())
+
+	repoMock.GetClientOrdersListMock.Set(func(ctx context.Context, clientID int) (*dto.ListOrdersDTO, error) {
+		return &dto.ListOrdersDTO{Orders: []dto.OrderDTO{{ID: int64(clientID), ClientID: clientID}}}, nil
+	})
+	repoMock.UpdateOrderMock.Return(nil)
+
+	for _, clientID := range []int{10, 10, 20} {
+		_, err := facade.GetClientOrdersList(ctx, clientID)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, uint64(2), repoMock.GetClientOrdersListAfterCounter())
+
+	// only the list of the order client is dropped
+	require.NoError(t, facade.UpdateOrder(ctx, dto.OrderDTO{ID: 1, ClientID: 10}))
+
+	for _, clientID := range []int{10, 20} {
+		_, err := facade.GetClientOrdersList(ctx, clientID)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, uint64(3), repoMock.GetClientOrdersListAfterCounter())
+
+	// lists are dropped once the transaction ends
+	err := facade.WithinTx(ctx, func(ctxTx context.Context) error {
+		if err := facade.UpdateOrder(ctxTx, dto.OrderDTO{ID: 1, ClientID: 10}); err != nil {
+			return err
+		}
+
+		_, err := facade.GetClientOrdersList(ctx, 10)
+		return err
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), repoMock.GetClientOrdersListAfterCounter())
+
+	_, err = facade.GetClientOrdersList(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), repoMock.GetClientOrdersListAfterCounter())
+}
+
+func TestCachedFacade_RefundsList(t *testing.T) {
+	ctx := context.Background()
+	facade, repoMock := newCachedFacade(t, withListCaches())
+
+	var (
+		refunded = domain.OrderStatusMap[domain.OrderStatusRefunded]
+		returned = domain.OrderStatusMap[domain.OrderStatusReturnedToSeller]
+	)
+
+	loads := make(map[string]int)
+	repoMock.GetRefundsListMock.Set(func(ctx context.Context, statuses []string, limit, offset int) (*dto.ListOrdersDTO, error) {
+		loads[statuses[0]]++
+		return &dto.ListOrdersDTO{}, nil
+	})
+	repoMock.AddOrderMock.Return(nil)
+	repoMock.UpdateOrderMock.Return(nil)
+
+	listAll := func() {
+		for _, status := range []string{refunded, returned} {
+			_, err := facade.GetRefundsList(ctx, []string{status}, 10, 0)
+			require.NoError(t, err)
+		}
+	}
+
+	order := dto.OrderDTO{ID: 1, ClientID: 10, Status: domain.OrderStatusMap[domain.OrderStatusPickedUp]}
+	require.NoError(t, facade.AddOrder(ctx, order))
+
+	listAll()
+	listAll()
+	assert.Equal(t, map[string]int{refunded: 1, returned: 1}, loads)
+
+	// order enters refunded pages
+	order.Status = refunded
+	require.NoError(t, facade.UpdateOrder(ctx, order))
+	listAll()
+	assert.Equal(t, map[string]int{refunded: 2, returned: 1}, loads)
+
+	// and moves from refunded pages to returned ones
+	order.Version++
+	order.Status = returned
+	require.NoError(t, facade.UpdateOrder(ctx, order))
+	listAll()
+	assert.Equal(t, map[string]int{refunded: 3, returned: 2}, loads)
+
+	// order changed by another replica with unknown previous status drops all pages
+	facade.Invalidate(dto.OrderDTO{ID: 2, ClientID: 20, Status: returned})
+	listAll()
+	assert.Equal(t, map[string]int{refunded: 4, returned: 3}, loads)
+}
+
+func TestCachedFacade_WarmUp(t *testing.T) {
+	ctx := context.Background()
+	facade, repoMock := newCachedFacade(t)
+
+	received := []string{domain.OrderStatusMap[domain.OrderStatusReceived]}
+	repoMock.ListOrdersByStatusMock.When(minimock.AnyContext, received, 2, 0).Then(&dto.ListOrdersDTO{
+		Orders: []dto.OrderDTO{{ID: 1}, {ID: 2}},
+	}, nil)
+	repoMock.ListOrdersByStatusMock.When(minimock.AnyContext, received, 2, 2).Then(&dto.ListOrdersDTO{
+		Orders: []dto.OrderDTO{{ID: 3}},
+	}, nil)
+
+	n, err := facade.WarmUp(ctx, received, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	// warmed up orders are served from the cache
+	list, err := facade.GetOrdersByIDs(ctx, []int64{1, 2, 3})
+	require.NoError(t, err)
+	assert.Len(t, list.Orders, 3)
+}
+
+func TestCachedFacade_NotFound(t *testing.T) {
+	ctx := context.Background()
+	clk := clock.NewFake(time.Date(2024, time.October, 1, 12, 0, 0, 0, time.UTC))
+	errNotFound := errors.New("order not found")
+
+	repoMock := mock.NewOrderRepoFacadeMock(minimock.NewController(t))
+	facade := repository.NewCachedFacade(repoMock,
+		cache.NewOrderCache(time.Hour, 1, 0, 0, cache.WithClock[int64, *dto.OrderDTO](clk)),
+		repository.WithClock(clk),
+		repository.WithNotFoundCache(cache.NewNotFoundCache(10*time.Second, 0, cache.WithClock[int64, error](clk)), errNotFound),
+	)
+
+	loads := map[int64]int{}
+	repoMock.GetOrderByIDMock.Set(func(ctx context.Context, id int64) (*dto.OrderDTO, error) {
+		loads[id]++
+		if id == 2 {
+			return nil, errors.New("connection reset")
+		}
+
+		return nil, fmt.Errorf("GetOrderByID: %w", errNotFound)
+	})
+
+	// unknown id reaches storage once per ttl, other errors are not cached
+	for i := 0; i < 2; i++ {
+		_, err := facade.GetOrderByID(ctx, 1)
+		assert.ErrorIs(t, err, errNotFound)
+
+		_, err = facade.GetOrderByID(ctx, 2)
+		assert.Error(t, err)
+	}
+	assert.Equal(t, map[int64]int{1: 1, 2: 2}, loads)
+
+	clk.Advance(11 * time.Second)
+	_, err := facade.GetOrderByID(ctx, 1)
+	assert.ErrorIs(t, err, errNotFound)
+	assert.Equal(t, 2, loads[1])
+
+	// added order is found right away
+	repoMock.AddOrderMock.Return(nil)
+	require.NoError(t, facade.AddOrder(ctx, dto.OrderDTO{ID: 1, ClientID: 10}))
+
+	got, err := facade.GetOrderByID(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), got.ID)
+}
@@ -0,0 +1,60 @@
+package repository_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/Na322Pr/route256/internal/app/mw"
+	"github.com/Na322Pr/route256/internal/repository"
+	"github.com/Na322Pr/route256/internal/repository/postgres"
+	"github.com/Na322Pr/route256/internal/repository/repotest"
+	"github.com/Na322Pr/route256/internal/usecase"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+// TEST_POSTGRES_DSN points to migrated database, its tables are truncated before every case
+const postgresDSNEnv = "TEST_POSTGRES_DSN"
+
+func newTestPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+
+	dsn := os.Getenv(postgresDSNEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", postgresDSNEnv)
+	}
+
+	pool, err := pgxpool.New(context.Background(), dsn)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	return pool
+}
+
+func TestStorageFacade_Conformance(t *testing.T) {
+	ctx := context.Background()
+	pool := newTestPool(t)
+
+	repotest.Run(t, repotest.Backend{
+		New: func(t *testing.T) usecase.OrderRepoFacade {
+			_, err := pool.Exec(ctx, "truncate orders, order_items, orders_archive, order_items_archive, handovers, handover_orders, audit_log restart identity cascade")
+			require.NoError(t, err)
+
+			return repository.NewFacade(pool)
+		},
+		ErrOrderNotFound: postgres.ErrOrderNotFound,
+	})
+}
+
+func TestIdempotencyStore_Conformance(t *testing.T) {
+	ctx := context.Background()
+	pool := newTestPool(t)
+
+	repotest.RunIdempotencyStore(t, func(t *testing.T) mw.IdempotencyStore {
+		_, err := pool.Exec(ctx, "truncate idempotency_keys")
+		require.NoError(t, err)
+
+		return postgres.NewIdempotencyStore(postgres.NewTxManager(pool))
+	})
+}
@@ -25,8 +25,8 @@ func NewStorageFacade(
 }
 
 func (s *StorageFacade) AddOrder(ctx context.Context, orderDTO dto.OrderDTO) error {
-	return s.txManager.RunSerializable(ctx, func(ctxTx context.Context) error {
-		err := s.pgOrderRepository.AddOrder(ctx, orderDTO)
+	return s.txManager.RunSerializable(ctx, postgres.TxModeReadWrite, func(ctxTx context.Context) error {
+		err := s.pgOrderRepository.AddOrder(ctxTx, orderDTO)
 		if err != nil {
 			return err
 		}
@@ -36,8 +36,8 @@ func (s *StorageFacade) AddOrder(ctx context.Context, orderDTO dto.OrderDTO) err
 }
 
 func (s *StorageFacade) UpdateOrder(ctx context.Context, orderDTO dto.OrderDTO) error {
-	return s.txManager.RunSerializable(ctx, func(ctxTx context.Context) error {
-		err := s.pgOrderRepository.UpdateOrder(ctx, orderDTO)
+	return s.txManager.RunSerializable(ctx, postgres.TxModeReadWrite, func(ctxTx context.Context) error {
+		err := s.pgOrderRepository.UpdateOrder(ctxTx, orderDTO)
 		if err != nil {
 			return err
 		}
@@ -49,8 +49,8 @@ func (s *StorageFacade) UpdateOrder(ctx context.Context, orderDTO dto.OrderDTO)
 func (s *StorageFacade) GetOrderByID(ctx context.Context, id int64) (*dto.OrderDTO, error) {
 	var orderDTO *dto.OrderDTO
 
-	err := s.txManager.RunReadCommitted(ctx, func(ctxTx context.Context) error {
-		c, err := s.pgOrderRepository.GetOrderByID(ctx, id)
+	err := s.txManager.RunReadCommitted(ctx, postgres.TxModeReadOnly, func(ctxTx context.Context) error {
+		c, err := s.pgOrderRepository.GetOrderByID(ctxTx, id)
 		if err != nil {
 			return err
 		}
@@ -65,8 +65,8 @@ func (s *StorageFacade) GetOrderByID(ctx context.Context, id int64) (*dto.OrderD
 func (s *StorageFacade) GetOrdersByIDs(ctx context.Context, ids []int64) (*dto.ListOrdersDTO, error) {
 	var listOrdersDTO *dto.ListOrdersDTO
 
-	err := s.txManager.RunReadCommitted(ctx, func(ctxTx context.Context) error {
-		c, err := s.pgOrderRepository.GetOrdersByIDs(ctx, ids)
+	err := s.txManager.RunReadCommitted(ctx, postgres.TxModeReadOnly, func(ctxTx context.Context) error {
+		c, err := s.pgOrderRepository.GetOrdersByIDs(ctxTx, ids)
 		if err != nil {
 			return err
 		}
@@ -79,15 +79,131 @@ func (s *StorageFacade) GetOrdersByIDs(ctx context.Context, ids []int64) (*dto.L
 }
 
 func (s *StorageFacade) GetClientOrdersList(ctx context.Context, clientID int) (*dto.ListOrdersDTO, error) {
-	return s.pgOrderRepository.GetClientOrdersList(ctx, clientID)
+	var listOrdersDTO *dto.ListOrdersDTO
+
+	err := s.txManager.RunReplicaRead(ctx, func(ctxTx context.Context) error {
+		c, err := s.pgOrderRepository.GetClientOrdersList(ctxTx, clientID)
+		if err != nil {
+			return err
+		}
+
+		listOrdersDTO = c
+		return nil
+	})
+
+	return listOrdersDTO, err
+}
+
+func (s *StorageFacade) GetRefundsList(ctx context.Context, statuses []string, limit, offset int) (*dto.ListOrdersDTO, error) {
+	var listOrdersDTO *dto.ListOrdersDTO
+
+	err := s.txManager.RunReplicaRead(ctx, func(ctxTx context.Context) error {
+		c, err := s.pgOrderRepository.GetRefundsList(ctxTx, statuses, limit, offset)
+		if err != nil {
+			return err
+		}
+
+		listOrdersDTO = c
+		return nil
+	})
+
+	return listOrdersDTO, err
+}
+
+func (s *StorageFacade) ListOrdersByStatus(ctx context.Context, statuses []string, limit, offset int) (*dto.ListOrdersDTO, error) {
+	var listOrdersDTO *dto.ListOrdersDTO
+
+	err := s.txManager.RunReplicaRead(ctx, func(ctxTx context.Context) error {
+		c, err := s.pgOrderRepository.ListOrdersByStatus(ctxTx, statuses, limit, offset)
+		if err != nil {
+			return err
+		}
+
+		listOrdersDTO = c
+		return nil
+	})
+
+	return listOrdersDTO, err
+}
+
+func (s *StorageFacade) HandOverOrders(ctx context.Context, handoverDTO dto.HandoverDTO) (int64, error) {
+	var handoverID int64
+
+	err := s.txManager.RunSerializable(ctx, postgres.TxModeReadWrite, func(ctxTx context.Context) error {
+		id, err := s.pgOrderRepository.AddHandover(ctxTx, handoverDTO)
+		if err != nil {
+			return err
+		}
+
+		for _, orderDTO := range handoverDTO.Orders {
+			if err := s.pgOrderRepository.UpdateOrder(ctxTx, orderDTO); err != nil {
+				return err
+			}
+		}
+
+		handoverID = id
+		return nil
+	})
+
+	return handoverID, err
 }
 
-func (s *StorageFacade) GetRefundsList(ctx context.Context, limit, offset int) (*dto.ListOrdersDTO, error) {
-	return s.pgOrderRepository.GetRefundsList(ctx, limit, offset)
+func (s *StorageFacade) UpdateOrders(ctx context.Context, ordersDTO []dto.OrderDTO) error {
+	return s.txManager.RunSerializable(ctx, postgres.TxModeReadWrite, func(ctxTx context.Context) error {
+		for _, orderDTO := range ordersDTO {
+			if err := s.pgOrderRepository.UpdateOrder(ctxTx, orderDTO); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (s *StorageFacade) ArchiveOrders(ctx context.Context, archiveDTO dto.ArchiveOrdersDTO) ([]int64, error) {
+	var archived []int64
+
+	err := s.txManager.RunSerializable(ctx, postgres.TxModeReadWrite, func(ctxTx context.Context) error {
+		ids, err := s.pgOrderRepository.ArchiveOrders(ctxTx, archiveDTO)
+		if err != nil {
+			return err
+		}
+
+		archived = ids
+		return nil
+	})
+
+	return archived, err
+}
+
+func (s *StorageFacade) WithinTx(ctx context.Context, fn func(ctxTx context.Context) error) error {
+	return s.txManager.RunSerializable(ctx, postgres.TxModeReadWrite, fn)
+}
+
+func (s *StorageFacade) AddAuditLog(ctx context.Context, entry dto.AuditLogDTO) error {
+	return s.txManager.RunSerializable(ctx, postgres.TxModeReadWrite, func(ctxTx context.Context) error {
+		return s.pgOrderRepository.AddAuditLog(ctxTx, entry)
+	})
+}
+
+func (s *StorageFacade) QueryAuditLog(ctx context.Context, filter dto.AuditLogFilterDTO) (*dto.ListAuditLogDTO, error) {
+	var listAuditLogDTO *dto.ListAuditLogDTO
+
+	err := s.txManager.RunReplicaRead(ctx, func(ctxTx context.Context) error {
+		c, err := s.pgOrderRepository.QueryAuditLog(ctxTx, filter)
+		if err != nil {
+			return err
+		}
+
+		listAuditLogDTO = c
+		return nil
+	})
+
+	return listAuditLogDTO, err
 }
 
-func NewFacade(pool *pgxpool.Pool) usecase.OrderRepoFacade {
-	txManager := postgres.NewTxManager(pool)
+func NewFacade(pool *pgxpool.Pool, opts ...postgres.TxManagerOption) usecase.OrderRepoFacade {
+	txManager := postgres.NewTxManager(pool, opts...)
 	pgOrderRepository := postgres.NewPgOrderRepository(txManager)
 	return NewStorageFacade(txManager, pgOrderRepository)
 }
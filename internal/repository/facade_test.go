@@ -0,0 +1,65 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Na322Pr/route256/internal/dto"
+	"github.com/Na322Pr/route256/internal/repository"
+	"github.com/Na322Pr/route256/internal/repository/postgres"
+	"github.com/Na322Pr/route256/internal/repository/postgres/pgtest"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageFacade_WritesInsideTransaction(t *testing.T) {
+	order := dto.OrderDTO{
+		ID:    1,
+		Items: []dto.OrderItemDTO{{SKU: "phone", Status: "received"}},
+	}
+
+	tests := []struct {
+		name      string
+		call      func(facade *repository.StorageFacade) error
+		wantExecs int
+	}{
+		{
+			name: "AddOrder",
+			call: func(facade *repository.StorageFacade) error {
+				return facade.AddOrder(context.Background(), order)
+			},
+			wantExecs: 2,
+		},
+		{
+			name: "UpdateOrder",
+			call: func(facade *repository.StorageFacade) error {
+				return facade.UpdateOrder(context.Background(), order)
+			},
+			wantExecs: 2,
+		},
+		{
+			name: "UpdateOrders",
+			call: func(facade *repository.StorageFacade) error {
+				return facade.UpdateOrders(context.Background(), []dto.OrderDTO{order, order})
+			},
+			wantExecs: 4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := &pgtest.FakeDB{}
+			txManager := postgres.NewTxManager(db)
+			facade := repository.NewStorageFacade(txManager, postgres.NewPgOrderRepository(txManager))
+
+			require.NoError(t, tt.call(facade))
+
+			// nothing is executed outside of transaction
+			assert.Empty(t, db.Execs)
+			require.Len(t, db.Txs, 1)
+			assert.Equal(t, pgx.ReadWrite, db.Opts[0].AccessMode)
+			assert.Len(t, db.Txs[0].Execs, tt.wantExecs)
+			assert.True(t, db.Txs[0].Committed)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Na322Pr/route256/internal/dto"
+)
+
+func (r *MemOrderRepository) WithinTx(ctx context.Context, fn func(ctxTx context.Context) error) error {
+	return r.txManager.RunSerializable(ctx, TxModeReadWrite, fn)
+}
+
+func (r *MemOrderRepository) AddAuditLog(ctx context.Context, entry dto.AuditLogDTO) error {
+	const op = "MemOrderRepository.AddAuditLog"
+
+	return r.txManager.RunSerializable(ctx, TxModeReadWrite, func(ctxTx context.Context) error {
+		data, err := r.txManager.storage(ctxTx, TxModeReadWrite)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		data.addAuditEntry(copyAuditEntry(entry))
+
+		return nil
+	})
+}
+
+// QueryAuditLog returns matching entries newest first
+func (r *MemOrderRepository) QueryAuditLog(ctx context.Context, filter dto.AuditLogFilterDTO) (*dto.ListAuditLogDTO, error) {
+	const op = "MemOrderRepository.QueryAuditLog"
+
+	entries := make([]dto.AuditLogDTO, 0)
+
+	err := r.txManager.RunReadCommitted(ctx, TxModeReadOnly, func(ctxTx context.Context) error {
+		data, err := r.txManager.storage(ctxTx, TxModeReadOnly)
+		if err != nil {
+			return err
+		}
+
+		for i := len(data.auditLog) - 1; i >= 0; i-- {
+			if entry := data.auditLog[i]; auditEntryMatches(entry, filter) {
+				entries = append(entries, copyAuditEntry(entry))
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if filter.Offset > 0 {
+		entries = entries[min(filter.Offset, len(entries)):]
+	}
+
+	if filter.Limit > 0 {
+		entries = entries[:min(filter.Limit, len(entries))]
+	}
+
+	return &dto.ListAuditLogDTO{Entries: entries}, nil
+}
+
+func auditEntryMatches(entry dto.AuditLogDTO, filter dto.AuditLogFilterDTO) bool {
+	switch {
+	case filter.OperatorID != "" && entry.OperatorID != filter.OperatorID:
+		return false
+	case filter.Method != "" && entry.Method != filter.Method:
+		return false
+	case filter.OrderID != 0 && !slices.Contains(entry.OrderIDs, filter.OrderID):
+		return false
+	case !filter.From.IsZero() && entry.CreatedAt.Before(filter.From):
+		return false
+	case !filter.To.IsZero() && !entry.CreatedAt.Before(filter.To):
+		return false
+	}
+
+	return true
+}
+
+func copyAuditEntry(entry dto.AuditLogDTO) dto.AuditLogDTO {
+	entry.OrderIDs = slices.Clone(entry.OrderIDs)
+	entry.Before = slices.Clone(entry.Before)
+	entry.After = slices.Clone(entry.After)
+	return entry
+}
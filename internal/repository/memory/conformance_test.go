@@ -0,0 +1,26 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/Na322Pr/route256/internal/app/mw"
+	"github.com/Na322Pr/route256/internal/repository/memory"
+	"github.com/Na322Pr/route256/internal/repository/repotest"
+	"github.com/Na322Pr/route256/internal/usecase"
+)
+
+func TestMemOrderRepository_Conformance(t *testing.T) {
+	repotest.Run(t, repotest.Backend{
+		New: func(t *testing.T) usecase.OrderRepoFacade {
+			return memory.NewFacade()
+		},
+		ErrOrderNotFound: memory.ErrOrderNotFound,
+		ErrAlreadyExist:  memory.ErrAlreadyExist,
+	})
+}
+
+func TestIdempotencyStore_Conformance(t *testing.T) {
+	repotest.RunIdempotencyStore(t, func(t *testing.T) mw.IdempotencyStore {
+		return memory.NewIdempotencyStore()
+	})
+}
@@ -0,0 +1,13 @@
+package memory
+
+import "errors"
+
+var (
+	ErrAlreadyExist  = errors.New("order already exist")
+	ErrOrderNotFound = errors.New("order not found")
+
+	ErrIdempotencyKeyNotFound = errors.New("idempotency key not found")
+
+	ErrReadOnlyTx = errors.New("read-write call inside read-only transaction")
+	ErrNoTx       = errors.New("storage accessed outside of transaction")
+)
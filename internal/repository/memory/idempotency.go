@@ -0,0 +1,75 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/Na322Pr/route256/internal/dto"
+)
+
+// IdempotencyStore keeps idempotency records apart from orders,
+// they are never part of order transactions
+type IdempotencyStore struct {
+	mu      sync.Mutex
+	records map[string]dto.IdempotencyRecordDTO
+}
+
+func NewIdempotencyStore() *IdempotencyStore {
+	return &IdempotencyStore{records: make(map[string]dto.IdempotencyRecordDTO)}
+}
+
+func (s *IdempotencyStore) Reserve(ctx context.Context, record dto.IdempotencyRecordDTO) (*dto.IdempotencyRecordDTO, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if existing, ok := s.records[record.Key]; ok && existing.ExpiresAt.After(record.CreatedAt) {
+		existing.Response = slices.Clone(existing.Response)
+		return &existing, nil
+	}
+
+	record.Response = nil
+	s.records[record.Key] = record
+
+	return nil, nil
+}
+
+func (s *IdempotencyStore) Complete(ctx context.Context, key string, response []byte, expiresAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, ok := s.records[key]
+	if !ok {
+		return ErrIdempotencyKeyNotFound
+	}
+
+	record.Response = slices.Clone(response)
+	record.ExpiresAt = expiresAt
+	s.records[key] = record
+
+	return nil
+}
+
+func (s *IdempotencyStore) Release(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.records, key)
+	return nil
+}
+
+func (s *IdempotencyStore) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var deleted int64
+	for key, record := range s.records {
+		if !record.ExpiresAt.After(now) {
+			delete(s.records, key)
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,316 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/Na322Pr/route256/internal/domain"
+	"github.com/Na322Pr/route256/internal/dto"
+	"github.com/Na322Pr/route256/internal/usecase"
+)
+
+type MemOrderRepository struct {
+	txManager *TxManager
+}
+
+func NewMemOrderRepository(txManager *TxManager) *MemOrderRepository {
+	return &MemOrderRepository{txManager: txManager}
+}
+
+func NewFacade() usecase.OrderRepoFacade {
+	return NewMemOrderRepository(NewTxManager())
+}
+
+func (r *MemOrderRepository) AddOrder(ctx context.Context, orderDTO dto.OrderDTO) error {
+	const op = "MemOrderRepository.AddOrder"
+
+	return r.txManager.RunSerializable(ctx, TxModeReadWrite, func(ctxTx context.Context) error {
+		data, err := r.txManager.storage(ctxTx, TxModeReadWrite)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		// archived order id is taken for good as well
+		if _, ok := data.orders[orderDTO.ID]; ok {
+			return fmt.Errorf("%s: %w", op, ErrAlreadyExist)
+		}
+		if _, ok := data.archive[orderDTO.ID]; ok {
+			return fmt.Errorf("%s: %w", op, ErrAlreadyExist)
+		}
+
+		// only columns written by insert are kept, the rest start from storage defaults
+		stored := dto.OrderDTO{
+			ID:         orderDTO.ID,
+			ClientID:   orderDTO.ClientID,
+			StoreUntil: orderDTO.StoreUntil,
+			Status:     orderDTO.Status,
+			Cost:       orderDTO.Cost,
+			Weight:     orderDTO.Weight,
+			Packages:   slices.Clone(orderDTO.Packages),
+		}
+
+		for _, item := range orderDTO.Items {
+			item.OrderID = orderDTO.ID
+			stored.Items = append(stored.Items, item)
+		}
+		sortItems(stored.Items)
+
+		data.setOrder(stored)
+		return nil
+	})
+}
+
+func (r *MemOrderRepository) UpdateOrder(ctx context.Context, orderDTO dto.OrderDTO) error {
+	const op = "MemOrderRepository.UpdateOrder"
+
+	return r.txManager.RunSerializable(ctx, TxModeReadWrite, func(ctxTx context.Context) error {
+		if err := r.updateOrder(ctxTx, orderDTO); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		return nil
+	})
+}
+
+func (r *MemOrderRepository) UpdateOrders(ctx context.Context, ordersDTO []dto.OrderDTO) error {
+	const op = "MemOrderRepository.UpdateOrders"
+
+	return r.txManager.RunSerializable(ctx, TxModeReadWrite, func(ctxTx context.Context) error {
+		for _, orderDTO := range ordersDTO {
+			if err := r.updateOrder(ctxTx, orderDTO); err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *MemOrderRepository) GetOrderByID(ctx context.Context, id int64) (*dto.OrderDTO, error) {
+	const op = "MemOrderRepository.GetOrderByID"
+
+	var orderDTO dto.OrderDTO
+
+	err := r.txManager.RunReadCommitted(ctx, TxModeReadOnly, func(ctxTx context.Context) error {
+		data, err := r.txManager.storage(ctxTx, TxModeReadOnly)
+		if err != nil {
+			return err
+		}
+
+		order, ok := data.orders[id]
+		if !ok {
+			order, ok = data.archive[id]
+			order.Archived = ok
+		}
+		if !ok {
+			return ErrOrderNotFound
+		}
+
+		orderDTO = copyOrder(order)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &orderDTO, nil
+}
+
+func (r *MemOrderRepository) GetOrdersByIDs(ctx context.Context, ids []int64) (*dto.ListOrdersDTO, error) {
+	const op = "MemOrderRepository.GetOrdersByIDs"
+
+	orders, err := r.selectOrders(ctx, func(order dto.OrderDTO) bool {
+		return slices.Contains(ids, order.ID)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &dto.ListOrdersDTO{Orders: orders}, nil
+}
+
+func (r *MemOrderRepository) GetClientOrdersList(ctx context.Context, clientID int) (*dto.ListOrdersDTO, error) {
+	const op = "MemOrderRepository.GetClientOrdersList"
+
+	received := domain.OrderStatusMap[domain.OrderStatusReceived]
+
+	orders, err := r.selectOrders(ctx, func(order dto.OrderDTO) bool {
+		return order.ClientID == clientID && order.Status == received
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &dto.ListOrdersDTO{Orders: orders}, nil
+}
+
+func (r *MemOrderRepository) GetRefundsList(ctx context.Context, statuses []string, limit, offset int) (*dto.ListOrdersDTO, error) {
+	return r.ListOrdersByStatus(ctx, statuses, limit, offset)
+}
+
+// ListOrdersByStatus lists live orders in statuses ordered by id, zero limit and offset do not bound the list
+func (r *MemOrderRepository) ListOrdersByStatus(ctx context.Context, statuses []string, limit, offset int) (*dto.ListOrdersDTO, error) {
+	const op = "MemOrderRepository.ListOrdersByStatus"
+
+	orders, err := r.selectOrders(ctx, func(order dto.OrderDTO) bool {
+		return slices.Contains(statuses, order.Status)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if offset > 0 {
+		orders = orders[min(offset, len(orders)):]
+	}
+
+	if limit > 0 {
+		orders = orders[:min(limit, len(orders))]
+	}
+
+	return &dto.ListOrdersDTO{Orders: orders}, nil
+}
+
+func (r *MemOrderRepository) HandOverOrders(ctx context.Context, handoverDTO dto.HandoverDTO) (int64, error) {
+	const op = "MemOrderRepository.HandOverOrders"
+
+	var handoverID int64
+
+	err := r.txManager.RunSerializable(ctx, TxModeReadWrite, func(ctxTx context.Context) error {
+		data, err := r.txManager.storage(ctxTx, TxModeReadWrite)
+		if err != nil {
+			return err
+		}
+
+		for _, orderDTO := range handoverDTO.Orders {
+			if err := r.updateOrder(ctxTx, orderDTO); err != nil {
+				return err
+			}
+		}
+
+		handoverID = data.addHandover(copyHandover(handoverDTO))
+
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return handoverID, nil
+}
+
+// ArchiveOrders moves a batch of matching orders into archive, lowest ids first.
+// Orders holding refused items not yet returned to courier stay
+func (r *MemOrderRepository) ArchiveOrders(ctx context.Context, archiveDTO dto.ArchiveOrdersDTO) ([]int64, error) {
+	const op = "MemOrderRepository.ArchiveOrders"
+
+	var archived []int64
+
+	err := r.txManager.RunSerializable(ctx, TxModeReadWrite, func(ctxTx context.Context) error {
+		data, err := r.txManager.storage(ctxTx, TxModeReadWrite)
+		if err != nil {
+			return err
+		}
+
+		ids := make([]int64, 0)
+		for id, order := range data.orders {
+			completedAt := order.StoreUntil
+			if order.PickUpTime.Valid {
+				completedAt = order.PickUpTime.Time
+			}
+
+			holdsRefused := slices.ContainsFunc(order.Items, func(item dto.OrderItemDTO) bool {
+				return item.Status == domain.OrderItemStatusMap[domain.OrderItemStatusRefused]
+			})
+
+			if slices.Contains(archiveDTO.Statuses, order.Status) && completedAt.Before(archiveDTO.Before) && !holdsRefused {
+				ids = append(ids, id)
+			}
+		}
+
+		slices.Sort(ids)
+		if archiveDTO.Limit > 0 && len(ids) > archiveDTO.Limit {
+			ids = ids[:archiveDTO.Limit]
+		}
+
+		for _, id := range ids {
+			data.archiveOrder(id)
+		}
+
+		archived = ids
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return archived, nil
+}
+
+// updateOrder writes the columns touched by postgres update and bumps version
+func (r *MemOrderRepository) updateOrder(ctx context.Context, orderDTO dto.OrderDTO) error {
+	data, err := r.txManager.storage(ctx, TxModeReadWrite)
+	if err != nil {
+		return err
+	}
+
+	stored, ok := data.orders[orderDTO.ID]
+	if !ok || stored.Version != orderDTO.Version {
+		return domain.ErrConcurrentModification
+	}
+
+	stored.Status = orderDTO.Status
+	stored.PickUpTime = orderDTO.PickUpTime
+	stored.RefundReason = orderDTO.RefundReason
+	stored.RefundComment = orderDTO.RefundComment
+	stored.InspectionCondition = orderDTO.InspectionCondition
+	stored.InspectionOutcome = orderDTO.InspectionOutcome
+	stored.Version++
+
+	stored.Items = slices.Clone(stored.Items)
+	for _, item := range orderDTO.Items {
+		for i := range stored.Items {
+			if stored.Items[i].SKU == item.SKU {
+				stored.Items[i].Status = item.Status
+			}
+		}
+	}
+
+	data.setOrder(stored)
+	return nil
+}
+
+func (r *MemOrderRepository) selectOrders(ctx context.Context, match func(order dto.OrderDTO) bool) ([]dto.OrderDTO, error) {
+	orders := make([]dto.OrderDTO, 0)
+
+	err := r.txManager.RunReadCommitted(ctx, TxModeReadOnly, func(ctxTx context.Context) error {
+		data, err := r.txManager.storage(ctxTx, TxModeReadOnly)
+		if err != nil {
+			return err
+		}
+
+		for _, order := range data.orders {
+			if match(order) {
+				orders = append(orders, copyOrder(order))
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(orders, func(i, j int) bool {
+		return orders[i].ID < orders[j].ID
+	})
+
+	return orders, nil
+}
+
+func sortItems(items []dto.OrderItemDTO) {
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].SKU < items[j].SKU
+	})
+}
@@ -0,0 +1,204 @@
+package memory_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Na322Pr/route256/internal/domain"
+	"github.com/Na322Pr/route256/internal/dto"
+	"github.com/Na322Pr/route256/internal/repository/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestOrder(id int64) dto.OrderDTO {
+	return dto.OrderDTO{
+		ID:         id,
+		ClientID:   10,
+		StoreUntil: time.Now().Add(24 * time.Hour),
+		Status:     domain.OrderStatusMap[domain.OrderStatusReceived],
+		Cost:       1000,
+		Weight:     5,
+		Items: []dto.OrderItemDTO{
+			{SKU: "phone", Quantity: 1, UnitPrice: 1000, Status: "received"},
+		},
+	}
+}
+
+func TestMemOrderRepository_AddOrder(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewMemOrderRepository(memory.NewTxManager())
+
+	require.NoError(t, repo.AddOrder(ctx, newTestOrder(1)))
+	assert.ErrorIs(t, repo.AddOrder(ctx, newTestOrder(1)), memory.ErrAlreadyExist)
+
+	got, err := repo.GetOrderByID(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), got.Items[0].OrderID)
+
+	_, err = repo.GetOrderByID(ctx, 2)
+	assert.ErrorIs(t, err, memory.ErrOrderNotFound)
+}
+
+func TestMemOrderRepository_UpdateOrder(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewMemOrderRepository(memory.NewTxManager())
+	require.NoError(t, repo.AddOrder(ctx, newTestOrder(1)))
+
+	order, err := repo.GetOrderByID(ctx, 1)
+	require.NoError(t, err)
+
+	order.Status = domain.OrderStatusMap[domain.OrderStatusPickedUp]
+	order.Items[0].Status = "pickedUp"
+	require.NoError(t, repo.UpdateOrder(ctx, *order))
+
+	assert.ErrorIs(t, repo.UpdateOrder(ctx, *order), domain.ErrConcurrentModification)
+
+	got, err := repo.GetOrderByID(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), got.Version)
+	assert.Equal(t, order.Status, got.Status)
+	assert.Equal(t, "pickedUp", got.Items[0].Status)
+}
+
+func TestMemOrderRepository_ConcurrentUpdate(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewMemOrderRepository(memory.NewTxManager())
+	require.NoError(t, repo.AddOrder(ctx, newTestOrder(1)))
+
+	order, err := repo.GetOrderByID(ctx, 1)
+	require.NoError(t, err)
+
+	const writers = 8
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		succeeded int
+	)
+
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if err := repo.UpdateOrder(ctx, *order); err == nil {
+				mu.Lock()
+				succeeded++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 1, succeeded)
+}
+
+func TestMemOrderRepository_HandOverOrdersRollback(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewMemOrderRepository(memory.NewTxManager())
+	require.NoError(t, repo.AddOrder(ctx, newTestOrder(1)))
+	require.NoError(t, repo.AddOrder(ctx, newTestOrder(2)))
+
+	first, err := repo.GetOrderByID(ctx, 1)
+	require.NoError(t, err)
+	first.Status = domain.OrderStatusMap[domain.OrderStatusReturnedToSeller]
+
+	stale := newTestOrder(2)
+	stale.Version = 5
+
+	_, err = repo.HandOverOrders(ctx, dto.HandoverDTO{
+		Courier: "courier",
+		Orders:  []dto.OrderDTO{*first, stale},
+	})
+	assert.ErrorIs(t, err, domain.ErrConcurrentModification)
+
+	got, err := repo.GetOrderByID(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, domain.OrderStatusMap[domain.OrderStatusReceived], got.Status)
+	assert.Equal(t, int64(0), got.Version)
+}
+
+func TestTxManager_Run(t *testing.T) {
+	ctx := context.Background()
+	txManager := memory.NewTxManager()
+	repo := memory.NewMemOrderRepository(txManager)
+
+	errRollback := errors.New("rollback")
+
+	err := txManager.RunSerializable(ctx, memory.TxModeReadWrite, func(ctxTx context.Context) error {
+		if err := repo.AddOrder(ctxTx, newTestOrder(1)); err != nil {
+			return err
+		}
+
+		if _, err := repo.GetOrderByID(ctxTx, 1); err != nil {
+			return err
+		}
+
+		return errRollback
+	})
+	assert.ErrorIs(t, err, errRollback)
+
+	_, err = repo.GetOrderByID(ctx, 1)
+	assert.ErrorIs(t, err, memory.ErrOrderNotFound)
+
+	err = txManager.RunReadCommitted(ctx, memory.TxModeReadOnly, func(ctxTx context.Context) error {
+		return repo.AddOrder(ctxTx, newTestOrder(1))
+	})
+	assert.ErrorIs(t, err, memory.ErrReadOnlyTx)
+}
+
+func TestTxManager_RollbackUndoesWrites(t *testing.T) {
+	ctx := context.Background()
+	txManager := memory.NewTxManager()
+	repo := memory.NewMemOrderRepository(txManager)
+
+	require.NoError(t, repo.AddOrder(ctx, newTestOrder(1)))
+	require.NoError(t, repo.AddOrder(ctx, newTestOrder(2)))
+
+	deleted := domain.OrderStatusMap[domain.OrderStatusDelete]
+	errRollback := errors.New("rollback")
+
+	err := txManager.RunSerializable(ctx, memory.TxModeReadWrite, func(ctxTx context.Context) error {
+		order := newTestOrder(1)
+		order.Status = deleted
+		if err := repo.UpdateOrder(ctxTx, order); err != nil {
+			return err
+		}
+
+		archived, err := repo.ArchiveOrders(ctxTx, dto.ArchiveOrdersDTO{
+			Statuses: []string{deleted},
+			Before:   time.Now().Add(48 * time.Hour),
+		})
+		require.NoError(t, err)
+		require.Equal(t, []int64{1}, archived)
+
+		if _, err := repo.HandOverOrders(ctxTx, dto.HandoverDTO{Courier: "courier"}); err != nil {
+			return err
+		}
+
+		if err := repo.AddAuditLog(ctxTx, dto.AuditLogDTO{OperatorID: "operator"}); err != nil {
+			return err
+		}
+
+		return errRollback
+	})
+	assert.ErrorIs(t, err, errRollback)
+
+	got, err := repo.GetOrderByID(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, domain.OrderStatusMap[domain.OrderStatusReceived], got.Status)
+	assert.Equal(t, int64(0), got.Version)
+
+	log, err := repo.QueryAuditLog(ctx, dto.AuditLogFilterDTO{})
+	require.NoError(t, err)
+	assert.Empty(t, log.Entries)
+
+	// handover id taken by the failed transaction is given out again
+	id, err := repo.HandOverOrders(ctx, dto.HandoverDTO{Courier: "courier"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), id)
+}
@@ -0,0 +1,105 @@
+package memory
+
+import (
+	"slices"
+
+	"github.com/Na322Pr/route256/internal/dto"
+)
+
+type storage struct {
+	orders         map[int64]dto.OrderDTO
+	archive        map[int64]dto.OrderDTO
+	handovers      map[int64]dto.HandoverDTO
+	lastHandoverID int64
+	auditLog       []dto.AuditLogDTO
+
+	// undo reverts writes of the running read-write transaction,
+	// stored values are never changed in place, so previous ones are kept as is
+	undo []func()
+}
+
+func newStorage() *storage {
+	return &storage{
+		orders:    make(map[int64]dto.OrderDTO),
+		archive:   make(map[int64]dto.OrderDTO),
+		handovers: make(map[int64]dto.HandoverDTO),
+	}
+}
+
+// setOrder writes order and logs how to bring back the previous one
+func (s *storage) setOrder(order dto.OrderDTO) {
+	prev, existed := s.orders[order.ID]
+	s.undo = append(s.undo, func() {
+		if existed {
+			s.orders[order.ID] = prev
+			return
+		}
+		delete(s.orders, order.ID)
+	})
+
+	s.orders[order.ID] = order
+}
+
+func (s *storage) archiveOrder(id int64) {
+	order := s.orders[id]
+	s.undo = append(s.undo, func() {
+		delete(s.archive, id)
+		s.orders[id] = order
+	})
+
+	s.archive[id] = order
+	delete(s.orders, id)
+}
+
+// addHandover stores handover under the next id and returns it
+func (s *storage) addHandover(handover dto.HandoverDTO) int64 {
+	prevID := s.lastHandoverID
+	s.lastHandoverID++
+	handover.ID = s.lastHandoverID
+
+	s.undo = append(s.undo, func() {
+		delete(s.handovers, handover.ID)
+		s.lastHandoverID = prevID
+	})
+
+	s.handovers[handover.ID] = handover
+	return handover.ID
+}
+
+func (s *storage) addAuditEntry(entry dto.AuditLogDTO) {
+	n := len(s.auditLog)
+	s.undo = append(s.undo, func() {
+		s.auditLog = s.auditLog[:n]
+	})
+
+	entry.ID = int64(n) + 1
+	s.auditLog = append(s.auditLog, entry)
+}
+
+// rollback reverts writes logged since the last commit, newest first
+func (s *storage) rollback() {
+	for i := len(s.undo) - 1; i >= 0; i-- {
+		s.undo[i]()
+	}
+	s.undo = nil
+}
+
+func (s *storage) commit() {
+	s.undo = nil
+}
+
+func copyOrder(order dto.OrderDTO) dto.OrderDTO {
+	order.Packages = slices.Clone(order.Packages)
+	order.Items = slices.Clone(order.Items)
+	return order
+}
+
+func copyHandover(handover dto.HandoverDTO) dto.HandoverDTO {
+	orders := make([]dto.OrderDTO, 0, len(handover.Orders))
+	for _, order := range handover.Orders {
+		orders = append(orders, copyOrder(order))
+	}
+
+	handover.Orders = orders
+	return handover
+}
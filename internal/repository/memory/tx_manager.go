@@ -0,0 +1,94 @@
+package memory
+
+import (
+	"context"
+	"sync"
+)
+
+// Transaction access mode
+type TxMode int
+
+const (
+	TxModeReadOnly TxMode = iota
+	TxModeReadWrite
+)
+
+type txManagerKey struct{}
+
+type txState struct {
+	mode TxMode
+}
+
+// TxManager runs transactions over in-memory storage. Read-write transactions
+// are exclusive and work on live data, their writes are undone in reverse order
+// if the transaction fails, so every isolation level behaves as serializable
+type TxManager struct {
+	mu   sync.RWMutex
+	data *storage
+}
+
+type txFn = func(ctxTx context.Context) error
+
+func NewTxManager() *TxManager {
+	return &TxManager{data: newStorage()}
+}
+
+func (m *TxManager) RunSerializable(ctx context.Context, mode TxMode, fn txFn) error {
+	return m.run(ctx, mode, fn)
+}
+
+func (m *TxManager) RunReadCommitted(ctx context.Context, mode TxMode, fn txFn) error {
+	return m.run(ctx, mode, fn)
+}
+
+func (m *TxManager) run(ctx context.Context, mode TxMode, fn txFn) (err error) {
+	if state, ok := ctx.Value(txManagerKey{}).(*txState); ok {
+		if mode == TxModeReadWrite && state.mode == TxModeReadOnly {
+			return ErrReadOnlyTx
+		}
+
+		return fn(ctx)
+	}
+
+	ctxTx := context.WithValue(ctx, txManagerKey{}, &txState{mode: mode})
+
+	if mode == TxModeReadOnly {
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		return fn(ctxTx)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	committed := false
+
+	defer func() {
+		if !committed {
+			m.data.rollback()
+		}
+	}()
+
+	if err := fn(ctxTx); err != nil {
+		return err
+	}
+
+	m.data.commit()
+	committed = true
+	return nil
+}
+
+// storage returns data for the transaction bound to ctx
+func (m *TxManager) storage(ctx context.Context, mode TxMode) (*storage, error) {
+	state, ok := ctx.Value(txManagerKey{}).(*txState)
+	if !ok {
+		return nil, ErrNoTx
+	}
+
+	if mode == TxModeReadWrite && state.mode == TxModeReadOnly {
+		return nil, ErrReadOnlyTx
+	}
+
+	return m.data, nil
+}
@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Na322Pr/route256/internal/dto"
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+func (r *PgOrderRepository) AddAuditLog(ctx context.Context, entry dto.AuditLogDTO) error {
+	const (
+		op = "PgOrderRepository.AddAuditLog"
+
+		sqlQuery = `insert into audit_log(operator_id, method, order_ids, before, after, client_ip, created_at)
+		values ($1, $2, $3, $4, $5, $6, $7)`
+	)
+
+	_, err := r.txManager.GetQueryEngine(ctx).Exec(ctx, sqlQuery,
+		entry.OperatorID,
+		entry.Method,
+		entry.OrderIDs,
+		entry.Before,
+		entry.After,
+		entry.ClientIP,
+		entry.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// QueryAuditLog returns matching entries newest first
+func (r *PgOrderRepository) QueryAuditLog(ctx context.Context, filter dto.AuditLogFilterDTO) (*dto.ListAuditLogDTO, error) {
+	const op = "PgOrderRepository.QueryAuditLog"
+
+	var (
+		conds  []string
+		params []any
+	)
+
+	addCond := func(cond string, param any) {
+		params = append(params, param)
+		conds = append(conds, strings.ReplaceAll(cond, "$?", "$"+strconv.Itoa(len(params))))
+	}
+
+	if filter.OperatorID != "" {
+		addCond("operator_id = $?", filter.OperatorID)
+	}
+
+	if filter.Method != "" {
+		addCond("method = $?", filter.Method)
+	}
+
+	if filter.OrderID != 0 {
+		addCond("order_ids @> array[$?::bigint]", filter.OrderID)
+	}
+
+	if !filter.From.IsZero() {
+		addCond("created_at >= $?", filter.From)
+	}
+
+	if !filter.To.IsZero() {
+		addCond("created_at < $?", filter.To)
+	}
+
+	query := "select id, operator_id, method, order_ids, before, after, client_ip, created_at from audit_log "
+	if len(conds) > 0 {
+		query += "where " + strings.Join(conds, " and ") + " "
+	}
+	query += "order by id desc "
+
+	if filter.Limit > 0 {
+		params = append(params, filter.Limit)
+		query += "limit $" + strconv.Itoa(len(params)) + " "
+	}
+
+	if filter.Offset > 0 {
+		params = append(params, filter.Offset)
+		query += "offset $" + strconv.Itoa(len(params))
+	}
+
+	entries := make([]dto.AuditLogDTO, 0, filter.Limit)
+
+	err := pgxscan.Select(ctx, r.txManager.GetQueryEngine(ctx), &entries, query, params...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &dto.ListAuditLogDTO{Entries: entries}, nil
+}
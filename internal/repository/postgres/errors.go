@@ -5,4 +5,9 @@ import "errors"
 var (
 	ErrAlreadyExist  = errors.New("order already exist")
 	ErrOrderNotFound = errors.New("order not found")
+
+	ErrIdempotencyKeyNotFound = errors.New("idempotency key not found")
+
+	ErrReadOnlyTx        = errors.New("read-write call inside read-only transaction")
+	ErrTxRetriesExceeded = errors.New("transaction retries exceeded")
 )
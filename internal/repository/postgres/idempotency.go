@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Na322Pr/route256/internal/dto"
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+type IdempotencyStore struct {
+	txManager TransactionManager
+}
+
+func NewIdempotencyStore(txManager TransactionManager) *IdempotencyStore {
+	return &IdempotencyStore{txManager: txManager}
+}
+
+// Reserve takes the key over if it is free or expired, otherwise returns the stored record
+func (s *IdempotencyStore) Reserve(ctx context.Context, record dto.IdempotencyRecordDTO) (*dto.IdempotencyRecordDTO, error) {
+	const (
+		op = "IdempotencyStore.Reserve"
+
+		sqlUpsertQuery = `insert into idempotency_keys(idempotency_key, request_hash, response, created_at, expires_at)
+		values ($1, $2, null, $3, $4)
+		on conflict (idempotency_key) do update
+		set request_hash = excluded.request_hash, response = null,
+			created_at = excluded.created_at, expires_at = excluded.expires_at
+		where idempotency_keys.expires_at <= excluded.created_at`
+
+		sqlSelectQuery = `select * from idempotency_keys where idempotency_key = $1`
+	)
+
+	var existing *dto.IdempotencyRecordDTO
+
+	err := s.txManager.RunReadCommitted(ctx, TxModeReadWrite, func(ctxTx context.Context) error {
+		tx := s.txManager.GetQueryEngine(ctxTx)
+
+		tag, err := tx.Exec(ctxTx, sqlUpsertQuery, record.Key, record.RequestHash, record.CreatedAt, record.ExpiresAt)
+		if err != nil {
+			return err
+		}
+
+		if tag.RowsAffected() != 0 {
+			return nil
+		}
+
+		var stored dto.IdempotencyRecordDTO
+		if err := pgxscan.Get(ctxTx, tx, &stored, sqlSelectQuery, record.Key); err != nil {
+			return err
+		}
+
+		existing = &stored
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return existing, nil
+}
+
+func (s *IdempotencyStore) Complete(ctx context.Context, key string, response []byte, expiresAt time.Time) error {
+	const (
+		op = "IdempotencyStore.Complete"
+
+		sqlQuery = `update idempotency_keys set response = $2, expires_at = $3 where idempotency_key = $1`
+	)
+
+	tag, err := s.txManager.GetQueryEngine(ctx).Exec(ctx, sqlQuery, key, response, expiresAt)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, ErrIdempotencyKeyNotFound)
+	}
+
+	return nil
+}
+
+func (s *IdempotencyStore) Release(ctx context.Context, key string) error {
+	const (
+		op = "IdempotencyStore.Release"
+
+		sqlQuery = `delete from idempotency_keys where idempotency_key = $1`
+	)
+
+	if _, err := s.txManager.GetQueryEngine(ctx).Exec(ctx, sqlQuery, key); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *IdempotencyStore) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	const (
+		op = "IdempotencyStore.DeleteExpired"
+
+		sqlQuery = `delete from idempotency_keys where expires_at <= $1`
+	)
+
+	tag, err := s.txManager.GetQueryEngine(ctx).Exec(ctx, sqlQuery, now)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tag.RowsAffected(), nil
+}
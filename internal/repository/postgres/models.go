@@ -13,8 +13,14 @@ type QueryEngine interface {
 	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
 }
 
+type Database interface {
+	QueryEngine
+	BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error)
+}
+
 type TransactionManager interface {
 	GetQueryEngine(ctx context.Context) QueryEngine
-	RunReadCommitted(ctx context.Context, fn func(ctxTx context.Context) error) error
-	RunSerializable(ctx context.Context, fn func(ctxTx context.Context) error) error
+	RunReadCommitted(ctx context.Context, mode TxMode, fn func(ctxTx context.Context) error) error
+	RunSerializable(ctx context.Context, mode TxMode, fn func(ctxTx context.Context) error) error
+	RunReplicaRead(ctx context.Context, fn func(ctxTx context.Context) error) error
 }
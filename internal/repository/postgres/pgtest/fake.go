@@ -0,0 +1,66 @@
+// Package pgtest holds in-memory fakes of postgres connection used by
+// transaction and repository tests, queries are recorded and not executed
+package pgtest
+
+import (
+	"context"
+
+	"github.com/Na322Pr/route256/internal/repository/postgres"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// FakeTx records queries executed inside transaction, every query affects one row
+type FakeTx struct {
+	pgx.Tx
+
+	Execs      []string
+	Committed  bool
+	RolledBack bool
+}
+
+func (tx *FakeTx) Exec(_ context.Context, sql string, _ ...interface{}) (pgconn.CommandTag, error) {
+	tx.Execs = append(tx.Execs, sql)
+	return pgconn.NewCommandTag("UPDATE 1"), nil
+}
+
+func (tx *FakeTx) Commit(context.Context) error {
+	tx.Committed = true
+	return nil
+}
+
+func (tx *FakeTx) Rollback(context.Context) error {
+	if tx.Committed {
+		return pgx.ErrTxClosed
+	}
+
+	tx.RolledBack = true
+	return nil
+}
+
+// FakeDB records started transactions with their options and queries executed outside of them,
+// BeginTx fails with BeginErr when it is set
+type FakeDB struct {
+	postgres.QueryEngine
+
+	Txs      []*FakeTx
+	Opts     []pgx.TxOptions
+	Execs    []string
+	BeginErr error
+}
+
+func (db *FakeDB) BeginTx(_ context.Context, opts pgx.TxOptions) (pgx.Tx, error) {
+	if db.BeginErr != nil {
+		return nil, db.BeginErr
+	}
+
+	tx := &FakeTx{}
+	db.Txs = append(db.Txs, tx)
+	db.Opts = append(db.Opts, opts)
+	return tx, nil
+}
+
+func (db *FakeDB) Exec(_ context.Context, sql string, _ ...interface{}) (pgconn.CommandTag, error) {
+	db.Execs = append(db.Execs, sql)
+	return pgconn.NewCommandTag("UPDATE 1"), nil
+}
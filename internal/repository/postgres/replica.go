@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"context"
+	"log"
+	"sync/atomic"
+	"time"
+)
+
+// replicaLagQuery reports how far the standby is behind the primary in seconds.
+// Replay timestamp alone grows while the primary is idle, so fully replayed wal is treated as no lag
+const replicaLagQuery = `
+	select case
+		when pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() then 0
+		else coalesce(extract(epoch from now() - pg_last_xact_replay_timestamp()), 0)
+	end`
+
+type replica struct {
+	db      Database
+	healthy atomic.Bool
+}
+
+// ReplicaSet balances read-only transactions between healthy replicas
+type ReplicaSet struct {
+	replicas []*replica
+	next     atomic.Uint64
+	maxLag   time.Duration
+}
+
+// NewReplicaSet treats replicas as healthy until the first failed check,
+// replicas lagging behind the primary more than maxLag are skipped
+func NewReplicaSet(maxLag time.Duration, dbs ...Database) *ReplicaSet {
+	s := &ReplicaSet{
+		replicas: make([]*replica, 0, len(dbs)),
+		maxLag:   maxLag,
+	}
+
+	for _, db := range dbs {
+		r := &replica{db: db}
+		r.healthy.Store(true)
+		s.replicas = append(s.replicas, r)
+	}
+
+	return s
+}
+
+// pick returns next healthy replica in round robin order or nil if there is none
+func (s *ReplicaSet) pick() *replica {
+	n := len(s.replicas)
+	if n == 0 {
+		return nil
+	}
+
+	start := int(s.next.Add(1) % uint64(n))
+	for i := 0; i < n; i++ {
+		r := s.replicas[(start+i)%n]
+		if r.healthy.Load() {
+			return r
+		}
+	}
+
+	return nil
+}
+
+// CheckHealth pings every replica and marks unreachable or lagging ones as unhealthy
+func (s *ReplicaSet) CheckHealth(ctx context.Context) {
+	for i, r := range s.replicas {
+		var lagSeconds float64
+		err := r.db.QueryRow(ctx, replicaLagQuery).Scan(&lagSeconds)
+		if err != nil {
+			if r.healthy.Swap(false) {
+				log.Printf("[ReplicaSet.CheckHealth] replica %d is unavailable: %s", i, err.Error())
+			}
+			continue
+		}
+
+		lag := time.Duration(lagSeconds * float64(time.Second))
+		if lag > s.maxLag {
+			if r.healthy.Swap(false) {
+				log.Printf("[ReplicaSet.CheckHealth] replica %d lags behind primary by %s", i, lag)
+			}
+			continue
+		}
+
+		if !r.healthy.Swap(true) {
+			log.Printf("[ReplicaSet.CheckHealth] replica %d is back", i)
+		}
+	}
+}
+
+// RunHealthCheck checks replicas every interval until ctx is done
+func (s *ReplicaSet) RunHealthCheck(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.CheckHealth(ctx)
+		}
+	}
+}
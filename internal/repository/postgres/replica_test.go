@@ -0,0 +1,210 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/Na322Pr/route256/internal/dto"
+	"github.com/Na322Pr/route256/internal/repository/postgres"
+	"github.com/Na322Pr/route256/internal/repository/postgres/pgtest"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type lagRow struct {
+	lag float64
+	err error
+}
+
+func (r lagRow) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	*dest[0].(*float64) = r.lag
+	return nil
+}
+
+type fakeReplica struct {
+	*pgtest.FakeDB
+
+	lag    float64
+	lagErr error
+}
+
+func (r *fakeReplica) QueryRow(context.Context, string, ...interface{}) pgx.Row {
+	return lagRow{lag: r.lag, err: r.lagErr}
+}
+
+func newReplicaTxManager(primary *pgtest.FakeDB, readAfterWrite time.Duration, replicas ...postgres.Database) *postgres.TxManager {
+	return postgres.NewTxManager(primary,
+		postgres.WithTxRetry(3, time.Microsecond, time.Millisecond),
+		postgres.WithReplicas(postgres.NewReplicaSet(time.Second, replicas...), readAfterWrite),
+	)
+}
+
+func runRead(t *testing.T, txManager *postgres.TxManager) {
+	t.Helper()
+
+	err := txManager.RunReplicaRead(context.Background(), func(context.Context) error {
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestTxManager_RunReplicaRead(t *testing.T) {
+	t.Run("RoundRobinOverReplicas", func(t *testing.T) {
+		primary, first, second := &pgtest.FakeDB{}, &pgtest.FakeDB{}, &pgtest.FakeDB{}
+		txManager := newReplicaTxManager(primary, time.Minute, first, second)
+
+		for i := 0; i < 4; i++ {
+			runRead(t, txManager)
+		}
+
+		assert.Empty(t, primary.Txs)
+		assert.Len(t, first.Txs, 2)
+		assert.Len(t, second.Txs, 2)
+		assert.Equal(t, pgx.TxOptions{IsoLevel: pgx.ReadCommitted, AccessMode: pgx.ReadOnly}, first.Opts[0])
+		assert.True(t, first.Txs[0].Committed)
+	})
+
+	t.Run("NoReplicas", func(t *testing.T) {
+		primary := &pgtest.FakeDB{}
+		txManager := newTestTxManager(primary)
+
+		runRead(t, txManager)
+
+		require.Len(t, primary.Txs, 1)
+		assert.Equal(t, pgx.TxOptions{IsoLevel: pgx.ReadCommitted, AccessMode: pgx.ReadOnly}, primary.Opts[0])
+	})
+
+	t.Run("FallbackWhenReplicaUnavailable", func(t *testing.T) {
+		primary := &pgtest.FakeDB{}
+		broken := &pgtest.FakeDB{BeginErr: errors.New("connection refused")}
+		txManager := newReplicaTxManager(primary, time.Minute, broken)
+
+		runRead(t, txManager)
+		runRead(t, txManager)
+
+		assert.Len(t, primary.Txs, 2)
+		assert.Empty(t, broken.Txs)
+	})
+
+	t.Run("FallbackWhenReplicaLostDuringRead", func(t *testing.T) {
+		primary, replica := &pgtest.FakeDB{}, &pgtest.FakeDB{}
+		txManager := newReplicaTxManager(primary, time.Minute, replica)
+
+		calls := 0
+		err := txManager.RunReplicaRead(context.Background(), func(context.Context) error {
+			if calls++; calls == 1 {
+				return &net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")}
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, calls)
+
+		require.Len(t, replica.Txs, 1)
+		assert.True(t, replica.Txs[0].RolledBack)
+		require.Len(t, primary.Txs, 1)
+		assert.True(t, primary.Txs[0].Committed)
+
+		// replica stays out until health check brings it back
+		runRead(t, txManager)
+		assert.Len(t, replica.Txs, 1)
+		assert.Len(t, primary.Txs, 2)
+	})
+
+	t.Run("QueryErrorOnReplica", func(t *testing.T) {
+		primary, replica := &pgtest.FakeDB{}, &pgtest.FakeDB{}
+		txManager := newReplicaTxManager(primary, time.Minute, replica)
+
+		calls := 0
+		err := txManager.RunReplicaRead(context.Background(), func(context.Context) error {
+			calls++
+			return &pgconn.PgError{Code: "42P01"}
+		})
+		assert.Error(t, err)
+		assert.Equal(t, 1, calls)
+		assert.Empty(t, primary.Txs)
+
+		runRead(t, txManager)
+		assert.Len(t, replica.Txs, 2)
+	})
+
+	t.Run("PrimaryAfterWrite", func(t *testing.T) {
+		primary, replica := &pgtest.FakeDB{}, &pgtest.FakeDB{}
+		txManager := newReplicaTxManager(primary, time.Minute, replica)
+		repo := postgres.NewPgOrderRepository(txManager)
+
+		runRead(t, txManager)
+
+		err := txManager.RunSerializable(context.Background(), postgres.TxModeReadWrite, func(ctxTx context.Context) error {
+			return repo.UpdateOrder(ctxTx, dto.OrderDTO{ID: 1})
+		})
+		require.NoError(t, err)
+
+		runRead(t, txManager)
+
+		assert.Len(t, replica.Txs, 1)
+		assert.Len(t, primary.Txs, 2)
+	})
+
+	t.Run("ReplicaAfterReadAfterWriteWindow", func(t *testing.T) {
+		primary, replica := &pgtest.FakeDB{}, &pgtest.FakeDB{}
+		txManager := newReplicaTxManager(primary, time.Nanosecond, replica)
+
+		err := txManager.RunSerializable(context.Background(), postgres.TxModeReadWrite, func(context.Context) error {
+			return nil
+		})
+		require.NoError(t, err)
+
+		time.Sleep(time.Millisecond)
+		runRead(t, txManager)
+
+		assert.Len(t, primary.Txs, 1)
+		assert.Len(t, replica.Txs, 1)
+	})
+
+	t.Run("NestedInTransaction", func(t *testing.T) {
+		primary, replica := &pgtest.FakeDB{}, &pgtest.FakeDB{}
+		txManager := newReplicaTxManager(primary, time.Minute, replica)
+
+		err := txManager.RunSerializable(context.Background(), postgres.TxModeReadWrite, func(ctxTx context.Context) error {
+			return txManager.RunReplicaRead(ctxTx, func(context.Context) error {
+				return nil
+			})
+		})
+		require.NoError(t, err)
+
+		assert.Len(t, primary.Txs, 1)
+		assert.Empty(t, replica.Txs)
+	})
+}
+
+func TestReplicaSet_CheckHealth(t *testing.T) {
+	primary := &pgtest.FakeDB{}
+	replica := &fakeReplica{FakeDB: &pgtest.FakeDB{}}
+	replicas := postgres.NewReplicaSet(time.Second, replica)
+	txManager := postgres.NewTxManager(primary, postgres.WithReplicas(replicas, time.Minute))
+
+	replica.lag = 5
+	replicas.CheckHealth(context.Background())
+	runRead(t, txManager)
+	assert.Len(t, primary.Txs, 1)
+
+	replica.lag = 0.5
+	replicas.CheckHealth(context.Background())
+	runRead(t, txManager)
+	assert.Len(t, replica.Txs, 1)
+
+	replica.lagErr = errors.New("connection refused")
+	replicas.CheckHealth(context.Background())
+	runRead(t, txManager)
+	assert.Len(t, primary.Txs, 2)
+}
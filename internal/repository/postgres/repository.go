@@ -4,12 +4,24 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/Na322Pr/route256/internal/domain"
 	"github.com/Na322Pr/route256/internal/dto"
 	"github.com/georgysavva/scany/v2/pgxscan"
 )
 
+const (
+	// archiveColumns are orders_archive columns matching OrderDTO fields
+	archiveColumns = `order_id, client_id, store_until, status, cost, weight, packages, pick_up_time,
+	refund_reason, refund_comment, inspection_condition, inspection_outcome, version`
+
+	// orderFields reads orders row with packages collected from order_packages in packing order
+	orderFields = `order_id, client_id, store_until, status, cost, weight,
+	array(select p.package_type from order_packages p where p.order_id = orders.order_id order by p.position)::varchar[] as packages,
+	pick_up_time, refund_reason, refund_comment, inspection_condition, inspection_outcome, version`
+)
+
 type PgOrderRepository struct {
 	txManager TransactionManager
 }
@@ -22,25 +34,45 @@ func (r *PgOrderRepository) AddOrder(ctx context.Context, orderDTO dto.OrderDTO)
 	const (
 		op = "PgOrderRepository.AddOrder"
 
-		sqlQuery = `insert into orders(order_id, client_id, store_until, status, cost, weight, packages)
-		values ($1, $2, $3, $4, $5, $6, $7::varchar[])`
+		// archived order id is taken for good, orders_archive is not covered by orders primary key
+		sqlQuery = `insert into orders(order_id, client_id, store_until, status, cost, weight)
+		select $1, $2, $3, $4, $5, $6
+		where not exists (select 1 from orders_archive where order_id = $1)`
+
+		// "unknown" is a placeholder for no package, it is not stored
+		sqlPackagesQuery = `insert into order_packages(order_id, position, package_type)
+		select $1, p.position, p.package_type
+		from unnest($2::varchar[]) with ordinality as p(package_type, position)
+		where p.package_type <> 'unknown'`
 	)
 
 	tx := r.txManager.GetQueryEngine(ctx)
 
-	_, err := tx.Exec(ctx, sqlQuery,
+	tag, err := tx.Exec(ctx, sqlQuery,
 		orderDTO.ID,
 		orderDTO.ClientID,
 		orderDTO.StoreUntil,
 		orderDTO.Status,
 		orderDTO.Cost,
 		orderDTO.Weight,
-		orderDTO.Packages,
 	)
+	if isUniqueViolation(err) || err == nil && tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, ErrAlreadyExist)
+	}
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	if len(orderDTO.Packages) != 0 {
+		if _, err := tx.Exec(ctx, sqlPackagesQuery, orderDTO.ID, orderDTO.Packages); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := r.addOrderItems(ctx, orderDTO); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
 	return nil
 }
 
@@ -49,17 +81,36 @@ func (r *PgOrderRepository) UpdateOrder(ctx context.Context, orderDTO dto.OrderD
 		op = "PgOrderRepository.UpdateOrder"
 
 		sqlQuery = `update orders
-        set status = $2, pick_up_time = $3
-        where order_id = $1`
+        set status = $2, pick_up_time = $3,
+            refund_reason = $4, refund_comment = $5, inspection_condition = $6, inspection_outcome = $7,
+            version = version + 1
+        where order_id = $1 and version = $8`
 	)
 
 	tx := r.txManager.GetQueryEngine(ctx)
 
-	_, err := tx.Exec(ctx, sqlQuery, orderDTO.ID, orderDTO.Status, orderDTO.PickUpTime)
+	tag, err := tx.Exec(ctx, sqlQuery,
+		orderDTO.ID,
+		orderDTO.Status,
+		orderDTO.PickUpTime,
+		orderDTO.RefundReason,
+		orderDTO.RefundComment,
+		orderDTO.InspectionCondition,
+		orderDTO.InspectionOutcome,
+		orderDTO.Version,
+	)
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, domain.ErrConcurrentModification)
+	}
+
+	if err := r.updateOrderItems(ctx, orderDTO); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
 	return nil
 }
 
@@ -67,7 +118,7 @@ func (r *PgOrderRepository) GetOrderByID(ctx context.Context, id int64) (*dto.Or
 	const (
 		op = "PgOrderRepository.GetOrderByID"
 
-		sqlQuery = `select * from orders where order_id = $1`
+		sqlQuery = `select ` + orderFields + ` from orders where order_id = $1`
 	)
 
 	tx := r.txManager.GetQueryEngine(ctx)
@@ -75,19 +126,36 @@ func (r *PgOrderRepository) GetOrderByID(ctx context.Context, id int64) (*dto.Or
 	orders := make([]dto.OrderDTO, 1)
 
 	err := pgxscan.Select(ctx, tx, &orders, sqlQuery, id)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(orders) != 0 {
+		if err := r.attachOrderItems(ctx, orders); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		return &orders[0], nil
+	}
+
+	// archived order comes with its archived items
+	orders, err = r.getArchivedOrder(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
 
 	if len(orders) == 0 {
 		return nil, fmt.Errorf("%s: %w", op, ErrOrderNotFound)
 	}
 
-	return &orders[0], err
+	return &orders[0], nil
 }
 
 func (r *PgOrderRepository) GetOrdersByIDs(ctx context.Context, ids []int64) (*dto.ListOrdersDTO, error) {
 	const (
 		op = "PgOrderRepository.GetOrdersByIDs"
 
-		sqlQuery = `select * from orders where order_id = any($1)`
+		sqlQuery = `select ` + orderFields + ` from orders where order_id = any($1)`
 	)
 
 	orders := make([]dto.OrderDTO, 1)
@@ -98,6 +166,10 @@ func (r *PgOrderRepository) GetOrdersByIDs(ctx context.Context, ids []int64) (*d
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
+	if err := r.attachOrderItems(ctx, orders); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	return &dto.ListOrdersDTO{Orders: orders}, nil
 }
 
@@ -105,7 +177,7 @@ func (r *PgOrderRepository) GetClientOrdersList(ctx context.Context, clientID in
 	const (
 		op = "PgOrderRepository.GetClientOrdersList"
 
-		sqlQuery = `select * from orders where client_id = $1 and status = $2`
+		sqlQuery = `select ` + orderFields + ` from orders where client_id = $1 and status = $2`
 	)
 
 	orders := make([]dto.OrderDTO, 1)
@@ -116,16 +188,25 @@ func (r *PgOrderRepository) GetClientOrdersList(ctx context.Context, clientID in
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
+	if err := r.attachOrderItems(ctx, orders); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	return &dto.ListOrdersDTO{Orders: orders}, err
 }
 
-func (r *PgOrderRepository) GetRefundsList(ctx context.Context, limit, offset int) (*dto.ListOrdersDTO, error) {
-	const op = "PgOrderRepository.GetRefundsList"
+func (r *PgOrderRepository) GetRefundsList(ctx context.Context, statuses []string, limit, offset int) (*dto.ListOrdersDTO, error) {
+	return r.ListOrdersByStatus(ctx, statuses, limit, offset)
+}
+
+// ListOrdersByStatus lists live orders in statuses ordered by id, zero limit and offset do not bound the list
+func (r *PgOrderRepository) ListOrdersByStatus(ctx context.Context, statuses []string, limit, offset int) (*dto.ListOrdersDTO, error) {
+	const op = "PgOrderRepository.ListOrdersByStatus"
 
 	orders := make([]dto.OrderDTO, 0, limit)
 
-	query := "select * from orders where status = $1 order by order_id "
-	params := []any{domain.OrderStatusMap[domain.OrderStatusRefunded]}
+	query := "select " + orderFields + " from orders where status = any($1) order by order_id "
+	params := []any{statuses}
 
 	if limit > 0 {
 		query += "limit $2 "
@@ -143,5 +224,222 @@ func (r *PgOrderRepository) GetRefundsList(ctx context.Context, limit, offset in
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
+	if err := r.attachOrderItems(ctx, orders); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	return &dto.ListOrdersDTO{Orders: orders}, err
 }
+
+func (r *PgOrderRepository) AddHandover(ctx context.Context, handoverDTO dto.HandoverDTO) (int64, error) {
+	const (
+		op = "PgOrderRepository.AddHandover"
+
+		sqlHandoverQuery = `insert into handovers(courier, handed_over_at)
+		values ($1, $2)
+		returning handover_id`
+
+		sqlHandoverOrdersQuery = `insert into handover_orders(handover_id, order_id)
+		select $1, unnest($2::bigint[])`
+	)
+
+	tx := r.txManager.GetQueryEngine(ctx)
+
+	var handoverID int64
+	err := tx.QueryRow(ctx, sqlHandoverQuery, handoverDTO.Courier, handoverDTO.HandedOverAt).Scan(&handoverID)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	orderIDs := make([]int64, 0, len(handoverDTO.Orders))
+	for _, order := range handoverDTO.Orders {
+		orderIDs = append(orderIDs, order.ID)
+	}
+
+	_, err = tx.Exec(ctx, sqlHandoverOrdersQuery, handoverID, orderIDs)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return handoverID, nil
+}
+
+// ArchiveOrders moves a batch of matching orders with their items into archive tables
+// and returns ids of moved orders. Orders holding refused items not yet returned to courier stay
+func (r *PgOrderRepository) ArchiveOrders(ctx context.Context, archiveDTO dto.ArchiveOrdersDTO) ([]int64, error) {
+	const (
+		op = "PgOrderRepository.ArchiveOrders"
+
+		sqlSelectQuery = `select order_id from orders
+		where status = any($1) and coalesce(pick_up_time, store_until) < $2
+		and not exists (select 1 from order_items i where i.order_id = orders.order_id and i.status = 'refused')
+		order by order_id
+		limit $3
+		for update skip locked`
+
+		sqlArchiveItemsQuery = `insert into order_items_archive(order_id, sku, name, quantity, unit_price, status)
+		select order_id, sku, name, quantity, unit_price, status from order_items where order_id = any($1)`
+
+		sqlDeleteItemsQuery = `delete from order_items where order_id = any($1)`
+
+		sqlArchiveQuery = `insert into orders_archive(` + archiveColumns + `, archived_at)
+		select ` + orderFields + `, $2 from orders where order_id = any($1)`
+
+		sqlDeleteQuery = `delete from orders where order_id = any($1)`
+	)
+
+	tx := r.txManager.GetQueryEngine(ctx)
+
+	var ids []int64
+	err := pgxscan.Select(ctx, tx, &ids, sqlSelectQuery, archiveDTO.Statuses, archiveDTO.Before, archiveDTO.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	if err := r.ensureArchivePartition(ctx, archiveDTO.ArchivedAt); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, query := range []string{sqlArchiveItemsQuery, sqlDeleteItemsQuery} {
+		if _, err := tx.Exec(ctx, query, ids); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if _, err := tx.Exec(ctx, sqlArchiveQuery, ids, archiveDTO.ArchivedAt); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err := tx.Exec(ctx, sqlDeleteQuery, ids); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return ids, nil
+}
+
+// ensureArchivePartition creates orders_archive partition for the month of archivedAt
+func (r *PgOrderRepository) ensureArchivePartition(ctx context.Context, archivedAt time.Time) error {
+	at := archivedAt.UTC()
+	from := time.Date(at.Year(), at.Month(), 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	sqlQuery := fmt.Sprintf(
+		`create table if not exists orders_archive_%s partition of orders_archive for values from ('%s') to ('%s')`,
+		from.Format("2006_01"),
+		from.Format(time.RFC3339),
+		to.Format(time.RFC3339),
+	)
+
+	_, err := r.txManager.GetQueryEngine(ctx).Exec(ctx, sqlQuery)
+	return err
+}
+
+func (r *PgOrderRepository) getArchivedOrder(ctx context.Context, id int64) ([]dto.OrderDTO, error) {
+	const sqlQuery = `select ` + archiveColumns + ` from orders_archive
+	where order_id = $1
+	order by archived_at desc
+	limit 1`
+
+	var orders []dto.OrderDTO
+
+	tx := r.txManager.GetQueryEngine(ctx)
+	if err := pgxscan.Select(ctx, tx, &orders, sqlQuery, id); err != nil {
+		return nil, err
+	}
+
+	for i := range orders {
+		orders[i].Archived = true
+	}
+
+	err := r.attachItems(ctx, orders, `select * from order_items_archive where order_id = any($1) order by order_id, sku`)
+	return orders, err
+}
+
+func (r *PgOrderRepository) addOrderItems(ctx context.Context, orderDTO dto.OrderDTO) error {
+	const sqlQuery = `insert into order_items(order_id, sku, name, quantity, unit_price, status)
+		select $1, unnest($2::varchar[]), unnest($3::varchar[]), unnest($4::integer[]), unnest($5::integer[]), unnest($6::varchar[])`
+
+	if len(orderDTO.Items) == 0 {
+		return nil
+	}
+
+	var (
+		skus       = make([]string, 0, len(orderDTO.Items))
+		names      = make([]string, 0, len(orderDTO.Items))
+		quantities = make([]int, 0, len(orderDTO.Items))
+		unitPrices = make([]int, 0, len(orderDTO.Items))
+		statuses   = make([]string, 0, len(orderDTO.Items))
+	)
+
+	for _, item := range orderDTO.Items {
+		skus = append(skus, item.SKU)
+		names = append(names, item.Name)
+		quantities = append(quantities, item.Quantity)
+		unitPrices = append(unitPrices, item.UnitPrice)
+		statuses = append(statuses, item.Status)
+	}
+
+	tx := r.txManager.GetQueryEngine(ctx)
+	_, err := tx.Exec(ctx, sqlQuery, orderDTO.ID, skus, names, quantities, unitPrices, statuses)
+	return err
+}
+
+func (r *PgOrderRepository) updateOrderItems(ctx context.Context, orderDTO dto.OrderDTO) error {
+	const sqlQuery = `update order_items
+		set status = u.status
+		from unnest($2::varchar[], $3::varchar[]) as u(sku, status)
+		where order_items.order_id = $1 and order_items.sku = u.sku`
+
+	if len(orderDTO.Items) == 0 {
+		return nil
+	}
+
+	skus := make([]string, 0, len(orderDTO.Items))
+	statuses := make([]string, 0, len(orderDTO.Items))
+
+	for _, item := range orderDTO.Items {
+		skus = append(skus, item.SKU)
+		statuses = append(statuses, item.Status)
+	}
+
+	tx := r.txManager.GetQueryEngine(ctx)
+	_, err := tx.Exec(ctx, sqlQuery, orderDTO.ID, skus, statuses)
+	return err
+}
+
+func (r *PgOrderRepository) attachOrderItems(ctx context.Context, orders []dto.OrderDTO) error {
+	return r.attachItems(ctx, orders, `select * from order_items where order_id = any($1) order by order_id, sku`)
+}
+
+func (r *PgOrderRepository) attachItems(ctx context.Context, orders []dto.OrderDTO, sqlQuery string) error {
+	if len(orders) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(orders))
+	for _, order := range orders {
+		ids = append(ids, order.ID)
+	}
+
+	var items []dto.OrderItemDTO
+
+	tx := r.txManager.GetQueryEngine(ctx)
+	if err := pgxscan.Select(ctx, tx, &items, sqlQuery, ids); err != nil {
+		return err
+	}
+
+	itemsByOrder := make(map[int64][]dto.OrderItemDTO, len(orders))
+	for _, item := range items {
+		itemsByOrder[item.OrderID] = append(itemsByOrder[item.OrderID], item)
+	}
+
+	for i := range orders {
+		orders[i].Items = itemsByOrder[orders[i].ID]
+	}
+
+	return nil
+}
@@ -3,68 +3,289 @@ package postgres
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math/rand/v2"
+	"net"
+	"strings"
+	"sync/atomic"
+	"time"
 
 	"github.com/jackc/pgx/v5"
-	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
+const (
+	sqlStateSerializationFailure = "40001"
+	sqlStateDeadlockDetected     = "40P01"
+	sqlStateUniqueViolation      = "23505"
+
+	sqlStateClassConnectionException  = "08"
+	sqlStateClassOperatorIntervention = "57P"
+
+	defaultTxMaxAttempts = 3
+	defaultTxBaseDelay   = 10 * time.Millisecond
+	defaultTxMaxDelay    = 200 * time.Millisecond
+)
+
+// Transaction access mode
+type TxMode int
+
+const (
+	TxModeReadOnly TxMode = iota
+	TxModeReadWrite
+)
+
+var txAccessModes = map[TxMode]pgx.TxAccessMode{
+	TxModeReadOnly:  pgx.ReadOnly,
+	TxModeReadWrite: pgx.ReadWrite,
+}
+
 type txManagerKey struct{}
 
+type txState struct {
+	tx   pgx.Tx
+	mode TxMode
+}
+
 type TxManager struct {
-	pool *pgxpool.Pool
+	db          Database
+	maxAttempts int
+	baseDelay   time.Duration
+	maxDelay    time.Duration
+
+	replicas       *ReplicaSet
+	readAfterWrite time.Duration
+	lastWrite      atomic.Int64
 }
 
 type txFn = func(ctxTx context.Context) error
 
-func NewTxManager(pool *pgxpool.Pool) *TxManager {
-	return &TxManager{pool: pool}
+type TxManagerOption func(*TxManager)
+
+// WithTxRetry sets how many times a transaction is run on serialization failure or deadlock
+// and bounds of the jittered exponential backoff between attempts
+func WithTxRetry(maxAttempts int, baseDelay, maxDelay time.Duration) TxManagerOption {
+	return func(m *TxManager) {
+		m.maxAttempts = maxAttempts
+		m.baseDelay = baseDelay
+		m.maxDelay = maxDelay
+	}
 }
 
-func (m *TxManager) RunSerializable(ctx context.Context, fn txFn) error {
-	opts := pgx.TxOptions{
-		IsoLevel:   pgx.Serializable,
-		AccessMode: pgx.ReadOnly,
+// WithReplicas routes RunReplicaRead to replicas. Reads during readAfterWrite
+// since the last committed write of this process still go to primary so they see that write
+func WithReplicas(replicas *ReplicaSet, readAfterWrite time.Duration) TxManagerOption {
+	return func(m *TxManager) {
+		m.replicas = replicas
+		m.readAfterWrite = readAfterWrite
+	}
+}
+
+func NewTxManager(db Database, opts ...TxManagerOption) *TxManager {
+	m := &TxManager{
+		db:          db,
+		maxAttempts: defaultTxMaxAttempts,
+		baseDelay:   defaultTxBaseDelay,
+		maxDelay:    defaultTxMaxDelay,
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return m
+}
+
+func (m *TxManager) RunSerializable(ctx context.Context, mode TxMode, fn txFn) error {
+	return m.run(ctx, pgx.Serializable, mode, fn)
+}
+
+func (m *TxManager) RunReadCommitted(ctx context.Context, mode TxMode, fn txFn) error {
+	return m.run(ctx, pgx.ReadCommitted, mode, fn)
+}
+
+// RunReplicaRead runs read-only transaction on a healthy replica,
+// it falls back to primary when there is none or replicas may not have caught up with a recent write.
+// Replica lost while fn runs is marked unhealthy and fn is run again on primary.
+//
+// The last write time is kept in process memory, so only writes of this process are seen
+// on primary right away. Write made through another instance of the service
+// may be missing from replica for up to the replica lag checked by ReplicaSet
+func (m *TxManager) RunReplicaRead(ctx context.Context, fn txFn) error {
+	if _, ok := ctx.Value(txManagerKey{}).(*txState); ok {
+		return fn(ctx)
+	}
+
+	if r := m.pickReplica(); r != nil {
+		opts := pgx.TxOptions{
+			IsoLevel:   pgx.ReadCommitted,
+			AccessMode: pgx.ReadOnly,
+		}
+
+		tx, err := r.db.BeginTx(ctx, opts)
+		if err == nil {
+			err = m.runTx(ctx, tx, TxModeReadOnly, fn)
+			if err == nil || !isConnectionError(err) {
+				return err
+			}
+		}
+
+		if ctx.Err() != nil {
+			return err
+		}
+
+		r.healthy.Store(false)
+		log.Printf("[TxManager.RunReplicaRead] replica is unavailable, fallback to primary: %s", err.Error())
+	}
+
+	return m.run(ctx, pgx.ReadCommitted, TxModeReadOnly, fn)
+}
+
+func (m *TxManager) pickReplica() *replica {
+	if m.replicas == nil {
+		return nil
+	}
+
+	lastWrite := m.lastWrite.Load()
+	if lastWrite != 0 && time.Since(time.Unix(0, lastWrite)) < m.readAfterWrite {
+		return nil
 	}
 
-	return m.beginFunc(ctx, opts, fn)
+	return m.replicas.pick()
 }
 
-func (m *TxManager) RunReadCommitted(ctx context.Context, fn txFn) error {
+// run reuses a transaction already bound to ctx, otherwise it begins a new one
+// and retries it while postgres reports a serialization failure or a deadlock
+func (m *TxManager) run(ctx context.Context, isoLevel pgx.TxIsoLevel, mode TxMode, fn txFn) error {
+	if state, ok := ctx.Value(txManagerKey{}).(*txState); ok {
+		if mode == TxModeReadWrite && state.mode == TxModeReadOnly {
+			return ErrReadOnlyTx
+		}
+
+		return fn(ctx)
+	}
+
 	opts := pgx.TxOptions{
-		IsoLevel:   pgx.ReadCommitted,
-		AccessMode: pgx.ReadOnly,
+		IsoLevel:   isoLevel,
+		AccessMode: txAccessModes[mode],
+	}
+
+	var err error
+	for attempt := 0; attempt < m.maxAttempts; attempt++ {
+		if attempt > 0 {
+			if ctxErr := m.backoff(ctx, attempt); ctxErr != nil {
+				return errors.Join(err, ctxErr)
+			}
+		}
+
+		err = m.beginFunc(ctx, opts, mode, fn)
+		if !isRetryable(err) {
+			return err
+		}
 	}
 
-	return m.beginFunc(ctx, opts, fn)
+	return fmt.Errorf("%w: %w", ErrTxRetriesExceeded, err)
 }
 
-func (m *TxManager) beginFunc(ctx context.Context, opts pgx.TxOptions, fn txFn) error {
-	tx, err := m.pool.BeginTx(ctx, opts)
+func (m *TxManager) beginFunc(ctx context.Context, opts pgx.TxOptions, mode TxMode, fn txFn) error {
+	tx, err := m.db.BeginTx(ctx, opts)
 	if err != nil {
 		return err
 	}
 
-	defer func() error {
-		err = errors.Join(err, tx.Rollback(ctx))
-		if err != nil {
-			return err
+	return m.runTx(ctx, tx, mode, fn)
+}
+
+// runTx binds tx to ctx for fn, commits it on success and rolls back otherwise
+func (m *TxManager) runTx(ctx context.Context, tx pgx.Tx, mode TxMode, fn txFn) (err error) {
+	defer func() {
+		if err == nil {
+			return
+		}
+
+		if rbErr := tx.Rollback(ctx); rbErr != nil && !errors.Is(rbErr, pgx.ErrTxClosed) {
+			err = errors.Join(err, rbErr)
 		}
-		return nil
 	}()
 
-	ctx = context.WithValue(ctx, txManagerKey{}, tx)
-	if err := fn(ctx); err != nil {
+	ctxTx := context.WithValue(ctx, txManagerKey{}, &txState{tx: tx, mode: mode})
+	if err := fn(ctxTx); err != nil {
 		return err
 	}
 
-	return tx.Commit(ctx)
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	if mode == TxModeReadWrite {
+		m.lastWrite.Store(time.Now().UnixNano())
+	}
+
+	return nil
+}
+
+func (m *TxManager) backoff(ctx context.Context, attempt int) error {
+	delay := m.baseDelay << (attempt - 1)
+	if delay <= 0 || delay > m.maxDelay {
+		delay = m.maxDelay
+	}
+
+	if delay > 0 {
+		delay = delay/2 + rand.N(delay/2+1)
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func isRetryable(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+
+	return pgErr.Code == sqlStateSerializationFailure || pgErr.Code == sqlStateDeadlockDetected
+}
+
+// isConnectionError tells lost or refused connection from errors of the query itself.
+// Server reports shutdown and connection failures with sqlstate classes 57P and 08
+func isConnectionError(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return strings.HasPrefix(pgErr.Code, sqlStateClassConnectionException) ||
+			strings.HasPrefix(pgErr.Code, sqlStateClassOperatorIntervention)
+	}
+
+	var netErr net.Error
+	var connectErr *pgconn.ConnectError
+
+	return errors.As(err, &netErr) || errors.As(err, &connectErr) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+
+	return pgErr.Code == sqlStateUniqueViolation
 }
 
 func (m *TxManager) GetQueryEngine(ctx context.Context) QueryEngine {
-	v, ok := ctx.Value(txManagerKey{}).(QueryEngine)
-	if ok && v != nil {
-		return v
+	state, ok := ctx.Value(txManagerKey{}).(*txState)
+	if ok && state != nil {
+		return state.tx
 	}
 
-	return m.pool
+	return m.db
 }
@@ -0,0 +1,129 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Na322Pr/route256/internal/dto"
+	"github.com/Na322Pr/route256/internal/repository/postgres"
+	"github.com/Na322Pr/route256/internal/repository/postgres/pgtest"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTxManager(db *pgtest.FakeDB) *postgres.TxManager {
+	return postgres.NewTxManager(db, postgres.WithTxRetry(3, time.Microsecond, time.Millisecond))
+}
+
+func TestTxManager_WritesInsideTransaction(t *testing.T) {
+	db := &pgtest.FakeDB{}
+	txManager := newTestTxManager(db)
+	repo := postgres.NewPgOrderRepository(txManager)
+
+	err := txManager.RunSerializable(context.Background(), postgres.TxModeReadWrite, func(ctxTx context.Context) error {
+		return repo.UpdateOrder(ctxTx, dto.OrderDTO{
+			ID:    1,
+			Items: []dto.OrderItemDTO{{SKU: "phone", Status: "pickedUp"}},
+		})
+	})
+	require.NoError(t, err)
+
+	require.Len(t, db.Txs, 1)
+	assert.Len(t, db.Txs[0].Execs, 2)
+	assert.True(t, db.Txs[0].Committed)
+	assert.Empty(t, db.Execs)
+	assert.Equal(t, pgx.TxOptions{IsoLevel: pgx.Serializable, AccessMode: pgx.ReadWrite}, db.Opts[0])
+}
+
+func TestTxManager_ReadOnlyMode(t *testing.T) {
+	db := &pgtest.FakeDB{}
+	txManager := newTestTxManager(db)
+
+	err := txManager.RunReadCommitted(context.Background(), postgres.TxModeReadOnly, func(ctxTx context.Context) error {
+		return txManager.RunSerializable(ctxTx, postgres.TxModeReadWrite, func(context.Context) error {
+			return nil
+		})
+	})
+	assert.ErrorIs(t, err, postgres.ErrReadOnlyTx)
+
+	require.Len(t, db.Txs, 1)
+	assert.True(t, db.Txs[0].RolledBack)
+	assert.Equal(t, pgx.TxOptions{IsoLevel: pgx.ReadCommitted, AccessMode: pgx.ReadOnly}, db.Opts[0])
+}
+
+func TestTxManager_NestedCallReusesTransaction(t *testing.T) {
+	db := &pgtest.FakeDB{}
+	txManager := newTestTxManager(db)
+	repo := postgres.NewPgOrderRepository(txManager)
+
+	err := txManager.RunSerializable(context.Background(), postgres.TxModeReadWrite, func(ctxTx context.Context) error {
+		if err := repo.UpdateOrder(ctxTx, dto.OrderDTO{ID: 1}); err != nil {
+			return err
+		}
+
+		return txManager.RunReadCommitted(ctxTx, postgres.TxModeReadOnly, func(ctxNested context.Context) error {
+			return repo.UpdateOrder(ctxNested, dto.OrderDTO{ID: 2})
+		})
+	})
+	require.NoError(t, err)
+
+	require.Len(t, db.Txs, 1)
+	assert.Len(t, db.Txs[0].Execs, 2)
+	assert.Empty(t, db.Execs)
+}
+
+func TestTxManager_Retry(t *testing.T) {
+	errFn := errors.New("fn failed")
+
+	tests := []struct {
+		name       string
+		errs       []error
+		wantBegins int
+		wantErr    error
+	}{
+		{
+			name:       "SuccessAfterSerializationFailure",
+			errs:       []error{&pgconn.PgError{Code: "40001"}, &pgconn.PgError{Code: "40P01"}, nil},
+			wantBegins: 3,
+		},
+		{
+			name:       "ErrorRetriesExceeded",
+			errs:       []error{&pgconn.PgError{Code: "40001"}, &pgconn.PgError{Code: "40001"}, &pgconn.PgError{Code: "40001"}},
+			wantBegins: 3,
+			wantErr:    postgres.ErrTxRetriesExceeded,
+		},
+		{
+			name:       "ErrorNotRetryable",
+			errs:       []error{errFn},
+			wantBegins: 1,
+			wantErr:    errFn,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := &pgtest.FakeDB{}
+			txManager := newTestTxManager(db)
+
+			attempt := 0
+			err := txManager.RunSerializable(context.Background(), postgres.TxModeReadWrite, func(context.Context) error {
+				err := tt.errs[attempt]
+				attempt++
+				return err
+			})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Len(t, db.Txs, tt.wantBegins)
+			for i, tx := range db.Txs {
+				assert.Equal(t, i == len(db.Txs)-1 && tt.wantErr == nil, tx.Committed)
+			}
+		})
+	}
+}
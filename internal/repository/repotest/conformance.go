@@ -0,0 +1,689 @@
+// Package repotest holds the conformance suite every usecase.OrderRepoFacade
+// implementation has to pass
+package repotest
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/Na322Pr/route256/internal/domain"
+	"github.com/Na322Pr/route256/internal/dto"
+	"github.com/Na322Pr/route256/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type Backend struct {
+	// New returns repository over empty storage
+	New func(t *testing.T) usecase.OrderRepoFacade
+	// ErrOrderNotFound is returned by GetOrderByID for unknown order
+	ErrOrderNotFound error
+	// ErrAlreadyExist is returned by AddOrder for id of stored or archived order
+	ErrAlreadyExist error
+}
+
+func Run(t *testing.T, backend Backend) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, backend Backend)
+	}{
+		{"AddAndGet", testAddAndGet},
+		{"AddDuplicate", testAddDuplicate},
+		{"GetNotFound", testGetNotFound},
+		{"UpdateOrder", testUpdateOrder},
+		{"UpdateOrderConflict", testUpdateOrderConflict},
+		{"UpdateOrdersAtomic", testUpdateOrdersAtomic},
+		{"GetOrdersByIDs", testGetOrdersByIDs},
+		{"GetClientOrdersList", testGetClientOrdersList},
+		{"GetRefundsList", testGetRefundsList},
+		{"ListOrdersByStatus", testListOrdersByStatus},
+		{"HandOverOrders", testHandOverOrders},
+		{"HandOverOrdersConflict", testHandOverOrdersConflict},
+		{"ArchiveOrders", testArchiveOrders},
+		{"ArchiveOrdersBatch", testArchiveOrdersBatch},
+		{"ArchiveOrdersDomainDeleted", testArchiveOrdersDomainDeleted},
+		{"ArchiveOrdersRefusedItems", testArchiveOrdersRefusedItems},
+		{"AddArchivedDuplicate", testAddArchivedDuplicate},
+		{"AuditLog", testAuditLog},
+		{"WithinTxRollback", testWithinTxRollback},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(t, backend)
+		})
+	}
+}
+
+var (
+	statusReceived = domain.OrderStatusMap[domain.OrderStatusReceived]
+	statusPickedUp = domain.OrderStatusMap[domain.OrderStatusPickedUp]
+	statusRefunded = domain.OrderStatusMap[domain.OrderStatusRefunded]
+	statusReturned = domain.OrderStatusMap[domain.OrderStatusReturnedToSeller]
+	statusDeleted  = domain.OrderStatusMap[domain.OrderStatusDelete]
+)
+
+func newOrder(id int64, clientID int) dto.OrderDTO {
+	return dto.OrderDTO{
+		ID:         id,
+		ClientID:   clientID,
+		StoreUntil: time.Date(2030, time.January, 1, 12, 0, 0, 0, time.UTC),
+		Status:     statusReceived,
+		Cost:       1500,
+		Weight:     7,
+		Packages:   []string{"box", "tape"},
+		Items: []dto.OrderItemDTO{
+			{SKU: "phone", Name: "Phone", Quantity: 1, UnitPrice: 1000, Status: "received"},
+			{SKU: "case", Name: "Case", Quantity: 2, UnitPrice: 250, Status: "received"},
+		},
+	}
+}
+
+func addOrders(t *testing.T, repo usecase.OrderRepoFacade, orders ...dto.OrderDTO) {
+	t.Helper()
+
+	for _, order := range orders {
+		require.NoError(t, repo.AddOrder(context.Background(), order))
+	}
+}
+
+func getOrder(t *testing.T, repo usecase.OrderRepoFacade, id int64) dto.OrderDTO {
+	t.Helper()
+
+	order, err := repo.GetOrderByID(context.Background(), id)
+	require.NoError(t, err)
+	require.NotNil(t, order)
+
+	return *order
+}
+
+func orderIDs(list *dto.ListOrdersDTO) []int64 {
+	ids := make([]int64, 0, len(list.Orders))
+	for _, order := range list.Orders {
+		ids = append(ids, order.ID)
+	}
+
+	return ids
+}
+
+func testAddAndGet(t *testing.T, backend Backend) {
+	repo := backend.New(t)
+	want := newOrder(1, 10)
+	addOrders(t, repo, want)
+
+	got := getOrder(t, repo, 1)
+
+	assert.Equal(t, want.ID, got.ID)
+	assert.Equal(t, want.ClientID, got.ClientID)
+	assert.True(t, want.StoreUntil.Equal(got.StoreUntil))
+	assert.Equal(t, want.Status, got.Status)
+	assert.Equal(t, want.Cost, got.Cost)
+	assert.Equal(t, want.Weight, got.Weight)
+	assert.Equal(t, want.Packages, got.Packages)
+	assert.False(t, got.PickUpTime.Valid)
+	assert.Zero(t, got.Version)
+
+	// items come back sorted by sku and bound to the order
+	require.Len(t, got.Items, 2)
+	assert.Equal(t, dto.OrderItemDTO{OrderID: 1, SKU: "case", Name: "Case", Quantity: 2, UnitPrice: 250, Status: "received"}, got.Items[0])
+	assert.Equal(t, dto.OrderItemDTO{OrderID: 1, SKU: "phone", Name: "Phone", Quantity: 1, UnitPrice: 1000, Status: "received"}, got.Items[1])
+}
+
+func testAddDuplicate(t *testing.T, backend Backend) {
+	repo := backend.New(t)
+	addOrders(t, repo, newOrder(1, 10))
+
+	duplicate := newOrder(1, 20)
+	duplicate.Items = nil
+	assert.ErrorIs(t, repo.AddOrder(context.Background(), duplicate), backend.ErrAlreadyExist)
+
+	assert.Equal(t, 10, getOrder(t, repo, 1).ClientID)
+}
+
+func testGetNotFound(t *testing.T, backend Backend) {
+	repo := backend.New(t)
+
+	_, err := repo.GetOrderByID(context.Background(), 42)
+	assert.ErrorIs(t, err, backend.ErrOrderNotFound)
+}
+
+func testUpdateOrder(t *testing.T, backend Backend) {
+	ctx := context.Background()
+	repo := backend.New(t)
+	addOrders(t, repo, newOrder(1, 10))
+
+	pickUpTime := time.Date(2029, time.June, 1, 10, 30, 0, 0, time.UTC)
+
+	order := getOrder(t, repo, 1)
+	order.Status = statusRefunded
+	order.PickUpTime = sql.NullTime{Time: pickUpTime, Valid: true}
+	order.RefundReason = "defect"
+	order.RefundComment = "broken screen"
+	order.InspectionCondition = "damaged"
+	order.InspectionOutcome = "accepted"
+	order.Items[1].Status = "refunded"
+	require.NoError(t, repo.UpdateOrder(ctx, order))
+
+	got := getOrder(t, repo, 1)
+	assert.Equal(t, statusRefunded, got.Status)
+	assert.True(t, got.PickUpTime.Valid)
+	assert.True(t, pickUpTime.Equal(got.PickUpTime.Time))
+	assert.Equal(t, "defect", got.RefundReason)
+	assert.Equal(t, "broken screen", got.RefundComment)
+	assert.Equal(t, "damaged", got.InspectionCondition)
+	assert.Equal(t, "accepted", got.InspectionOutcome)
+	assert.Equal(t, int64(1), got.Version)
+	assert.Equal(t, "received", got.Items[0].Status)
+	assert.Equal(t, "refunded", got.Items[1].Status)
+}
+
+func testUpdateOrderConflict(t *testing.T, backend Backend) {
+	ctx := context.Background()
+	repo := backend.New(t)
+	addOrders(t, repo, newOrder(1, 10))
+
+	stale := getOrder(t, repo, 1)
+
+	fresh := stale
+	fresh.Status = statusPickedUp
+	require.NoError(t, repo.UpdateOrder(ctx, fresh))
+
+	stale.Status = statusReturned
+	assert.ErrorIs(t, repo.UpdateOrder(ctx, stale), domain.ErrConcurrentModification)
+
+	missing := newOrder(2, 10)
+	assert.ErrorIs(t, repo.UpdateOrder(ctx, missing), domain.ErrConcurrentModification)
+
+	got := getOrder(t, repo, 1)
+	assert.Equal(t, statusPickedUp, got.Status)
+	assert.Equal(t, int64(1), got.Version)
+}
+
+func testUpdateOrdersAtomic(t *testing.T, backend Backend) {
+	ctx := context.Background()
+	repo := backend.New(t)
+	addOrders(t, repo, newOrder(1, 10), newOrder(2, 10))
+
+	first := getOrder(t, repo, 1)
+	first.Status = statusPickedUp
+
+	second := getOrder(t, repo, 2)
+	second.Status = statusPickedUp
+	second.Version = 5
+
+	assert.ErrorIs(t, repo.UpdateOrders(ctx, []dto.OrderDTO{first, second}), domain.ErrConcurrentModification)
+	assert.Equal(t, statusReceived, getOrder(t, repo, 1).Status)
+
+	second.Version = 0
+	require.NoError(t, repo.UpdateOrders(ctx, []dto.OrderDTO{first, second}))
+	assert.Equal(t, statusPickedUp, getOrder(t, repo, 1).Status)
+	assert.Equal(t, statusPickedUp, getOrder(t, repo, 2).Status)
+}
+
+func testGetOrdersByIDs(t *testing.T, backend Backend) {
+	repo := backend.New(t)
+	addOrders(t, repo, newOrder(3, 10), newOrder(1, 10), newOrder(2, 20))
+
+	list, err := repo.GetOrdersByIDs(context.Background(), []int64{3, 1, 100})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []int64{1, 3}, orderIDs(list))
+
+	for _, order := range list.Orders {
+		assert.Len(t, order.Items, 2)
+	}
+}
+
+func testGetClientOrdersList(t *testing.T, backend Backend) {
+	ctx := context.Background()
+	repo := backend.New(t)
+	addOrders(t, repo, newOrder(1, 10), newOrder(2, 10), newOrder(3, 20))
+
+	pickedUp := getOrder(t, repo, 2)
+	pickedUp.Status = statusPickedUp
+	require.NoError(t, repo.UpdateOrder(ctx, pickedUp))
+
+	list, err := repo.GetClientOrdersList(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1}, orderIDs(list))
+	assert.Len(t, list.Orders[0].Items, 2)
+
+	list, err = repo.GetClientOrdersList(ctx, 30)
+	require.NoError(t, err)
+	assert.Empty(t, list.Orders)
+}
+
+func testGetRefundsList(t *testing.T, backend Backend) {
+	ctx := context.Background()
+	repo := backend.New(t)
+
+	for id := int64(1); id <= 5; id++ {
+		addOrders(t, repo, newOrder(id, 10))
+
+		if id == 3 {
+			continue
+		}
+
+		order := getOrder(t, repo, id)
+		order.Status = statusRefunded
+		require.NoError(t, repo.UpdateOrder(ctx, order))
+	}
+
+	statuses := []string{statusRefunded}
+
+	list, err := repo.GetRefundsList(ctx, statuses, 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 4, 5}, orderIDs(list))
+
+	list, err = repo.GetRefundsList(ctx, statuses, 2, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2}, orderIDs(list))
+
+	list, err = repo.GetRefundsList(ctx, statuses, 2, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{5}, orderIDs(list))
+
+	list, err = repo.GetRefundsList(ctx, statuses, 0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{4, 5}, orderIDs(list))
+
+	list, err = repo.GetRefundsList(ctx, []string{statusReturned}, 0, 0)
+	require.NoError(t, err)
+	assert.Empty(t, list.Orders)
+}
+
+func testListOrdersByStatus(t *testing.T, backend Backend) {
+	ctx := context.Background()
+	repo := backend.New(t)
+
+	addOrders(t, repo, newOrder(1, 10), newOrder(2, 20), newOrder(3, 30))
+
+	order := getOrder(t, repo, 2)
+	order.Status = statusPickedUp
+	require.NoError(t, repo.UpdateOrder(ctx, order))
+
+	list, err := repo.ListOrdersByStatus(ctx, []string{statusReceived}, 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 3}, orderIDs(list))
+	require.Len(t, list.Orders[0].Items, 2)
+
+	list, err = repo.ListOrdersByStatus(ctx, []string{statusReceived, statusPickedUp}, 2, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{2, 3}, orderIDs(list))
+}
+
+func testHandOverOrders(t *testing.T, backend Backend) {
+	ctx := context.Background()
+	repo := backend.New(t)
+	addOrders(t, repo, newOrder(1, 10), newOrder(2, 10))
+
+	orders := make([]dto.OrderDTO, 0, 2)
+	for _, id := range []int64{1, 2} {
+		order := getOrder(t, repo, id)
+		order.Status = statusReturned
+		orders = append(orders, order)
+	}
+
+	firstID, err := repo.HandOverOrders(ctx, dto.HandoverDTO{
+		Courier:      "courier-1",
+		HandedOverAt: time.Date(2029, time.June, 1, 10, 0, 0, 0, time.UTC),
+		Orders:       orders[:1],
+	})
+	require.NoError(t, err)
+	assert.NotZero(t, firstID)
+
+	secondID, err := repo.HandOverOrders(ctx, dto.HandoverDTO{
+		Courier:      "courier-1",
+		HandedOverAt: time.Date(2029, time.June, 1, 11, 0, 0, 0, time.UTC),
+		Orders:       orders[1:],
+	})
+	require.NoError(t, err)
+	assert.Greater(t, secondID, firstID)
+
+	for _, id := range []int64{1, 2} {
+		got := getOrder(t, repo, id)
+		assert.Equal(t, statusReturned, got.Status)
+		assert.Equal(t, int64(1), got.Version)
+	}
+}
+
+func testHandOverOrdersConflict(t *testing.T, backend Backend) {
+	ctx := context.Background()
+	repo := backend.New(t)
+	addOrders(t, repo, newOrder(1, 10), newOrder(2, 10))
+
+	first := getOrder(t, repo, 1)
+	first.Status = statusReturned
+
+	second := getOrder(t, repo, 2)
+	second.Status = statusReturned
+	second.Version = 3
+
+	_, err := repo.HandOverOrders(ctx, dto.HandoverDTO{
+		Courier:      "courier-1",
+		HandedOverAt: time.Date(2029, time.June, 1, 10, 0, 0, 0, time.UTC),
+		Orders:       []dto.OrderDTO{first, second},
+	})
+	assert.ErrorIs(t, err, domain.ErrConcurrentModification)
+
+	got := getOrder(t, repo, 1)
+	assert.Equal(t, statusReceived, got.Status)
+	assert.Zero(t, got.Version)
+}
+
+func testArchiveOrders(t *testing.T, backend Backend) {
+	ctx := context.Background()
+	repo := backend.New(t)
+
+	pickedUpAt := time.Date(2029, time.January, 10, 12, 0, 0, 0, time.UTC)
+	before := time.Date(2029, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+	// 1 - old picked up, 2 - recently picked up, 3 - old but still received,
+	// 4 - deleted after storage expired, 5 - old picked up handed over before archiving
+	expired := newOrder(4, 10)
+	expired.StoreUntil = time.Date(2029, time.January, 5, 12, 0, 0, 0, time.UTC)
+	addOrders(t, repo, newOrder(1, 10), newOrder(2, 10), newOrder(3, 10), expired, newOrder(5, 10))
+
+	update := func(id int64, status string, pickUpTime time.Time) dto.OrderDTO {
+		order := getOrder(t, repo, id)
+		order.Status = status
+		if !pickUpTime.IsZero() {
+			order.PickUpTime = sql.NullTime{Time: pickUpTime, Valid: true}
+		}
+		order.Items[0].Status = "pickedUp"
+		return order
+	}
+
+	require.NoError(t, repo.UpdateOrder(ctx, update(1, statusPickedUp, pickedUpAt)))
+	require.NoError(t, repo.UpdateOrder(ctx, update(2, statusPickedUp, before.Add(time.Hour))))
+	require.NoError(t, repo.UpdateOrder(ctx, update(4, statusDeleted, time.Time{})))
+
+	_, err := repo.HandOverOrders(ctx, dto.HandoverDTO{
+		Courier:      "courier-1",
+		HandedOverAt: pickedUpAt,
+		Orders:       []dto.OrderDTO{update(5, statusReturned, pickedUpAt)},
+	})
+	require.NoError(t, err)
+
+	archived, err := repo.ArchiveOrders(ctx, dto.ArchiveOrdersDTO{
+		Statuses:   []string{statusPickedUp, statusDeleted, statusReturned},
+		Before:     before,
+		ArchivedAt: before.Add(24 * time.Hour),
+		Limit:      10,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 4, 5}, archived)
+
+	// archived orders are still found by id with their items, but read only
+	for _, id := range []int64{1, 4, 5} {
+		got := getOrder(t, repo, id)
+		assert.Equal(t, id, got.ID)
+		assert.True(t, got.Archived)
+		assert.Equal(t, int64(1), got.Version)
+		require.Len(t, got.Items, 2)
+		assert.Equal(t, id, got.Items[0].OrderID)
+	}
+
+	assert.True(t, getOrder(t, repo, 1).PickUpTime.Valid)
+	assert.Equal(t, statusDeleted, getOrder(t, repo, 4).Status)
+
+	// but no longer take part in updates and lists
+	assert.ErrorIs(t, repo.UpdateOrder(ctx, getOrder(t, repo, 1)), domain.ErrConcurrentModification)
+
+	list, err := repo.GetRefundsList(ctx, []string{statusReturned}, 0, 0)
+	require.NoError(t, err)
+	assert.Empty(t, list.Orders)
+
+	list, err = repo.GetClientOrdersList(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3}, orderIDs(list))
+	assert.False(t, getOrder(t, repo, 3).Archived)
+
+	archived, err = repo.ArchiveOrders(ctx, dto.ArchiveOrdersDTO{
+		Statuses:   []string{statusPickedUp, statusDeleted, statusReturned},
+		Before:     before,
+		ArchivedAt: before.Add(48 * time.Hour),
+		Limit:      10,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, archived)
+}
+
+// testArchiveOrdersDomainDeleted writes a never picked up order through domain conversion,
+// so its empty pick up time has to be stored as null and store until time is used instead
+func testArchiveOrdersDomainDeleted(t *testing.T, backend Backend) {
+	ctx := context.Background()
+	repo := backend.New(t)
+
+	storeUntil := time.Date(2029, time.February, 10, 12, 0, 0, 0, time.UTC)
+
+	received := newOrder(1, 10)
+	received.StoreUntil = storeUntil
+	addOrders(t, repo, received)
+
+	var order domain.Order
+	require.NoError(t, order.FromDTO(getOrder(t, repo, 1)))
+	order.SetStatus(domain.OrderStatusDelete)
+	require.NoError(t, repo.UpdateOrder(ctx, *order.ToDTO()))
+
+	got := getOrder(t, repo, 1)
+	assert.Equal(t, statusDeleted, got.Status)
+	assert.False(t, got.PickUpTime.Valid)
+
+	archiveDTO := dto.ArchiveOrdersDTO{
+		Statuses:   []string{statusDeleted},
+		Before:     storeUntil.Add(-time.Hour),
+		ArchivedAt: storeUntil,
+		Limit:      10,
+	}
+
+	archived, err := repo.ArchiveOrders(ctx, archiveDTO)
+	require.NoError(t, err)
+	assert.Empty(t, archived)
+
+	archiveDTO.Before = storeUntil.Add(time.Hour)
+	archived, err = repo.ArchiveOrders(ctx, archiveDTO)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1}, archived)
+}
+
+// testArchiveOrdersRefusedItems keeps picked up order in live tables
+// until its refused items are returned to courier
+func testArchiveOrdersRefusedItems(t *testing.T, backend Backend) {
+	ctx := context.Background()
+	repo := backend.New(t)
+
+	pickedUpAt := time.Date(2029, time.January, 10, 12, 0, 0, 0, time.UTC)
+	archiveDTO := dto.ArchiveOrdersDTO{
+		Statuses:   []string{statusPickedUp},
+		Before:     pickedUpAt.Add(time.Hour),
+		ArchivedAt: pickedUpAt.Add(24 * time.Hour),
+		Limit:      10,
+	}
+
+	addOrders(t, repo, newOrder(1, 10))
+
+	order := getOrder(t, repo, 1)
+	order.Status = statusPickedUp
+	order.PickUpTime = sql.NullTime{Time: pickedUpAt, Valid: true}
+	order.Items[0].Status = "refused"
+	order.Items[1].Status = "pickedUp"
+	require.NoError(t, repo.UpdateOrder(ctx, order))
+
+	archived, err := repo.ArchiveOrders(ctx, archiveDTO)
+	require.NoError(t, err)
+	assert.Empty(t, archived)
+
+	order = getOrder(t, repo, 1)
+	order.Items[0].Status = "returned"
+	require.NoError(t, repo.UpdateOrder(ctx, order))
+
+	archived, err = repo.ArchiveOrders(ctx, archiveDTO)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1}, archived)
+}
+
+// testAddArchivedDuplicate checks id of archived order is not given to a new one
+func testAddArchivedDuplicate(t *testing.T, backend Backend) {
+	ctx := context.Background()
+	repo := backend.New(t)
+
+	addOrders(t, repo, newOrder(1, 10))
+
+	order := getOrder(t, repo, 1)
+	order.Status = statusDeleted
+	require.NoError(t, repo.UpdateOrder(ctx, order))
+
+	archived, err := repo.ArchiveOrders(ctx, dto.ArchiveOrdersDTO{
+		Statuses:   []string{statusDeleted},
+		Before:     time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+		ArchivedAt: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+		Limit:      10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{1}, archived)
+
+	assert.ErrorIs(t, repo.AddOrder(ctx, newOrder(1, 20)), backend.ErrAlreadyExist)
+
+	got := getOrder(t, repo, 1)
+	assert.True(t, got.Archived)
+	assert.Equal(t, 10, got.ClientID)
+}
+
+func testArchiveOrdersBatch(t *testing.T, backend Backend) {
+	ctx := context.Background()
+	repo := backend.New(t)
+
+	archiveDTO := dto.ArchiveOrdersDTO{
+		Statuses:   []string{statusDeleted},
+		Before:     time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+		ArchivedAt: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+		Limit:      2,
+	}
+
+	for id := int64(1); id <= 5; id++ {
+		addOrders(t, repo, newOrder(id, 10))
+
+		order := getOrder(t, repo, id)
+		order.Status = statusDeleted
+		require.NoError(t, repo.UpdateOrder(ctx, order))
+	}
+
+	for _, want := range []int{2, 2, 1, 0} {
+		archived, err := repo.ArchiveOrders(ctx, archiveDTO)
+		require.NoError(t, err)
+		assert.Len(t, archived, want)
+	}
+}
+
+func newAuditEntry(operatorID, method string, createdAt time.Time, orderIDs ...int64) dto.AuditLogDTO {
+	return dto.AuditLogDTO{
+		OperatorID: operatorID,
+		Method:     method,
+		OrderIDs:   orderIDs,
+		Before:     json.RawMessage(`[]`),
+		After:      json.RawMessage(`[{"id":1}]`),
+		ClientIP:   "10.0.0.1",
+		CreatedAt:  createdAt,
+	}
+}
+
+func auditEntryIDs(list *dto.ListAuditLogDTO) []int64 {
+	ids := make([]int64, 0, len(list.Entries))
+	for _, entry := range list.Entries {
+		ids = append(ids, entry.ID)
+	}
+
+	return ids
+}
+
+func testAuditLog(t *testing.T, backend Backend) {
+	ctx := context.Background()
+	repo := backend.New(t)
+
+	at := time.Date(2030, time.January, 1, 12, 0, 0, 0, time.UTC)
+	entries := []dto.AuditLogDTO{
+		newAuditEntry("alice", "ReceiveCourier", at, 1),
+		newAuditEntry("bob", "CheckoutClient", at.Add(time.Hour), 1, 2),
+		newAuditEntry("alice", "RefundClient", at.Add(2*time.Hour), 2),
+	}
+	for _, entry := range entries {
+		require.NoError(t, repo.AddAuditLog(ctx, entry))
+	}
+
+	list, err := repo.QueryAuditLog(ctx, dto.AuditLogFilterDTO{})
+	require.NoError(t, err)
+	require.Len(t, list.Entries, 3)
+
+	newest := list.Entries[0]
+	assert.Equal(t, "alice", newest.OperatorID)
+	assert.Equal(t, "RefundClient", newest.Method)
+	assert.Equal(t, []int64{2}, newest.OrderIDs)
+	assert.JSONEq(t, `[]`, string(newest.Before))
+	assert.JSONEq(t, `[{"id":1}]`, string(newest.After))
+	assert.Equal(t, "10.0.0.1", newest.ClientIP)
+	assert.True(t, at.Add(2*time.Hour).Equal(newest.CreatedAt))
+
+	ids := auditEntryIDs(list)
+	assert.Greater(t, ids[0], ids[1])
+	assert.Greater(t, ids[1], ids[2])
+
+	tests := []struct {
+		name   string
+		filter dto.AuditLogFilterDTO
+		want   []int64
+	}{
+		{"Operator", dto.AuditLogFilterDTO{OperatorID: "alice"}, []int64{ids[0], ids[2]}},
+		{"Method", dto.AuditLogFilterDTO{Method: "CheckoutClient"}, []int64{ids[1]}},
+		{"Order", dto.AuditLogFilterDTO{OrderID: 2}, []int64{ids[0], ids[1]}},
+		{"Period", dto.AuditLogFilterDTO{From: at.Add(time.Hour), To: at.Add(2 * time.Hour)}, []int64{ids[1]}},
+		{"Page", dto.AuditLogFilterDTO{Limit: 1, Offset: 1}, []int64{ids[1]}},
+		{"Combined", dto.AuditLogFilterDTO{OperatorID: "alice", OrderID: 1}, []int64{ids[2]}},
+		{"NoMatch", dto.AuditLogFilterDTO{OrderID: 3}, []int64{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list, err := repo.QueryAuditLog(ctx, tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, auditEntryIDs(list))
+		})
+	}
+}
+
+func testWithinTxRollback(t *testing.T, backend Backend) {
+	ctx := context.Background()
+	repo := backend.New(t)
+
+	addOrders(t, repo, newOrder(1, 10))
+	stale := getOrder(t, repo, 1)
+
+	fresh := stale
+	fresh.Status = statusPickedUp
+	require.NoError(t, repo.UpdateOrder(ctx, fresh))
+
+	// audit entry written before the failed update is rolled back with it
+	err := repo.WithinTx(ctx, func(ctxTx context.Context) error {
+		if err := repo.AddAuditLog(ctxTx, newAuditEntry("alice", "ReturnCourier", time.Now(), 1)); err != nil {
+			return err
+		}
+
+		stale.Status = statusDeleted
+		return repo.UpdateOrder(ctxTx, stale)
+	})
+	assert.ErrorIs(t, err, domain.ErrConcurrentModification)
+
+	list, err := repo.QueryAuditLog(ctx, dto.AuditLogFilterDTO{})
+	require.NoError(t, err)
+	assert.Empty(t, list.Entries)
+
+	err = repo.WithinTx(ctx, func(ctxTx context.Context) error {
+		return repo.AddAuditLog(ctxTx, newAuditEntry("alice", "ReturnCourier", time.Now(), 1))
+	})
+	require.NoError(t, err)
+
+	list, err = repo.QueryAuditLog(ctx, dto.AuditLogFilterDTO{})
+	require.NoError(t, err)
+	assert.Len(t, list.Entries, 1)
+}
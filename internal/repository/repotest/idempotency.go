@@ -0,0 +1,137 @@
+package repotest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Na322Pr/route256/internal/app/mw"
+	"github.com/Na322Pr/route256/internal/dto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// RunIdempotencyStore checks mw.IdempotencyStore implementation over empty storage
+func RunIdempotencyStore(t *testing.T, newStore func(t *testing.T) mw.IdempotencyStore) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, store mw.IdempotencyStore)
+	}{
+		{"ReserveAndComplete", testIdempotencyReserveAndComplete},
+		{"ReserveExpired", testIdempotencyReserveExpired},
+		{"ReserveExpiredLease", testIdempotencyReserveExpiredLease},
+		{"Release", testIdempotencyRelease},
+		{"DeleteExpired", testIdempotencyDeleteExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(t, newStore(t))
+		})
+	}
+}
+
+var idempotencyNow = time.Date(2029, time.June, 1, 10, 0, 0, 0, time.UTC)
+
+func idempotencyRecord(key, hash string, createdAt time.Time) dto.IdempotencyRecordDTO {
+	return dto.IdempotencyRecordDTO{
+		Key:         key,
+		RequestHash: hash,
+		CreatedAt:   createdAt,
+		ExpiresAt:   createdAt.Add(time.Hour),
+	}
+}
+
+func testIdempotencyReserveAndComplete(t *testing.T, store mw.IdempotencyStore) {
+	ctx := context.Background()
+
+	existing, err := store.Reserve(ctx, idempotencyRecord("key-1", "hash-1", idempotencyNow))
+	require.NoError(t, err)
+	assert.Nil(t, existing)
+
+	// pending record is returned without response
+	existing, err = store.Reserve(ctx, idempotencyRecord("key-1", "hash-2", idempotencyNow.Add(time.Minute)))
+	require.NoError(t, err)
+	require.NotNil(t, existing)
+	assert.Equal(t, "hash-1", existing.RequestHash)
+	assert.Nil(t, existing.Response)
+
+	require.NoError(t, store.Complete(ctx, "key-1", []byte("response"), idempotencyNow.Add(2*time.Hour)))
+
+	existing, err = store.Reserve(ctx, idempotencyRecord("key-1", "hash-1", idempotencyNow.Add(time.Minute)))
+	require.NoError(t, err)
+	require.NotNil(t, existing)
+	assert.Equal(t, []byte("response"), existing.Response)
+	assert.True(t, idempotencyNow.Add(2*time.Hour).Equal(existing.ExpiresAt))
+
+	assert.Error(t, store.Complete(ctx, "key-2", []byte("response"), idempotencyNow.Add(time.Hour)))
+}
+
+func testIdempotencyReserveExpired(t *testing.T, store mw.IdempotencyStore) {
+	ctx := context.Background()
+
+	_, err := store.Reserve(ctx, idempotencyRecord("key-1", "hash-1", idempotencyNow))
+	require.NoError(t, err)
+	require.NoError(t, store.Complete(ctx, "key-1", []byte("response"), idempotencyNow.Add(time.Hour)))
+
+	existing, err := store.Reserve(ctx, idempotencyRecord("key-1", "hash-2", idempotencyNow.Add(2*time.Hour)))
+	require.NoError(t, err)
+	assert.Nil(t, existing)
+
+	existing, err = store.Reserve(ctx, idempotencyRecord("key-1", "hash-1", idempotencyNow.Add(2*time.Hour)))
+	require.NoError(t, err)
+	require.NotNil(t, existing)
+	assert.Equal(t, "hash-2", existing.RequestHash)
+	assert.Nil(t, existing.Response)
+}
+
+func testIdempotencyReserveExpiredLease(t *testing.T, store mw.IdempotencyStore) {
+	ctx := context.Background()
+
+	// pending record is reserved for a short lease and never completed
+	record := idempotencyRecord("key-1", "hash-1", idempotencyNow)
+	record.ExpiresAt = idempotencyNow.Add(time.Minute)
+	_, err := store.Reserve(ctx, record)
+	require.NoError(t, err)
+
+	existing, err := store.Reserve(ctx, idempotencyRecord("key-1", "hash-1", idempotencyNow.Add(2*time.Minute)))
+	require.NoError(t, err)
+	assert.Nil(t, existing)
+
+	require.NoError(t, store.Complete(ctx, "key-1", []byte("response"), idempotencyNow.Add(time.Hour)))
+
+	existing, err = store.Reserve(ctx, idempotencyRecord("key-1", "hash-1", idempotencyNow.Add(30*time.Minute)))
+	require.NoError(t, err)
+	require.NotNil(t, existing)
+	assert.Equal(t, []byte("response"), existing.Response)
+}
+
+func testIdempotencyRelease(t *testing.T, store mw.IdempotencyStore) {
+	ctx := context.Background()
+
+	_, err := store.Reserve(ctx, idempotencyRecord("key-1", "hash-1", idempotencyNow))
+	require.NoError(t, err)
+	require.NoError(t, store.Release(ctx, "key-1"))
+
+	existing, err := store.Reserve(ctx, idempotencyRecord("key-1", "hash-2", idempotencyNow))
+	require.NoError(t, err)
+	assert.Nil(t, existing)
+}
+
+func testIdempotencyDeleteExpired(t *testing.T, store mw.IdempotencyStore) {
+	ctx := context.Background()
+
+	_, err := store.Reserve(ctx, idempotencyRecord("old", "hash-1", idempotencyNow))
+	require.NoError(t, err)
+	_, err = store.Reserve(ctx, idempotencyRecord("new", "hash-2", idempotencyNow.Add(time.Hour)))
+	require.NoError(t, err)
+
+	deleted, err := store.DeleteExpired(ctx, idempotencyNow.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	existing, err := store.Reserve(ctx, idempotencyRecord("new", "hash-3", idempotencyNow.Add(time.Hour)))
+	require.NoError(t, err)
+	require.NotNil(t, existing)
+	assert.Equal(t, "hash-2", existing.RequestHash)
+}
@@ -0,0 +1,133 @@
+package sqlite
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Na322Pr/route256/internal/dto"
+)
+
+func (r *SqliteOrderRepository) WithinTx(ctx context.Context, fn func(ctxTx context.Context) error) error {
+	return r.txManager.RunSerializable(ctx, TxModeReadWrite, fn)
+}
+
+func (r *SqliteOrderRepository) AddAuditLog(ctx context.Context, entry dto.AuditLogDTO) error {
+	const (
+		op = "SqliteOrderRepository.AddAuditLog"
+
+		sqlQuery = `insert into audit_log(operator_id, method, order_ids, before, after, client_ip, created_at)
+		values (?, ?, ?, ?, ?, ?, ?)`
+	)
+
+	orderIDs, err := json.Marshal(entry.OrderIDs)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = r.txManager.RunSerializable(ctx, TxModeReadWrite, func(ctxTx context.Context) error {
+		_, err := r.txManager.GetQueryEngine(ctxTx).ExecContext(ctxTx, sqlQuery,
+			entry.OperatorID,
+			entry.Method,
+			string(orderIDs),
+			string(entry.Before),
+			string(entry.After),
+			entry.ClientIP,
+			entry.CreatedAt,
+		)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// QueryAuditLog returns matching entries newest first
+func (r *SqliteOrderRepository) QueryAuditLog(ctx context.Context, filter dto.AuditLogFilterDTO) (*dto.ListAuditLogDTO, error) {
+	const op = "SqliteOrderRepository.QueryAuditLog"
+
+	var (
+		conds []string
+		args  []any
+	)
+
+	if filter.OperatorID != "" {
+		conds = append(conds, "operator_id = ?")
+		args = append(args, filter.OperatorID)
+	}
+
+	if filter.Method != "" {
+		conds = append(conds, "method = ?")
+		args = append(args, filter.Method)
+	}
+
+	if filter.OrderID != 0 {
+		conds = append(conds, "exists (select 1 from json_each(order_ids) where value = ?)")
+		args = append(args, filter.OrderID)
+	}
+
+	if !filter.From.IsZero() {
+		conds = append(conds, "julianday(created_at) >= julianday(?)")
+		args = append(args, filter.From)
+	}
+
+	if !filter.To.IsZero() {
+		conds = append(conds, "julianday(created_at) < julianday(?)")
+		args = append(args, filter.To)
+	}
+
+	query := "select id, operator_id, method, order_ids, before, after, client_ip, created_at from audit_log "
+	if len(conds) > 0 {
+		query += "where " + strings.Join(conds, " and ") + " "
+	}
+
+	// sqlite requires limit before offset, -1 means no limit
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = -1
+	}
+
+	query += "order by id desc limit ? offset ?"
+	args = append(args, limit, max(filter.Offset, 0))
+
+	entries := make([]dto.AuditLogDTO, 0)
+
+	err := r.txManager.RunReadCommitted(ctx, TxModeReadOnly, func(ctxTx context.Context) error {
+		rows, err := r.txManager.GetQueryEngine(ctxTx).QueryContext(ctxTx, query, args...)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var (
+				entry                   dto.AuditLogDTO
+				orderIDs, before, after string
+			)
+
+			err := rows.Scan(&entry.ID, &entry.OperatorID, &entry.Method, &orderIDs, &before, &after,
+				&entry.ClientIP, &entry.CreatedAt)
+			if err != nil {
+				return err
+			}
+
+			if err := json.Unmarshal([]byte(orderIDs), &entry.OrderIDs); err != nil {
+				return err
+			}
+
+			entry.Before = json.RawMessage(before)
+			entry.After = json.RawMessage(after)
+			entries = append(entries, entry)
+		}
+
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &dto.ListAuditLogDTO{Entries: entries}, nil
+}
@@ -0,0 +1,48 @@
+package sqlite_test
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/Na322Pr/route256/internal/app/mw"
+	"github.com/Na322Pr/route256/internal/config"
+	"github.com/Na322Pr/route256/internal/migrator"
+	"github.com/Na322Pr/route256/internal/repository/repotest"
+	"github.com/Na322Pr/route256/internal/repository/sqlite"
+	"github.com/Na322Pr/route256/internal/usecase"
+	"github.com/stretchr/testify/require"
+)
+
+func openTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sqlite.Open(filepath.Join(t.TempDir(), "pvz.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	m, err := migrator.New(db, config.StorageSQLite)
+	require.NoError(t, err)
+
+	_, err = m.Up(context.Background())
+	require.NoError(t, err)
+
+	return db
+}
+
+func TestSqliteOrderRepository_Conformance(t *testing.T) {
+	repotest.Run(t, repotest.Backend{
+		New: func(t *testing.T) usecase.OrderRepoFacade {
+			return sqlite.NewFacade(openTestDB(t))
+		},
+		ErrOrderNotFound: sqlite.ErrOrderNotFound,
+		ErrAlreadyExist:  sqlite.ErrAlreadyExist,
+	})
+}
+
+func TestIdempotencyStore_Conformance(t *testing.T) {
+	repotest.RunIdempotencyStore(t, func(t *testing.T) mw.IdempotencyStore {
+		return sqlite.NewIdempotencyStore(openTestDB(t))
+	})
+}
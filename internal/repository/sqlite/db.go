@@ -0,0 +1,26 @@
+package sqlite
+
+import (
+	"database/sql"
+	"fmt"
+
+	_ "modernc.org/sqlite"
+)
+
+const dsnParams = "_txlock=immediate" +
+	"&_time_format=sqlite" +
+	"&_pragma=foreign_keys(1)" +
+	"&_pragma=journal_mode(WAL)" +
+	"&_pragma=busy_timeout(5000)"
+
+// Open opens sqlite database file, the schema is managed by migrator
+func Open(path string) (*sql.DB, error) {
+	const op = "sqlite.Open"
+
+	db, err := sql.Open("sqlite", fmt.Sprintf("file:%s?%s", path, dsnParams))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return db, nil
+}
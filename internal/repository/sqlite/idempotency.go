@@ -0,0 +1,122 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Na322Pr/route256/internal/dto"
+)
+
+type IdempotencyStore struct {
+	txManager *TxManager
+}
+
+func NewIdempotencyStore(db *sql.DB) *IdempotencyStore {
+	return &IdempotencyStore{txManager: NewTxManager(db)}
+}
+
+func (s *IdempotencyStore) Reserve(ctx context.Context, record dto.IdempotencyRecordDTO) (*dto.IdempotencyRecordDTO, error) {
+	const (
+		op = "IdempotencyStore.Reserve"
+
+		sqlSelectQuery = `select idempotency_key, request_hash, response, created_at, expires_at
+		from idempotency_keys where idempotency_key = ?`
+
+		sqlUpsertQuery = `insert into idempotency_keys(idempotency_key, request_hash, response, created_at, expires_at)
+		values (?, ?, null, ?, ?)
+		on conflict (idempotency_key) do update
+		set request_hash = excluded.request_hash, response = null,
+			created_at = excluded.created_at, expires_at = excluded.expires_at`
+	)
+
+	var existing *dto.IdempotencyRecordDTO
+
+	err := s.txManager.RunSerializable(ctx, TxModeReadWrite, func(ctxTx context.Context) error {
+		tx := s.txManager.GetQueryEngine(ctxTx)
+
+		var stored dto.IdempotencyRecordDTO
+		err := tx.QueryRowContext(ctxTx, sqlSelectQuery, record.Key).Scan(
+			&stored.Key,
+			&stored.RequestHash,
+			&stored.Response,
+			&stored.CreatedAt,
+			&stored.ExpiresAt,
+		)
+		if err == nil && stored.ExpiresAt.After(record.CreatedAt) {
+			existing = &stored
+			return nil
+		}
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctxTx, sqlUpsertQuery, record.Key, record.RequestHash, record.CreatedAt, record.ExpiresAt)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return existing, nil
+}
+
+func (s *IdempotencyStore) Complete(ctx context.Context, key string, response []byte, expiresAt time.Time) error {
+	const (
+		op = "IdempotencyStore.Complete"
+
+		sqlQuery = `update idempotency_keys set response = ?, expires_at = ? where idempotency_key = ?`
+	)
+
+	res, err := s.txManager.GetQueryEngine(ctx).ExecContext(ctx, sqlQuery, response, expiresAt, key)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, ErrIdempotencyKeyNotFound)
+	}
+
+	return nil
+}
+
+func (s *IdempotencyStore) Release(ctx context.Context, key string) error {
+	const (
+		op = "IdempotencyStore.Release"
+
+		sqlQuery = `delete from idempotency_keys where idempotency_key = ?`
+	)
+
+	if _, err := s.txManager.GetQueryEngine(ctx).ExecContext(ctx, sqlQuery, key); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *IdempotencyStore) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	const (
+		op = "IdempotencyStore.DeleteExpired"
+
+		sqlQuery = `delete from idempotency_keys where expires_at <= ?`
+	)
+
+	res, err := s.txManager.GetQueryEngine(ctx).ExecContext(ctx, sqlQuery, now)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,12 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+)
+
+type QueryEngine interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
@@ -0,0 +1,521 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Na322Pr/route256/internal/domain"
+	"github.com/Na322Pr/route256/internal/dto"
+	"github.com/Na322Pr/route256/internal/usecase"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+const orderColumns = `order_id, client_id, store_until, status, cost, weight, packages, pick_up_time,
+	refund_reason, refund_comment, inspection_condition, inspection_outcome, version`
+
+// orderTables names a pair of orders and order items tables
+type orderTables struct {
+	orders string
+	items  string
+}
+
+var (
+	liveTables    = orderTables{orders: "orders", items: "order_items"}
+	archiveTables = orderTables{orders: "orders_archive", items: "order_items_archive"}
+)
+
+type SqliteOrderRepository struct {
+	txManager *TxManager
+}
+
+func NewSqliteOrderRepository(txManager *TxManager) *SqliteOrderRepository {
+	return &SqliteOrderRepository{txManager: txManager}
+}
+
+func NewFacade(db *sql.DB) usecase.OrderRepoFacade {
+	return NewSqliteOrderRepository(NewTxManager(db))
+}
+
+func (r *SqliteOrderRepository) AddOrder(ctx context.Context, orderDTO dto.OrderDTO) error {
+	const (
+		op = "SqliteOrderRepository.AddOrder"
+
+		// archived order id is taken for good, orders_archive is not covered by orders primary key
+		sqlArchivedQuery = `select exists(select 1 from orders_archive where order_id = ?)`
+
+		sqlQuery = `insert into orders(order_id, client_id, store_until, status, cost, weight, packages)
+		values (?, ?, ?, ?, ?, ?, ?)`
+
+		sqlItemQuery = `insert into order_items(order_id, sku, name, quantity, unit_price, status)
+		values (?, ?, ?, ?, ?, ?)`
+	)
+
+	packages, err := json.Marshal(orderDTO.Packages)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = r.txManager.RunSerializable(ctx, TxModeReadWrite, func(ctxTx context.Context) error {
+		tx := r.txManager.GetQueryEngine(ctxTx)
+
+		var archived bool
+		if err := tx.QueryRowContext(ctxTx, sqlArchivedQuery, orderDTO.ID).Scan(&archived); err != nil {
+			return err
+		}
+
+		if archived {
+			return ErrAlreadyExist
+		}
+
+		_, err := tx.ExecContext(ctxTx, sqlQuery,
+			orderDTO.ID,
+			orderDTO.ClientID,
+			orderDTO.StoreUntil,
+			orderDTO.Status,
+			orderDTO.Cost,
+			orderDTO.Weight,
+			string(packages),
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range orderDTO.Items {
+			_, err := tx.ExecContext(ctxTx, sqlItemQuery,
+				orderDTO.ID, item.SKU, item.Name, item.Quantity, item.UnitPrice, item.Status)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if isPrimaryKeyViolation(err) {
+		err = ErrAlreadyExist
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *SqliteOrderRepository) UpdateOrder(ctx context.Context, orderDTO dto.OrderDTO) error {
+	const op = "SqliteOrderRepository.UpdateOrder"
+
+	err := r.txManager.RunSerializable(ctx, TxModeReadWrite, func(ctxTx context.Context) error {
+		return r.updateOrder(ctxTx, orderDTO)
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *SqliteOrderRepository) UpdateOrders(ctx context.Context, ordersDTO []dto.OrderDTO) error {
+	const op = "SqliteOrderRepository.UpdateOrders"
+
+	err := r.txManager.RunSerializable(ctx, TxModeReadWrite, func(ctxTx context.Context) error {
+		for _, orderDTO := range ordersDTO {
+			if err := r.updateOrder(ctxTx, orderDTO); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *SqliteOrderRepository) GetOrderByID(ctx context.Context, id int64) (*dto.OrderDTO, error) {
+	const op = "SqliteOrderRepository.GetOrderByID"
+
+	orders, err := r.selectOrders(ctx, "where order_id = ?", id)
+	if err == nil && len(orders) == 0 {
+		orders, err = r.selectFrom(ctx, archiveTables, "where order_id = ?", id)
+		for i := range orders {
+			orders[i].Archived = true
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(orders) == 0 {
+		return nil, fmt.Errorf("%s: %w", op, ErrOrderNotFound)
+	}
+
+	return &orders[0], nil
+}
+
+func (r *SqliteOrderRepository) GetOrdersByIDs(ctx context.Context, ids []int64) (*dto.ListOrdersDTO, error) {
+	const op = "SqliteOrderRepository.GetOrdersByIDs"
+
+	idsJSON, err := json.Marshal(ids)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	orders, err := r.selectOrders(ctx, "where order_id in (select value from json_each(?)) order by order_id", string(idsJSON))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &dto.ListOrdersDTO{Orders: orders}, nil
+}
+
+func (r *SqliteOrderRepository) GetClientOrdersList(ctx context.Context, clientID int) (*dto.ListOrdersDTO, error) {
+	const op = "SqliteOrderRepository.GetClientOrdersList"
+
+	orders, err := r.selectOrders(ctx, "where client_id = ? and status = ? order by order_id",
+		clientID, domain.OrderStatusMap[domain.OrderStatusReceived])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &dto.ListOrdersDTO{Orders: orders}, nil
+}
+
+func (r *SqliteOrderRepository) GetRefundsList(ctx context.Context, statuses []string, limit, offset int) (*dto.ListOrdersDTO, error) {
+	return r.ListOrdersByStatus(ctx, statuses, limit, offset)
+}
+
+// ListOrdersByStatus lists live orders in statuses ordered by id, zero limit and offset do not bound the list
+func (r *SqliteOrderRepository) ListOrdersByStatus(ctx context.Context, statuses []string, limit, offset int) (*dto.ListOrdersDTO, error) {
+	const op = "SqliteOrderRepository.ListOrdersByStatus"
+
+	statusesJSON, err := json.Marshal(statuses)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// sqlite requires limit before offset, -1 means no limit
+	if limit <= 0 {
+		limit = -1
+	}
+
+	orders, err := r.selectOrders(ctx,
+		"where status in (select value from json_each(?)) order by order_id limit ? offset ?",
+		string(statusesJSON), limit, max(offset, 0))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &dto.ListOrdersDTO{Orders: orders}, nil
+}
+
+func (r *SqliteOrderRepository) HandOverOrders(ctx context.Context, handoverDTO dto.HandoverDTO) (int64, error) {
+	const (
+		op = "SqliteOrderRepository.HandOverOrders"
+
+		sqlHandoverQuery = `insert into handovers(courier, handed_over_at) values (?, ?)`
+
+		sqlHandoverOrderQuery = `insert into handover_orders(handover_id, order_id) values (?, ?)`
+	)
+
+	var handoverID int64
+
+	err := r.txManager.RunSerializable(ctx, TxModeReadWrite, func(ctxTx context.Context) error {
+		tx := r.txManager.GetQueryEngine(ctxTx)
+
+		res, err := tx.ExecContext(ctxTx, sqlHandoverQuery, handoverDTO.Courier, handoverDTO.HandedOverAt)
+		if err != nil {
+			return err
+		}
+
+		id, err := res.LastInsertId()
+		if err != nil {
+			return err
+		}
+
+		for _, orderDTO := range handoverDTO.Orders {
+			if _, err := tx.ExecContext(ctxTx, sqlHandoverOrderQuery, id, orderDTO.ID); err != nil {
+				return err
+			}
+
+			if err := r.updateOrder(ctxTx, orderDTO); err != nil {
+				return err
+			}
+		}
+
+		handoverID = id
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return handoverID, nil
+}
+
+// ArchiveOrders moves a batch of matching orders with their items into archive tables
+// and returns ids of moved orders. Orders holding refused items not yet returned to courier stay
+func (r *SqliteOrderRepository) ArchiveOrders(ctx context.Context, archiveDTO dto.ArchiveOrdersDTO) ([]int64, error) {
+	const (
+		op = "SqliteOrderRepository.ArchiveOrders"
+
+		sqlSelectQuery = `select order_id from orders
+		where status in (select value from json_each(?)) and julianday(coalesce(pick_up_time, store_until)) < julianday(?)
+		and not exists (select 1 from order_items i where i.order_id = orders.order_id and i.status = 'refused')
+		order by order_id
+		limit ?`
+
+		sqlArchiveItemsQuery = `insert into order_items_archive(order_id, sku, name, quantity, unit_price, status)
+		select order_id, sku, name, quantity, unit_price, status from order_items
+		where order_id in (select value from json_each(?))`
+
+		sqlDeleteItemsQuery = `delete from order_items where order_id in (select value from json_each(?))`
+
+		sqlArchiveQuery = `insert into orders_archive(` + orderColumns + `, archived_at)
+		select ` + orderColumns + `, ? from orders where order_id in (select value from json_each(?))`
+
+		sqlDeleteQuery = `delete from orders where order_id in (select value from json_each(?))`
+	)
+
+	statusesJSON, err := json.Marshal(archiveDTO.Statuses)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var archived []int64
+
+	err = r.txManager.RunSerializable(ctx, TxModeReadWrite, func(ctxTx context.Context) error {
+		tx := r.txManager.GetQueryEngine(ctxTx)
+
+		rows, err := tx.QueryContext(ctxTx, sqlSelectQuery, string(statusesJSON), archiveDTO.Before, archiveDTO.Limit)
+		if err != nil {
+			return err
+		}
+
+		var ids []int64
+		for rows.Next() {
+			var id int64
+			if err := rows.Scan(&id); err != nil {
+				rows.Close()
+				return err
+			}
+			ids = append(ids, id)
+		}
+		rows.Close()
+
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		if len(ids) == 0 {
+			return nil
+		}
+
+		idsJSON, err := json.Marshal(ids)
+		if err != nil {
+			return err
+		}
+
+		for _, query := range []string{sqlArchiveItemsQuery, sqlDeleteItemsQuery} {
+			if _, err := tx.ExecContext(ctxTx, query, string(idsJSON)); err != nil {
+				return err
+			}
+		}
+
+		if _, err := tx.ExecContext(ctxTx, sqlArchiveQuery, archiveDTO.ArchivedAt, string(idsJSON)); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctxTx, sqlDeleteQuery, string(idsJSON)); err != nil {
+			return err
+		}
+
+		archived = ids
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return archived, nil
+}
+
+func (r *SqliteOrderRepository) updateOrder(ctx context.Context, orderDTO dto.OrderDTO) error {
+	const (
+		sqlQuery = `update orders
+		set status = ?, pick_up_time = ?,
+			refund_reason = ?, refund_comment = ?, inspection_condition = ?, inspection_outcome = ?,
+			version = version + 1
+		where order_id = ? and version = ?`
+
+		sqlItemQuery = `update order_items set status = ? where order_id = ? and sku = ?`
+	)
+
+	tx := r.txManager.GetQueryEngine(ctx)
+
+	res, err := tx.ExecContext(ctx, sqlQuery,
+		orderDTO.Status,
+		orderDTO.PickUpTime,
+		orderDTO.RefundReason,
+		orderDTO.RefundComment,
+		orderDTO.InspectionCondition,
+		orderDTO.InspectionOutcome,
+		orderDTO.ID,
+		orderDTO.Version,
+	)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return domain.ErrConcurrentModification
+	}
+
+	for _, item := range orderDTO.Items {
+		if _, err := tx.ExecContext(ctx, sqlItemQuery, item.Status, orderDTO.ID, item.SKU); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *SqliteOrderRepository) selectOrders(ctx context.Context, where string, args ...any) ([]dto.OrderDTO, error) {
+	return r.selectFrom(ctx, liveTables, where, args...)
+}
+
+func (r *SqliteOrderRepository) selectFrom(ctx context.Context, tables orderTables, where string, args ...any) ([]dto.OrderDTO, error) {
+	var orders []dto.OrderDTO
+
+	err := r.txManager.RunReadCommitted(ctx, TxModeReadOnly, func(ctxTx context.Context) error {
+		tx := r.txManager.GetQueryEngine(ctxTx)
+
+		rows, err := tx.QueryContext(ctxTx, "select "+orderColumns+" from "+tables.orders+" "+where, args...)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			order, err := scanOrder(rows)
+			if err != nil {
+				return err
+			}
+
+			orders = append(orders, order)
+		}
+
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		return r.attachOrderItems(ctxTx, tables.items, orders)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}
+
+func (r *SqliteOrderRepository) attachOrderItems(ctx context.Context, itemsTable string, orders []dto.OrderDTO) error {
+	sqlQuery := `select order_id, sku, name, quantity, unit_price, status
+	from ` + itemsTable + ` where order_id in (select value from json_each(?)) order by order_id, sku`
+
+	if len(orders) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(orders))
+	for _, order := range orders {
+		ids = append(ids, order.ID)
+	}
+
+	idsJSON, err := json.Marshal(ids)
+	if err != nil {
+		return err
+	}
+
+	tx := r.txManager.GetQueryEngine(ctx)
+
+	rows, err := tx.QueryContext(ctx, sqlQuery, string(idsJSON))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	itemsByOrder := make(map[int64][]dto.OrderItemDTO, len(orders))
+	for rows.Next() {
+		var item dto.OrderItemDTO
+
+		err := rows.Scan(&item.OrderID, &item.SKU, &item.Name, &item.Quantity, &item.UnitPrice, &item.Status)
+		if err != nil {
+			return err
+		}
+
+		itemsByOrder[item.OrderID] = append(itemsByOrder[item.OrderID], item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for i := range orders {
+		orders[i].Items = itemsByOrder[orders[i].ID]
+	}
+
+	return nil
+}
+
+func scanOrder(rows *sql.Rows) (dto.OrderDTO, error) {
+	var (
+		order    dto.OrderDTO
+		packages sql.NullString
+	)
+
+	err := rows.Scan(
+		&order.ID,
+		&order.ClientID,
+		&order.StoreUntil,
+		&order.Status,
+		&order.Cost,
+		&order.Weight,
+		&packages,
+		&order.PickUpTime,
+		&order.RefundReason,
+		&order.RefundComment,
+		&order.InspectionCondition,
+		&order.InspectionOutcome,
+		&order.Version,
+	)
+	if err != nil {
+		return order, err
+	}
+
+	if packages.Valid && strings.TrimSpace(packages.String) != "" {
+		if err := json.Unmarshal([]byte(packages.String), &order.Packages); err != nil {
+			return order, err
+		}
+	}
+
+	return order, nil
+}
+
+func isPrimaryKeyViolation(err error) bool {
+	var sqliteErr *sqlite.Error
+	if !errors.As(err, &sqliteErr) {
+		return false
+	}
+
+	return sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY
+}
@@ -0,0 +1,83 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+// Transaction access mode
+type TxMode int
+
+const (
+	TxModeReadOnly TxMode = iota
+	TxModeReadWrite
+)
+
+type txManagerKey struct{}
+
+type txState struct {
+	tx   *sql.Tx
+	mode TxMode
+}
+
+// TxManager runs sqlite transactions. Sqlite has a single writer and
+// isolates transactions as serializable whatever level is requested
+type TxManager struct {
+	db *sql.DB
+}
+
+type txFn = func(ctxTx context.Context) error
+
+func NewTxManager(db *sql.DB) *TxManager {
+	return &TxManager{db: db}
+}
+
+func (m *TxManager) RunSerializable(ctx context.Context, mode TxMode, fn txFn) error {
+	return m.run(ctx, mode, fn)
+}
+
+func (m *TxManager) RunReadCommitted(ctx context.Context, mode TxMode, fn txFn) error {
+	return m.run(ctx, mode, fn)
+}
+
+func (m *TxManager) run(ctx context.Context, mode TxMode, fn txFn) (err error) {
+	if state, ok := ctx.Value(txManagerKey{}).(*txState); ok {
+		if mode == TxModeReadWrite && state.mode == TxModeReadOnly {
+			return ErrReadOnlyTx
+		}
+
+		return fn(ctx)
+	}
+
+	tx, err := m.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: mode == TxModeReadOnly})
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err == nil {
+			return
+		}
+
+		if rbErr := tx.Rollback(); rbErr != nil && !errors.Is(rbErr, sql.ErrTxDone) {
+			err = errors.Join(err, rbErr)
+		}
+	}()
+
+	ctxTx := context.WithValue(ctx, txManagerKey{}, &txState{tx: tx, mode: mode})
+	if err := fn(ctxTx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (m *TxManager) GetQueryEngine(ctx context.Context) QueryEngine {
+	state, ok := ctx.Value(txManagerKey{}).(*txState)
+	if ok && state != nil {
+		return state.tx
+	}
+
+	return m.db
+}
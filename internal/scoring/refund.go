@@ -0,0 +1,251 @@
+package scoring
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Na322Pr/route256/internal/clock"
+	"github.com/Na322Pr/route256/internal/config"
+	"github.com/Na322Pr/route256/internal/dto"
+)
+
+const (
+	ReasonRefundRatio      = "refund_ratio"
+	ReasonRefundFrequency  = "refund_frequency"
+	ReasonHighValueRefunds = "high_value_refunds"
+)
+
+type refundRecord struct {
+	at   time.Time
+	cost int
+}
+
+type clientActivity struct {
+	issues  []time.Time
+	refunds []refundRecord
+}
+
+// RefundScorer keeps per-client issue and refund history within a sliding
+// window and flags clients whose refund behaviour exceeds configured limits.
+// Clients without activity in the window are dropped, and at most cfg.MaxClients
+// are kept, so history is bounded by the window but is not shared between replicas
+type RefundScorer struct {
+	cfg  config.RefundScoring
+	lock sync.RWMutex
+	data map[int]*clientActivity
+}
+
+func NewRefundScorer(cfg config.RefundScoring) *RefundScorer {
+	return &RefundScorer{
+		cfg:  cfg,
+		data: make(map[int]*clientActivity),
+	}
+}
+
+func (s *RefundScorer) RecordIssue(clientID int, now time.Time) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	activity := s.activity(clientID, now)
+	activity.issues = append(activity.issues, now)
+	s.prune(activity, now)
+}
+
+func (s *RefundScorer) RecordRefund(clientID int, cost int, now time.Time) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	activity := s.activity(clientID, now)
+	activity.refunds = append(activity.refunds, refundRecord{at: now, cost: cost})
+	s.prune(activity, now)
+}
+
+func (s *RefundScorer) Score(clientID int, now time.Time) dto.ClientRefundScoreDTO {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	activity, ok := s.data[clientID]
+	if !ok {
+		return dto.ClientRefundScoreDTO{ClientID: clientID}
+	}
+
+	return s.score(clientID, activity, now)
+}
+
+func (s *RefundScorer) RequireApproval(clientID int, now time.Time) bool {
+	return s.cfg.RequireApproval && s.Score(clientID, now).Flagged
+}
+
+func (s *RefundScorer) FlaggedClients(now time.Time) []dto.ClientRefundScoreDTO {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	flagged := make([]dto.ClientRefundScoreDTO, 0)
+
+	for clientID, activity := range s.data {
+		score := s.score(clientID, activity, now)
+		if score.Flagged {
+			flagged = append(flagged, score)
+		}
+	}
+
+	sort.Slice(flagged, func(i, j int) bool {
+		return flagged[i].ClientID < flagged[j].ClientID
+	})
+
+	return flagged
+}
+
+// DeleteInactive drops clients without issues and refunds in the window and returns their number
+func (s *RefundScorer) DeleteInactive(now time.Time) int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.deleteInactive(now)
+}
+
+// RunCleanup deletes inactive clients every interval until ctx is done
+func (s *RefundScorer) RunCleanup(ctx context.Context, interval time.Duration, clk clock.Clock) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.DeleteInactive(clk.Now())
+		}
+	}
+}
+
+func (s *RefundScorer) Len() int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return len(s.data)
+}
+
+// activity makes room for a new client by dropping inactive ones first
+// and the least recently active one when all are active
+func (s *RefundScorer) activity(clientID int, now time.Time) *clientActivity {
+	activity, ok := s.data[clientID]
+	if ok {
+		return activity
+	}
+
+	if s.cfg.MaxClients > 0 && len(s.data) >= s.cfg.MaxClients {
+		if s.deleteInactive(now) == 0 {
+			s.deleteLeastActive()
+		}
+	}
+
+	activity = &clientActivity{}
+	s.data[clientID] = activity
+
+	return activity
+}
+
+func (s *RefundScorer) deleteInactive(now time.Time) int {
+	n := 0
+	for clientID, activity := range s.data {
+		s.prune(activity, now)
+		if len(activity.issues) == 0 && len(activity.refunds) == 0 {
+			delete(s.data, clientID)
+			n++
+		}
+	}
+
+	return n
+}
+
+func (s *RefundScorer) deleteLeastActive() {
+	var (
+		oldestID int
+		oldestAt time.Time
+		found    bool
+	)
+
+	for clientID, activity := range s.data {
+		lastAt := activity.lastAt()
+		if !found || lastAt.Before(oldestAt) {
+			oldestID, oldestAt, found = clientID, lastAt, true
+		}
+	}
+
+	if found {
+		delete(s.data, oldestID)
+	}
+}
+
+// lastAt is the time of the latest record, records are appended in time order
+func (a *clientActivity) lastAt() time.Time {
+	var last time.Time
+	if n := len(a.issues); n > 0 {
+		last = a.issues[n-1]
+	}
+	if n := len(a.refunds); n > 0 && a.refunds[n-1].at.After(last) {
+		last = a.refunds[n-1].at
+	}
+
+	return last
+}
+
+func (s *RefundScorer) prune(activity *clientActivity, now time.Time) {
+	from := now.Add(-s.cfg.Window)
+
+	i := 0
+	for i < len(activity.issues) && activity.issues[i].Before(from) {
+		i++
+	}
+	activity.issues = activity.issues[i:]
+
+	j := 0
+	for j < len(activity.refunds) && activity.refunds[j].at.Before(from) {
+		j++
+	}
+	activity.refunds = activity.refunds[j:]
+}
+
+func (s *RefundScorer) score(clientID int, activity *clientActivity, now time.Time) dto.ClientRefundScoreDTO {
+	from := now.Add(-s.cfg.Window)
+	score := dto.ClientRefundScoreDTO{ClientID: clientID}
+
+	for _, issuedAt := range activity.issues {
+		if !issuedAt.Before(from) {
+			score.Issued++
+		}
+	}
+
+	for _, refund := range activity.refunds {
+		if refund.at.Before(from) {
+			continue
+		}
+
+		score.Refunds++
+		if s.cfg.HighValueCost > 0 && refund.cost >= s.cfg.HighValueCost {
+			score.HighValueRefunds++
+		}
+	}
+
+	if score.Issued > 0 {
+		score.RefundRatio = float64(score.Refunds) / float64(score.Issued)
+	}
+
+	if s.cfg.MaxRefundRatio > 0 && score.Issued >= s.cfg.MinIssued && score.RefundRatio > s.cfg.MaxRefundRatio {
+		score.Reasons = append(score.Reasons, ReasonRefundRatio)
+	}
+
+	if s.cfg.MaxRefunds > 0 && score.Refunds > s.cfg.MaxRefunds {
+		score.Reasons = append(score.Reasons, ReasonRefundFrequency)
+	}
+
+	if s.cfg.MaxHighValueRefunds > 0 && score.HighValueRefunds > s.cfg.MaxHighValueRefunds {
+		score.Reasons = append(score.Reasons, ReasonHighValueRefunds)
+	}
+
+	score.Flagged = len(score.Reasons) > 0
+	return score
+}
@@ -0,0 +1,150 @@
+package scoring
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Na322Pr/route256/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefundScorer_Score(t *testing.T) {
+	cfg := config.RefundScoring{
+		Window:              24 * time.Hour,
+		MinIssued:           2,
+		MaxRefundRatio:      0.5,
+		MaxRefunds:          3,
+		HighValueCost:       1000,
+		MaxHighValueRefunds: 1,
+		RequireApproval:     true,
+	}
+
+	now := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		setup       func(*RefundScorer)
+		wantFlagged bool
+		wantReasons []string
+	}{
+		{
+			name: "NotFlagged",
+			setup: func(s *RefundScorer) {
+				s.RecordIssue(1, now)
+				s.RecordIssue(1, now)
+				s.RecordRefund(1, 100, now)
+			},
+			wantFlagged: false,
+		},
+		{
+			name: "FlaggedRefundRatio",
+			setup: func(s *RefundScorer) {
+				s.RecordIssue(1, now)
+				s.RecordIssue(1, now)
+				s.RecordRefund(1, 100, now)
+				s.RecordRefund(1, 100, now)
+			},
+			wantFlagged: true,
+			wantReasons: []string{ReasonRefundRatio},
+		},
+		{
+			name: "FlaggedRefundFrequency",
+			setup: func(s *RefundScorer) {
+				for i := 0; i < 4; i++ {
+					s.RecordRefund(1, 100, now)
+				}
+			},
+			wantFlagged: true,
+			wantReasons: []string{ReasonRefundFrequency},
+		},
+		{
+			name: "FlaggedHighValueRefunds",
+			setup: func(s *RefundScorer) {
+				s.RecordRefund(1, 1000, now)
+				s.RecordRefund(1, 5000, now)
+			},
+			wantFlagged: true,
+			wantReasons: []string{ReasonHighValueRefunds},
+		},
+		{
+			name: "OutOfWindowIgnored",
+			setup: func(s *RefundScorer) {
+				old := now.Add(-48 * time.Hour)
+				for i := 0; i < 4; i++ {
+					s.RecordRefund(1, 5000, old)
+				}
+			},
+			wantFlagged: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := NewRefundScorer(cfg)
+			tt.setup(s)
+
+			score := s.Score(1, now)
+			assert.Equal(t, tt.wantFlagged, score.Flagged)
+			assert.Equal(t, tt.wantReasons, score.Reasons)
+			assert.Equal(t, tt.wantFlagged, s.RequireApproval(1, now))
+		})
+	}
+}
+
+func TestRefundScorer_FlaggedClients(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	s := NewRefundScorer(config.RefundScoring{
+		Window:     time.Hour,
+		MaxRefunds: 1,
+	})
+
+	s.RecordRefund(2, 100, now)
+	s.RecordRefund(2, 100, now)
+	s.RecordRefund(1, 100, now)
+	s.RecordRefund(1, 100, now)
+	s.RecordRefund(3, 100, now)
+
+	flagged := s.FlaggedClients(now)
+	assert.Len(t, flagged, 2)
+	assert.Equal(t, 1, flagged[0].ClientID)
+	assert.Equal(t, 2, flagged[1].ClientID)
+	assert.False(t, s.RequireApproval(1, now))
+}
+
+func TestRefundScorer_DeleteInactive(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	s := NewRefundScorer(config.RefundScoring{Window: time.Hour})
+
+	s.RecordIssue(1, now.Add(-2*time.Hour))
+	s.RecordRefund(2, 100, now.Add(-2*time.Hour))
+	s.RecordIssue(2, now)
+
+	assert.Equal(t, 1, s.DeleteInactive(now))
+	assert.Equal(t, 1, s.Len())
+	assert.Equal(t, 1, s.Score(2, now).Issued)
+}
+
+func TestRefundScorer_MaxClients(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	s := NewRefundScorer(config.RefundScoring{Window: time.Hour, MaxClients: 2})
+
+	s.RecordIssue(1, now.Add(-2*time.Minute))
+	s.RecordIssue(2, now.Add(-time.Minute))
+	s.RecordIssue(3, now)
+
+	// all clients are active, so the least recently active one is dropped
+	assert.Equal(t, 2, s.Len())
+	assert.Zero(t, s.Score(1, now).Issued)
+	assert.Equal(t, 1, s.Score(2, now).Issued)
+
+	// inactive clients are dropped first
+	s.RecordIssue(4, now.Add(2*time.Hour-30*time.Second))
+	assert.Equal(t, 1, s.Len())
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Na322Pr/route256/internal/domain"
+	"github.com/Na322Pr/route256/internal/dto"
+)
+
+const (
+	defaultArchiveOlderThan = 30 * 24 * time.Hour
+	defaultArchiveBatchSize = 500
+)
+
+// archiveStatuses have no further transitions. Refunded orders still wait
+// to be returned to courier and picked up ones are archived long after refund time expires,
+// unless refused items of them are still held by the pick-up point
+var archiveStatuses = []string{
+	domain.OrderStatusMap[domain.OrderStatusPickedUp],
+	domain.OrderStatusMap[domain.OrderStatusDelete],
+	domain.OrderStatusMap[domain.OrderStatusReturnedToSeller],
+}
+
+type archivePolicy struct {
+	olderThan time.Duration
+	batchSize int
+}
+
+// ArchiveOrders moves completed orders older than archive policy allows into archive
+// batch by batch, each batch is recorded in audit log. Returns the number of archived orders
+func (uc *OrderUseCase) ArchiveOrders(ctx context.Context) (int, error) {
+	op := "OrderUseCase.ArchiveOrders"
+
+	now := uc.clock.Now()
+	archiveDTO := dto.ArchiveOrdersDTO{
+		Statuses:   archiveStatuses,
+		Before:     now.Add(-uc.archive.olderThan),
+		ArchivedAt: now,
+		Limit:      uc.archive.batchSize,
+	}
+
+	total := 0
+	for {
+		archived, err := uc.auditedBatch(ctx, op, func(ctxTx context.Context) ([]int64, error) {
+			return uc.repo.ArchiveOrders(ctxTx, archiveDTO)
+		})
+		if err != nil {
+			return total, fmt.Errorf("%s: %w", op, err)
+		}
+
+		total += len(archived)
+		if len(archived) < archiveDTO.Limit {
+			return total, nil
+		}
+
+		if err := ctx.Err(); err != nil {
+			return total, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+}
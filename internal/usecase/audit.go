@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Na322Pr/route256/internal/actor"
+	"github.com/Na322Pr/route256/internal/dto"
+)
+
+const (
+	// systemOperator is recorded for changes made outside of operator calls
+	systemOperator = "system"
+
+	defaultAuditLogLimit = 100
+)
+
+// audited runs write and records it in audit log within one transaction.
+// Before holds orders as read from storage, after holds orders as passed to storage.
+// Archived orders are read only, write is not run for them
+func (uc *OrderUseCase) audited(
+	ctx context.Context,
+	method string,
+	before, after []dto.OrderDTO,
+	write func(ctxTx context.Context) error,
+) error {
+	for _, order := range before {
+		if order.Archived {
+			return ErrOrderArchived
+		}
+	}
+
+	entry, err := newAuditEntry(ctx, method, uc.clock.Now(), before, after)
+	if err != nil {
+		return err
+	}
+
+	return uc.repo.WithinTx(ctx, func(ctxTx context.Context) error {
+		if err := write(ctxTx); err != nil {
+			return err
+		}
+
+		return uc.repo.AddAuditLog(ctxTx, entry)
+	})
+}
+
+// auditedBatch runs write picking orders by itself and records ids it returns in audit log
+// within one transaction, states of the orders are not recorded. Empty batch is not recorded
+func (uc *OrderUseCase) auditedBatch(
+	ctx context.Context,
+	method string,
+	write func(ctxTx context.Context) ([]int64, error),
+) ([]int64, error) {
+	entry, err := newAuditEntry(ctx, method, uc.clock.Now(), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []int64
+	err = uc.repo.WithinTx(ctx, func(ctxTx context.Context) (err error) {
+		if ids, err = write(ctxTx); err != nil || len(ids) == 0 {
+			return err
+		}
+
+		entry.OrderIDs = ids
+		return uc.repo.AddAuditLog(ctxTx, entry)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// updateOrder saves single order changed from before state
+func (uc *OrderUseCase) updateOrder(ctx context.Context, method string, before dto.OrderDTO, after dto.OrderDTO) error {
+	return uc.audited(ctx, method, []dto.OrderDTO{before}, []dto.OrderDTO{after}, func(ctxTx context.Context) error {
+		return uc.repo.UpdateOrder(ctxTx, after)
+	})
+}
+
+func newAuditEntry(ctx context.Context, method string, now time.Time, before, after []dto.OrderDTO) (dto.AuditLogDTO, error) {
+	entry := dto.AuditLogDTO{
+		OperatorID: systemOperator,
+		Method:     method,
+		CreatedAt:  now,
+	}
+
+	if a, ok := actor.FromContext(ctx); ok {
+		entry.OperatorID = a.OperatorID
+		entry.Method = a.Method
+		entry.ClientIP = a.ClientIP
+	}
+
+	if before == nil {
+		before = []dto.OrderDTO{}
+	}
+
+	if after == nil {
+		after = []dto.OrderDTO{}
+	}
+
+	entry.OrderIDs = make([]int64, 0, len(after))
+	for _, order := range after {
+		entry.OrderIDs = append(entry.OrderIDs, order.ID)
+	}
+
+	var err error
+	if entry.Before, err = json.Marshal(before); err != nil {
+		return dto.AuditLogDTO{}, err
+	}
+
+	if entry.After, err = json.Marshal(after); err != nil {
+		return dto.AuditLogDTO{}, err
+	}
+
+	return entry, nil
+}
+
+// QueryAuditLog returns audit log entries matching filter, newest first
+func (uc *OrderUseCase) QueryAuditLog(ctx context.Context, filter dto.AuditLogFilterDTO) (*dto.ListAuditLogDTO, error) {
+	op := "OrderUseCase.QueryAuditLog"
+
+	if filter.Limit <= 0 {
+		filter.Limit = defaultAuditLogLimit
+	}
+
+	entries, err := uc.repo.QueryAuditLog(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"errors"
+
+	"github.com/Na322Pr/route256/internal/domain"
+)
+
+const maxConflictAttempts = 3
+
+// retryOnConflict reruns fn while the order update loses the version race.
+// Repository drops stale copies of the order on failed update, so the next attempt reads it anew
+func retryOnConflict(fn func() error) error {
+	var err error
+
+	for attempt := 0; attempt < maxConflictAttempts; attempt++ {
+		err = fn()
+		if !errors.Is(err, domain.ErrConcurrentModification) {
+			return err
+		}
+	}
+
+	return err
+}
@@ -4,9 +4,23 @@ import "errors"
 
 var (
 	ErrOrderPickedUp            = errors.New("order picked up")
+	ErrOrderNotReceived         = errors.New("order is not received")
 	ErrOrderDeleted             = errors.New("order deleted")
 	ErrOrderStoreTimeNotExpired = errors.New("order store time not expired")
+	ErrOrderAwaitingReturn      = errors.New("refunded order must be returned to seller with refunds handover")
+	ErrOrderReturnedToSeller    = errors.New("order returned to seller")
+	ErrOrderStatusChanged       = errors.New("order status changed concurrently")
+	ErrOrderArchived            = errors.New("order is archived")
 
 	ErrOrderClientMismatch  = errors.New("order client mismatch")
 	ErrOrderIsNotRefundable = errors.New("order is non-refundable")
+
+	ErrOrderPendingApproval    = errors.New("order refund pending approval")
+	ErrOrderNotPendingApproval = errors.New("order is not pending approval")
+
+	ErrOrderNotAwaitingReturn = errors.New("order is not awaiting return")
+	ErrInvalidRefundsFilter   = errors.New("invalid refunds filter")
+
+	ErrOrderStoreTimeExpired = errors.New("order store time expired")
+	ErrCheckoutOrdersOverlap = errors.New("order is both kept and refused")
 )
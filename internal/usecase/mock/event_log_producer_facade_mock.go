@@ -9,9 +9,9 @@ import (
 	mm_atomic "sync/atomic"
 	mm_time "time"
 
-	"github.com/gojuno/minimock/v3"
 	"github.com/Na322Pr/route256/internal/dto"
 	"github.com/Na322Pr/route256/internal/kafka/event"
+	"github.com/gojuno/minimock/v3"
 )
 
 // EventLogProducerFacadeMock implements mm_usecase.EventLogProducerFacade
@@ -25,6 +25,13 @@ type EventLogProducerFacadeMock struct {
 	afterProduceEventCounter  uint64
 	beforeProduceEventCounter uint64
 	ProduceEventMock          mEventLogProducerFacadeMockProduceEvent
+
+	funcProduceItemEvent          func(order dto.OrderDTO, item dto.OrderItemDTO, eventType event.EventType) (err error)
+	funcProduceItemEventOrigin    string
+	inspectFuncProduceItemEvent   func(order dto.OrderDTO, item dto.OrderItemDTO, eventType event.EventType)
+	afterProduceItemEventCounter  uint64
+	beforeProduceItemEventCounter uint64
+	ProduceItemEventMock          mEventLogProducerFacadeMockProduceItemEvent
 }
 
 // NewEventLogProducerFacadeMock returns a mock for mm_usecase.EventLogProducerFacade
@@ -38,6 +45,9 @@ func NewEventLogProducerFacadeMock(t minimock.Tester) *EventLogProducerFacadeMoc
 	m.ProduceEventMock = mEventLogProducerFacadeMockProduceEvent{mock: m}
 	m.ProduceEventMock.callArgs = []*EventLogProducerFacadeMockProduceEventParams{}
 
+	m.ProduceItemEventMock = mEventLogProducerFacadeMockProduceItemEvent{mock: m}
+	m.ProduceItemEventMock.callArgs = []*EventLogProducerFacadeMockProduceItemEventParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -385,11 +395,386 @@ func (m *EventLogProducerFacadeMock) MinimockProduceEventInspect() {
 	}
 }
 
+type mEventLogProducerFacadeMockProduceItemEvent struct {
+	optional           bool
+	mock               *EventLogProducerFacadeMock
+	defaultExpectation *EventLogProducerFacadeMockProduceItemEventExpectation
+	expectations       []*EventLogProducerFacadeMockProduceItemEventExpectation
+
+	callArgs []*EventLogProducerFacadeMockProduceItemEventParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventLogProducerFacadeMockProduceItemEventExpectation specifies expectation struct of the EventLogProducerFacade.ProduceItemEvent
+type EventLogProducerFacadeMockProduceItemEventExpectation struct {
+	mock               *EventLogProducerFacadeMock
+	params             *EventLogProducerFacadeMockProduceItemEventParams
+	paramPtrs          *EventLogProducerFacadeMockProduceItemEventParamPtrs
+	expectationOrigins EventLogProducerFacadeMockProduceItemEventExpectationOrigins
+	results            *EventLogProducerFacadeMockProduceItemEventResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventLogProducerFacadeMockProduceItemEventParams contains parameters of the EventLogProducerFacade.ProduceItemEvent
+type EventLogProducerFacadeMockProduceItemEventParams struct {
+	order     dto.OrderDTO
+	item      dto.OrderItemDTO
+	eventType event.EventType
+}
+
+// EventLogProducerFacadeMockProduceItemEventParamPtrs contains pointers to parameters of the EventLogProducerFacade.ProduceItemEvent
+type EventLogProducerFacadeMockProduceItemEventParamPtrs struct {
+	order     *dto.OrderDTO
+	item      *dto.OrderItemDTO
+	eventType *event.EventType
+}
+
+// EventLogProducerFacadeMockProduceItemEventResults contains results of the EventLogProducerFacade.ProduceItemEvent
+type EventLogProducerFacadeMockProduceItemEventResults struct {
+	err error
+}
+
+// EventLogProducerFacadeMockProduceItemEventOrigins contains origins of expectations of the EventLogProducerFacade.ProduceItemEvent
+type EventLogProducerFacadeMockProduceItemEventExpectationOrigins struct {
+	origin          string
+	originOrder     string
+	originItem      string
+	originEventType string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmProduceItemEvent *mEventLogProducerFacadeMockProduceItemEvent) Optional() *mEventLogProducerFacadeMockProduceItemEvent {
+	mmProduceItemEvent.optional = true
+	return mmProduceItemEvent
+}
+
+// Expect sets up expected params for EventLogProducerFacade.ProduceItemEvent
+func (mmProduceItemEvent *mEventLogProducerFacadeMockProduceItemEvent) Expect(order dto.OrderDTO, item dto.OrderItemDTO, eventType event.EventType) *mEventLogProducerFacadeMockProduceItemEvent {
+	if mmProduceItemEvent.mock.funcProduceItemEvent != nil {
+		mmProduceItemEvent.mock.t.Fatalf("EventLogProducerFacadeMock.ProduceItemEvent mock is already set by Set")
+	}
+
+	if mmProduceItemEvent.defaultExpectation == nil {
+		mmProduceItemEvent.defaultExpectation = &EventLogProducerFacadeMockProduceItemEventExpectation{}
+	}
+
+	if mmProduceItemEvent.defaultExpectation.paramPtrs != nil {
+		mmProduceItemEvent.mock.t.Fatalf("EventLogProducerFacadeMock.ProduceItemEvent mock is already set by ExpectParams functions")
+	}
+
+	mmProduceItemEvent.defaultExpectation.params = &EventLogProducerFacadeMockProduceItemEventParams{order, item, eventType}
+	mmProduceItemEvent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmProduceItemEvent.expectations {
+		if minimock.Equal(e.params, mmProduceItemEvent.defaultExpectation.params) {
+			mmProduceItemEvent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmProduceItemEvent.defaultExpectation.params)
+		}
+	}
+
+	return mmProduceItemEvent
+}
+
+// ExpectOrderParam1 sets up expected param order for EventLogProducerFacade.ProduceItemEvent
+func (mmProduceItemEvent *mEventLogProducerFacadeMockProduceItemEvent) ExpectOrderParam1(order dto.OrderDTO) *mEventLogProducerFacadeMockProduceItemEvent {
+	if mmProduceItemEvent.mock.funcProduceItemEvent != nil {
+		mmProduceItemEvent.mock.t.Fatalf("EventLogProducerFacadeMock.ProduceItemEvent mock is already set by Set")
+	}
+
+	if mmProduceItemEvent.defaultExpectation == nil {
+		mmProduceItemEvent.defaultExpectation = &EventLogProducerFacadeMockProduceItemEventExpectation{}
+	}
+
+	if mmProduceItemEvent.defaultExpectation.params != nil {
+		mmProduceItemEvent.mock.t.Fatalf("EventLogProducerFacadeMock.ProduceItemEvent mock is already set by Expect")
+	}
+
+	if mmProduceItemEvent.defaultExpectation.paramPtrs == nil {
+		mmProduceItemEvent.defaultExpectation.paramPtrs = &EventLogProducerFacadeMockProduceItemEventParamPtrs{}
+	}
+	mmProduceItemEvent.defaultExpectation.paramPtrs.order = &order
+	mmProduceItemEvent.defaultExpectation.expectationOrigins.originOrder = minimock.CallerInfo(1)
+
+	return mmProduceItemEvent
+}
+
+// ExpectItemParam2 sets up expected param item for EventLogProducerFacade.ProduceItemEvent
+func (mmProduceItemEvent *mEventLogProducerFacadeMockProduceItemEvent) ExpectItemParam2(item dto.OrderItemDTO) *mEventLogProducerFacadeMockProduceItemEvent {
+	if mmProduceItemEvent.mock.funcProduceItemEvent != nil {
+		mmProduceItemEvent.mock.t.Fatalf("EventLogProducerFacadeMock.ProduceItemEvent mock is already set by Set")
+	}
+
+	if mmProduceItemEvent.defaultExpectation == nil {
+		mmProduceItemEvent.defaultExpectation = &EventLogProducerFacadeMockProduceItemEventExpectation{}
+	}
+
+	if mmProduceItemEvent.defaultExpectation.params != nil {
+		mmProduceItemEvent.mock.t.Fatalf("EventLogProducerFacadeMock.ProduceItemEvent mock is already set by Expect")
+	}
+
+	if mmProduceItemEvent.defaultExpectation.paramPtrs == nil {
+		mmProduceItemEvent.defaultExpectation.paramPtrs = &EventLogProducerFacadeMockProduceItemEventParamPtrs{}
+	}
+	mmProduceItemEvent.defaultExpectation.paramPtrs.item = &item
+	mmProduceItemEvent.defaultExpectation.expectationOrigins.originItem = minimock.CallerInfo(1)
+
+	return mmProduceItemEvent
+}
+
+// ExpectEventTypeParam3 sets up expected param eventType for EventLogProducerFacade.ProduceItemEvent
+func (mmProduceItemEvent *mEventLogProducerFacadeMockProduceItemEvent) ExpectEventTypeParam3(eventType event.EventType) *mEventLogProducerFacadeMockProduceItemEvent {
+	if mmProduceItemEvent.mock.funcProduceItemEvent != nil {
+		mmProduceItemEvent.mock.t.Fatalf("EventLogProducerFacadeMock.ProduceItemEvent mock is already set by Set")
+	}
+
+	if mmProduceItemEvent.defaultExpectation == nil {
+		mmProduceItemEvent.defaultExpectation = &EventLogProducerFacadeMockProduceItemEventExpectation{}
+	}
+
+	if mmProduceItemEvent.defaultExpectation.params != nil {
+		mmProduceItemEvent.mock.t.Fatalf("EventLogProducerFacadeMock.ProduceItemEvent mock is already set by Expect")
+	}
+
+	if mmProduceItemEvent.defaultExpectation.paramPtrs == nil {
+		mmProduceItemEvent.defaultExpectation.paramPtrs = &EventLogProducerFacadeMockProduceItemEventParamPtrs{}
+	}
+	mmProduceItemEvent.defaultExpectation.paramPtrs.eventType = &eventType
+	mmProduceItemEvent.defaultExpectation.expectationOrigins.originEventType = minimock.CallerInfo(1)
+
+	return mmProduceItemEvent
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventLogProducerFacade.ProduceItemEvent
+func (mmProduceItemEvent *mEventLogProducerFacadeMockProduceItemEvent) Inspect(f func(order dto.OrderDTO, item dto.OrderItemDTO, eventType event.EventType)) *mEventLogProducerFacadeMockProduceItemEvent {
+	if mmProduceItemEvent.mock.inspectFuncProduceItemEvent != nil {
+		mmProduceItemEvent.mock.t.Fatalf("Inspect function is already set for EventLogProducerFacadeMock.ProduceItemEvent")
+	}
+
+	mmProduceItemEvent.mock.inspectFuncProduceItemEvent = f
+
+	return mmProduceItemEvent
+}
+
+// Return sets up results that will be returned by EventLogProducerFacade.ProduceItemEvent
+func (mmProduceItemEvent *mEventLogProducerFacadeMockProduceItemEvent) Return(err error) *EventLogProducerFacadeMock {
+	if mmProduceItemEvent.mock.funcProduceItemEvent != nil {
+		mmProduceItemEvent.mock.t.Fatalf("EventLogProducerFacadeMock.ProduceItemEvent mock is already set by Set")
+	}
+
+	if mmProduceItemEvent.defaultExpectation == nil {
+		mmProduceItemEvent.defaultExpectation = &EventLogProducerFacadeMockProduceItemEventExpectation{mock: mmProduceItemEvent.mock}
+	}
+	mmProduceItemEvent.defaultExpectation.results = &EventLogProducerFacadeMockProduceItemEventResults{err}
+	mmProduceItemEvent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmProduceItemEvent.mock
+}
+
+// Set uses given function f to mock the EventLogProducerFacade.ProduceItemEvent method
+func (mmProduceItemEvent *mEventLogProducerFacadeMockProduceItemEvent) Set(f func(order dto.OrderDTO, item dto.OrderItemDTO, eventType event.EventType) (err error)) *EventLogProducerFacadeMock {
+	if mmProduceItemEvent.defaultExpectation != nil {
+		mmProduceItemEvent.mock.t.Fatalf("Default expectation is already set for the EventLogProducerFacade.ProduceItemEvent method")
+	}
+
+	if len(mmProduceItemEvent.expectations) > 0 {
+		mmProduceItemEvent.mock.t.Fatalf("Some expectations are already set for the EventLogProducerFacade.ProduceItemEvent method")
+	}
+
+	mmProduceItemEvent.mock.funcProduceItemEvent = f
+	mmProduceItemEvent.mock.funcProduceItemEventOrigin = minimock.CallerInfo(1)
+	return mmProduceItemEvent.mock
+}
+
+// When sets expectation for the EventLogProducerFacade.ProduceItemEvent which will trigger the result defined by the following
+// Then helper
+func (mmProduceItemEvent *mEventLogProducerFacadeMockProduceItemEvent) When(order dto.OrderDTO, item dto.OrderItemDTO, eventType event.EventType) *EventLogProducerFacadeMockProduceItemEventExpectation {
+	if mmProduceItemEvent.mock.funcProduceItemEvent != nil {
+		mmProduceItemEvent.mock.t.Fatalf("EventLogProducerFacadeMock.ProduceItemEvent mock is already set by Set")
+	}
+
+	expectation := &EventLogProducerFacadeMockProduceItemEventExpectation{
+		mock:               mmProduceItemEvent.mock,
+		params:             &EventLogProducerFacadeMockProduceItemEventParams{order, item, eventType},
+		expectationOrigins: EventLogProducerFacadeMockProduceItemEventExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmProduceItemEvent.expectations = append(mmProduceItemEvent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventLogProducerFacade.ProduceItemEvent return parameters for the expectation previously defined by the When method
+func (e *EventLogProducerFacadeMockProduceItemEventExpectation) Then(err error) *EventLogProducerFacadeMock {
+	e.results = &EventLogProducerFacadeMockProduceItemEventResults{err}
+	return e.mock
+}
+
+// Times sets number of times EventLogProducerFacade.ProduceItemEvent should be invoked
+func (mmProduceItemEvent *mEventLogProducerFacadeMockProduceItemEvent) Times(n uint64) *mEventLogProducerFacadeMockProduceItemEvent {
+	if n == 0 {
+		mmProduceItemEvent.mock.t.Fatalf("Times of EventLogProducerFacadeMock.ProduceItemEvent mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmProduceItemEvent.expectedInvocations, n)
+	mmProduceItemEvent.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmProduceItemEvent
+}
+
+func (mmProduceItemEvent *mEventLogProducerFacadeMockProduceItemEvent) invocationsDone() bool {
+	if len(mmProduceItemEvent.expectations) == 0 && mmProduceItemEvent.defaultExpectation == nil && mmProduceItemEvent.mock.funcProduceItemEvent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmProduceItemEvent.mock.afterProduceItemEventCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmProduceItemEvent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ProduceItemEvent implements mm_usecase.EventLogProducerFacade
+func (mmProduceItemEvent *EventLogProducerFacadeMock) ProduceItemEvent(order dto.OrderDTO, item dto.OrderItemDTO, eventType event.EventType) (err error) {
+	mm_atomic.AddUint64(&mmProduceItemEvent.beforeProduceItemEventCounter, 1)
+	defer mm_atomic.AddUint64(&mmProduceItemEvent.afterProduceItemEventCounter, 1)
+
+	mmProduceItemEvent.t.Helper()
+
+	if mmProduceItemEvent.inspectFuncProduceItemEvent != nil {
+		mmProduceItemEvent.inspectFuncProduceItemEvent(order, item, eventType)
+	}
+
+	mm_params := EventLogProducerFacadeMockProduceItemEventParams{order, item, eventType}
+
+	// Record call args
+	mmProduceItemEvent.ProduceItemEventMock.mutex.Lock()
+	mmProduceItemEvent.ProduceItemEventMock.callArgs = append(mmProduceItemEvent.ProduceItemEventMock.callArgs, &mm_params)
+	mmProduceItemEvent.ProduceItemEventMock.mutex.Unlock()
+
+	for _, e := range mmProduceItemEvent.ProduceItemEventMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmProduceItemEvent.ProduceItemEventMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmProduceItemEvent.ProduceItemEventMock.defaultExpectation.Counter, 1)
+		mm_want := mmProduceItemEvent.ProduceItemEventMock.defaultExpectation.params
+		mm_want_ptrs := mmProduceItemEvent.ProduceItemEventMock.defaultExpectation.paramPtrs
+
+		mm_got := EventLogProducerFacadeMockProduceItemEventParams{order, item, eventType}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.order != nil && !minimock.Equal(*mm_want_ptrs.order, mm_got.order) {
+				mmProduceItemEvent.t.Errorf("EventLogProducerFacadeMock.ProduceItemEvent got unexpected parameter order, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmProduceItemEvent.ProduceItemEventMock.defaultExpectation.expectationOrigins.originOrder, *mm_want_ptrs.order, mm_got.order, minimock.Diff(*mm_want_ptrs.order, mm_got.order))
+			}
+
+			if mm_want_ptrs.item != nil && !minimock.Equal(*mm_want_ptrs.item, mm_got.item) {
+				mmProduceItemEvent.t.Errorf("EventLogProducerFacadeMock.ProduceItemEvent got unexpected parameter item, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmProduceItemEvent.ProduceItemEventMock.defaultExpectation.expectationOrigins.originItem, *mm_want_ptrs.item, mm_got.item, minimock.Diff(*mm_want_ptrs.item, mm_got.item))
+			}
+
+			if mm_want_ptrs.eventType != nil && !minimock.Equal(*mm_want_ptrs.eventType, mm_got.eventType) {
+				mmProduceItemEvent.t.Errorf("EventLogProducerFacadeMock.ProduceItemEvent got unexpected parameter eventType, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmProduceItemEvent.ProduceItemEventMock.defaultExpectation.expectationOrigins.originEventType, *mm_want_ptrs.eventType, mm_got.eventType, minimock.Diff(*mm_want_ptrs.eventType, mm_got.eventType))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmProduceItemEvent.t.Errorf("EventLogProducerFacadeMock.ProduceItemEvent got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmProduceItemEvent.ProduceItemEventMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmProduceItemEvent.ProduceItemEventMock.defaultExpectation.results
+		if mm_results == nil {
+			mmProduceItemEvent.t.Fatal("No results are set for the EventLogProducerFacadeMock.ProduceItemEvent")
+		}
+		return (*mm_results).err
+	}
+	if mmProduceItemEvent.funcProduceItemEvent != nil {
+		return mmProduceItemEvent.funcProduceItemEvent(order, item, eventType)
+	}
+	mmProduceItemEvent.t.Fatalf("Unexpected call to EventLogProducerFacadeMock.ProduceItemEvent. %v %v %v", order, item, eventType)
+	return
+}
+
+// ProduceItemEventAfterCounter returns a count of finished EventLogProducerFacadeMock.ProduceItemEvent invocations
+func (mmProduceItemEvent *EventLogProducerFacadeMock) ProduceItemEventAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmProduceItemEvent.afterProduceItemEventCounter)
+}
+
+// ProduceItemEventBeforeCounter returns a count of EventLogProducerFacadeMock.ProduceItemEvent invocations
+func (mmProduceItemEvent *EventLogProducerFacadeMock) ProduceItemEventBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmProduceItemEvent.beforeProduceItemEventCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventLogProducerFacadeMock.ProduceItemEvent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmProduceItemEvent *mEventLogProducerFacadeMockProduceItemEvent) Calls() []*EventLogProducerFacadeMockProduceItemEventParams {
+	mmProduceItemEvent.mutex.RLock()
+
+	argCopy := make([]*EventLogProducerFacadeMockProduceItemEventParams, len(mmProduceItemEvent.callArgs))
+	copy(argCopy, mmProduceItemEvent.callArgs)
+
+	mmProduceItemEvent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockProduceItemEventDone returns true if the count of the ProduceItemEvent invocations corresponds
+// the number of defined expectations
+func (m *EventLogProducerFacadeMock) MinimockProduceItemEventDone() bool {
+	if m.ProduceItemEventMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ProduceItemEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ProduceItemEventMock.invocationsDone()
+}
+
+// MinimockProduceItemEventInspect logs each unmet expectation
+func (m *EventLogProducerFacadeMock) MinimockProduceItemEventInspect() {
+	for _, e := range m.ProduceItemEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventLogProducerFacadeMock.ProduceItemEvent at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterProduceItemEventCounter := mm_atomic.LoadUint64(&m.afterProduceItemEventCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ProduceItemEventMock.defaultExpectation != nil && afterProduceItemEventCounter < 1 {
+		if m.ProduceItemEventMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventLogProducerFacadeMock.ProduceItemEvent at\n%s", m.ProduceItemEventMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventLogProducerFacadeMock.ProduceItemEvent at\n%s with params: %#v", m.ProduceItemEventMock.defaultExpectation.expectationOrigins.origin, *m.ProduceItemEventMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcProduceItemEvent != nil && afterProduceItemEventCounter < 1 {
+		m.t.Errorf("Expected call to EventLogProducerFacadeMock.ProduceItemEvent at\n%s", m.funcProduceItemEventOrigin)
+	}
+
+	if !m.ProduceItemEventMock.invocationsDone() && afterProduceItemEventCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventLogProducerFacadeMock.ProduceItemEvent at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ProduceItemEventMock.expectedInvocations), m.ProduceItemEventMock.expectedInvocationsOrigin, afterProduceItemEventCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *EventLogProducerFacadeMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
 			m.MinimockProduceEventInspect()
+
+			m.MinimockProduceItemEventInspect()
 		}
 	})
 }
@@ -413,5 +798,6 @@ func (m *EventLogProducerFacadeMock) MinimockWait(timeout mm_time.Duration) {
 func (m *EventLogProducerFacadeMock) minimockDone() bool {
 	done := true
 	return done &&
-		m.MinimockProduceEventDone()
+		m.MinimockProduceEventDone() &&
+		m.MinimockProduceItemEventDone()
 }
@@ -10,8 +10,8 @@ import (
 	mm_atomic "sync/atomic"
 	mm_time "time"
 
-	"github.com/gojuno/minimock/v3"
 	"github.com/Na322Pr/route256/internal/dto"
+	"github.com/gojuno/minimock/v3"
 )
 
 // OrderRepoFacadeMock implements mm_usecase.OrderRepoFacade
@@ -19,6 +19,13 @@ type OrderRepoFacadeMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcAddAuditLog          func(ctx context.Context, entry dto.AuditLogDTO) (err error)
+	funcAddAuditLogOrigin    string
+	inspectFuncAddAuditLog   func(ctx context.Context, entry dto.AuditLogDTO)
+	afterAddAuditLogCounter  uint64
+	beforeAddAuditLogCounter uint64
+	AddAuditLogMock          mOrderRepoFacadeMockAddAuditLog
+
 	funcAddOrder          func(ctx context.Context, orderDTO dto.OrderDTO) (err error)
 	funcAddOrderOrigin    string
 	inspectFuncAddOrder   func(ctx context.Context, orderDTO dto.OrderDTO)
@@ -26,6 +33,13 @@ type OrderRepoFacadeMock struct {
 	beforeAddOrderCounter uint64
 	AddOrderMock          mOrderRepoFacadeMockAddOrder
 
+	funcArchiveOrders          func(ctx context.Context, archiveDTO dto.ArchiveOrdersDTO) (ia1 []int64, err error)
+	funcArchiveOrdersOrigin    string
+	inspectFuncArchiveOrders   func(ctx context.Context, archiveDTO dto.ArchiveOrdersDTO)
+	afterArchiveOrdersCounter  uint64
+	beforeArchiveOrdersCounter uint64
+	ArchiveOrdersMock          mOrderRepoFacadeMockArchiveOrders
+
 	funcGetClientOrdersList          func(ctx context.Context, clientID int) (lp1 *dto.ListOrdersDTO, err error)
 	funcGetClientOrdersListOrigin    string
 	inspectFuncGetClientOrdersList   func(ctx context.Context, clientID int)
@@ -47,19 +61,54 @@ type OrderRepoFacadeMock struct {
 	beforeGetOrdersByIDsCounter uint64
 	GetOrdersByIDsMock          mOrderRepoFacadeMockGetOrdersByIDs
 
-	funcGetRefundsList          func(ctx context.Context, limit int, offset int) (lp1 *dto.ListOrdersDTO, err error)
+	funcGetRefundsList          func(ctx context.Context, statuses []string, limit int, offset int) (lp1 *dto.ListOrdersDTO, err error)
 	funcGetRefundsListOrigin    string
-	inspectFuncGetRefundsList   func(ctx context.Context, limit int, offset int)
+	inspectFuncGetRefundsList   func(ctx context.Context, statuses []string, limit int, offset int)
 	afterGetRefundsListCounter  uint64
 	beforeGetRefundsListCounter uint64
 	GetRefundsListMock          mOrderRepoFacadeMockGetRefundsList
 
+	funcHandOverOrders          func(ctx context.Context, handoverDTO dto.HandoverDTO) (i1 int64, err error)
+	funcHandOverOrdersOrigin    string
+	inspectFuncHandOverOrders   func(ctx context.Context, handoverDTO dto.HandoverDTO)
+	afterHandOverOrdersCounter  uint64
+	beforeHandOverOrdersCounter uint64
+	HandOverOrdersMock          mOrderRepoFacadeMockHandOverOrders
+
+	funcListOrdersByStatus          func(ctx context.Context, statuses []string, limit int, offset int) (lp1 *dto.ListOrdersDTO, err error)
+	funcListOrdersByStatusOrigin    string
+	inspectFuncListOrdersByStatus   func(ctx context.Context, statuses []string, limit int, offset int)
+	afterListOrdersByStatusCounter  uint64
+	beforeListOrdersByStatusCounter uint64
+	ListOrdersByStatusMock          mOrderRepoFacadeMockListOrdersByStatus
+
+	funcQueryAuditLog          func(ctx context.Context, filter dto.AuditLogFilterDTO) (lp1 *dto.ListAuditLogDTO, err error)
+	funcQueryAuditLogOrigin    string
+	inspectFuncQueryAuditLog   func(ctx context.Context, filter dto.AuditLogFilterDTO)
+	afterQueryAuditLogCounter  uint64
+	beforeQueryAuditLogCounter uint64
+	QueryAuditLogMock          mOrderRepoFacadeMockQueryAuditLog
+
 	funcUpdateOrder          func(ctx context.Context, orderDTO dto.OrderDTO) (err error)
 	funcUpdateOrderOrigin    string
 	inspectFuncUpdateOrder   func(ctx context.Context, orderDTO dto.OrderDTO)
 	afterUpdateOrderCounter  uint64
 	beforeUpdateOrderCounter uint64
 	UpdateOrderMock          mOrderRepoFacadeMockUpdateOrder
+
+	funcUpdateOrders          func(ctx context.Context, ordersDTO []dto.OrderDTO) (err error)
+	funcUpdateOrdersOrigin    string
+	inspectFuncUpdateOrders   func(ctx context.Context, ordersDTO []dto.OrderDTO)
+	afterUpdateOrdersCounter  uint64
+	beforeUpdateOrdersCounter uint64
+	UpdateOrdersMock          mOrderRepoFacadeMockUpdateOrders
+
+	funcWithinTx          func(ctx context.Context, fn func(ctxTx context.Context) error) (err error)
+	funcWithinTxOrigin    string
+	inspectFuncWithinTx   func(ctx context.Context, fn func(ctxTx context.Context) error)
+	afterWithinTxCounter  uint64
+	beforeWithinTxCounter uint64
+	WithinTxMock          mOrderRepoFacadeMockWithinTx
 }
 
 // NewOrderRepoFacadeMock returns a mock for mm_usecase.OrderRepoFacade
@@ -70,9 +119,15 @@ func NewOrderRepoFacadeMock(t minimock.Tester) *OrderRepoFacadeMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.AddAuditLogMock = mOrderRepoFacadeMockAddAuditLog{mock: m}
+	m.AddAuditLogMock.callArgs = []*OrderRepoFacadeMockAddAuditLogParams{}
+
 	m.AddOrderMock = mOrderRepoFacadeMockAddOrder{mock: m}
 	m.AddOrderMock.callArgs = []*OrderRepoFacadeMockAddOrderParams{}
 
+	m.ArchiveOrdersMock = mOrderRepoFacadeMockArchiveOrders{mock: m}
+	m.ArchiveOrdersMock.callArgs = []*OrderRepoFacadeMockArchiveOrdersParams{}
+
 	m.GetClientOrdersListMock = mOrderRepoFacadeMockGetClientOrdersList{mock: m}
 	m.GetClientOrdersListMock.callArgs = []*OrderRepoFacadeMockGetClientOrdersListParams{}
 
@@ -85,14 +140,371 @@ func NewOrderRepoFacadeMock(t minimock.Tester) *OrderRepoFacadeMock {
 	m.GetRefundsListMock = mOrderRepoFacadeMockGetRefundsList{mock: m}
 	m.GetRefundsListMock.callArgs = []*OrderRepoFacadeMockGetRefundsListParams{}
 
+	m.HandOverOrdersMock = mOrderRepoFacadeMockHandOverOrders{mock: m}
+	m.HandOverOrdersMock.callArgs = []*OrderRepoFacadeMockHandOverOrdersParams{}
+
+	m.ListOrdersByStatusMock = mOrderRepoFacadeMockListOrdersByStatus{mock: m}
+	m.ListOrdersByStatusMock.callArgs = []*OrderRepoFacadeMockListOrdersByStatusParams{}
+
+	m.QueryAuditLogMock = mOrderRepoFacadeMockQueryAuditLog{mock: m}
+	m.QueryAuditLogMock.callArgs = []*OrderRepoFacadeMockQueryAuditLogParams{}
+
 	m.UpdateOrderMock = mOrderRepoFacadeMockUpdateOrder{mock: m}
 	m.UpdateOrderMock.callArgs = []*OrderRepoFacadeMockUpdateOrderParams{}
 
+	m.UpdateOrdersMock = mOrderRepoFacadeMockUpdateOrders{mock: m}
+	m.UpdateOrdersMock.callArgs = []*OrderRepoFacadeMockUpdateOrdersParams{}
+
+	m.WithinTxMock = mOrderRepoFacadeMockWithinTx{mock: m}
+	m.WithinTxMock.callArgs = []*OrderRepoFacadeMockWithinTxParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
+type mOrderRepoFacadeMockAddAuditLog struct {
+	optional           bool
+	mock               *OrderRepoFacadeMock
+	defaultExpectation *OrderRepoFacadeMockAddAuditLogExpectation
+	expectations       []*OrderRepoFacadeMockAddAuditLogExpectation
+
+	callArgs []*OrderRepoFacadeMockAddAuditLogParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrderRepoFacadeMockAddAuditLogExpectation specifies expectation struct of the OrderRepoFacade.AddAuditLog
+type OrderRepoFacadeMockAddAuditLogExpectation struct {
+	mock               *OrderRepoFacadeMock
+	params             *OrderRepoFacadeMockAddAuditLogParams
+	paramPtrs          *OrderRepoFacadeMockAddAuditLogParamPtrs
+	expectationOrigins OrderRepoFacadeMockAddAuditLogExpectationOrigins
+	results            *OrderRepoFacadeMockAddAuditLogResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrderRepoFacadeMockAddAuditLogParams contains parameters of the OrderRepoFacade.AddAuditLog
+type OrderRepoFacadeMockAddAuditLogParams struct {
+	ctx   context.Context
+	entry dto.AuditLogDTO
+}
+
+// OrderRepoFacadeMockAddAuditLogParamPtrs contains pointers to parameters of the OrderRepoFacade.AddAuditLog
+type OrderRepoFacadeMockAddAuditLogParamPtrs struct {
+	ctx   *context.Context
+	entry *dto.AuditLogDTO
+}
+
+// OrderRepoFacadeMockAddAuditLogResults contains results of the OrderRepoFacade.AddAuditLog
+type OrderRepoFacadeMockAddAuditLogResults struct {
+	err error
+}
+
+// OrderRepoFacadeMockAddAuditLogOrigins contains origins of expectations of the OrderRepoFacade.AddAuditLog
+type OrderRepoFacadeMockAddAuditLogExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originEntry string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddAuditLog *mOrderRepoFacadeMockAddAuditLog) Optional() *mOrderRepoFacadeMockAddAuditLog {
+	mmAddAuditLog.optional = true
+	return mmAddAuditLog
+}
+
+// Expect sets up expected params for OrderRepoFacade.AddAuditLog
+func (mmAddAuditLog *mOrderRepoFacadeMockAddAuditLog) Expect(ctx context.Context, entry dto.AuditLogDTO) *mOrderRepoFacadeMockAddAuditLog {
+	if mmAddAuditLog.mock.funcAddAuditLog != nil {
+		mmAddAuditLog.mock.t.Fatalf("OrderRepoFacadeMock.AddAuditLog mock is already set by Set")
+	}
+
+	if mmAddAuditLog.defaultExpectation == nil {
+		mmAddAuditLog.defaultExpectation = &OrderRepoFacadeMockAddAuditLogExpectation{}
+	}
+
+	if mmAddAuditLog.defaultExpectation.paramPtrs != nil {
+		mmAddAuditLog.mock.t.Fatalf("OrderRepoFacadeMock.AddAuditLog mock is already set by ExpectParams functions")
+	}
+
+	mmAddAuditLog.defaultExpectation.params = &OrderRepoFacadeMockAddAuditLogParams{ctx, entry}
+	mmAddAuditLog.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddAuditLog.expectations {
+		if minimock.Equal(e.params, mmAddAuditLog.defaultExpectation.params) {
+			mmAddAuditLog.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddAuditLog.defaultExpectation.params)
+		}
+	}
+
+	return mmAddAuditLog
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrderRepoFacade.AddAuditLog
+func (mmAddAuditLog *mOrderRepoFacadeMockAddAuditLog) ExpectCtxParam1(ctx context.Context) *mOrderRepoFacadeMockAddAuditLog {
+	if mmAddAuditLog.mock.funcAddAuditLog != nil {
+		mmAddAuditLog.mock.t.Fatalf("OrderRepoFacadeMock.AddAuditLog mock is already set by Set")
+	}
+
+	if mmAddAuditLog.defaultExpectation == nil {
+		mmAddAuditLog.defaultExpectation = &OrderRepoFacadeMockAddAuditLogExpectation{}
+	}
+
+	if mmAddAuditLog.defaultExpectation.params != nil {
+		mmAddAuditLog.mock.t.Fatalf("OrderRepoFacadeMock.AddAuditLog mock is already set by Expect")
+	}
+
+	if mmAddAuditLog.defaultExpectation.paramPtrs == nil {
+		mmAddAuditLog.defaultExpectation.paramPtrs = &OrderRepoFacadeMockAddAuditLogParamPtrs{}
+	}
+	mmAddAuditLog.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddAuditLog.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddAuditLog
+}
+
+// ExpectEntryParam2 sets up expected param entry for OrderRepoFacade.AddAuditLog
+func (mmAddAuditLog *mOrderRepoFacadeMockAddAuditLog) ExpectEntryParam2(entry dto.AuditLogDTO) *mOrderRepoFacadeMockAddAuditLog {
+	if mmAddAuditLog.mock.funcAddAuditLog != nil {
+		mmAddAuditLog.mock.t.Fatalf("OrderRepoFacadeMock.AddAuditLog mock is already set by Set")
+	}
+
+	if mmAddAuditLog.defaultExpectation == nil {
+		mmAddAuditLog.defaultExpectation = &OrderRepoFacadeMockAddAuditLogExpectation{}
+	}
+
+	if mmAddAuditLog.defaultExpectation.params != nil {
+		mmAddAuditLog.mock.t.Fatalf("OrderRepoFacadeMock.AddAuditLog mock is already set by Expect")
+	}
+
+	if mmAddAuditLog.defaultExpectation.paramPtrs == nil {
+		mmAddAuditLog.defaultExpectation.paramPtrs = &OrderRepoFacadeMockAddAuditLogParamPtrs{}
+	}
+	mmAddAuditLog.defaultExpectation.paramPtrs.entry = &entry
+	mmAddAuditLog.defaultExpectation.expectationOrigins.originEntry = minimock.CallerInfo(1)
+
+	return mmAddAuditLog
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrderRepoFacade.AddAuditLog
+func (mmAddAuditLog *mOrderRepoFacadeMockAddAuditLog) Inspect(f func(ctx context.Context, entry dto.AuditLogDTO)) *mOrderRepoFacadeMockAddAuditLog {
+	if mmAddAuditLog.mock.inspectFuncAddAuditLog != nil {
+		mmAddAuditLog.mock.t.Fatalf("Inspect function is already set for OrderRepoFacadeMock.AddAuditLog")
+	}
+
+	mmAddAuditLog.mock.inspectFuncAddAuditLog = f
+
+	return mmAddAuditLog
+}
+
+// Return sets up results that will be returned by OrderRepoFacade.AddAuditLog
+func (mmAddAuditLog *mOrderRepoFacadeMockAddAuditLog) Return(err error) *OrderRepoFacadeMock {
+	if mmAddAuditLog.mock.funcAddAuditLog != nil {
+		mmAddAuditLog.mock.t.Fatalf("OrderRepoFacadeMock.AddAuditLog mock is already set by Set")
+	}
+
+	if mmAddAuditLog.defaultExpectation == nil {
+		mmAddAuditLog.defaultExpectation = &OrderRepoFacadeMockAddAuditLogExpectation{mock: mmAddAuditLog.mock}
+	}
+	mmAddAuditLog.defaultExpectation.results = &OrderRepoFacadeMockAddAuditLogResults{err}
+	mmAddAuditLog.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddAuditLog.mock
+}
+
+// Set uses given function f to mock the OrderRepoFacade.AddAuditLog method
+func (mmAddAuditLog *mOrderRepoFacadeMockAddAuditLog) Set(f func(ctx context.Context, entry dto.AuditLogDTO) (err error)) *OrderRepoFacadeMock {
+	if mmAddAuditLog.defaultExpectation != nil {
+		mmAddAuditLog.mock.t.Fatalf("Default expectation is already set for the OrderRepoFacade.AddAuditLog method")
+	}
+
+	if len(mmAddAuditLog.expectations) > 0 {
+		mmAddAuditLog.mock.t.Fatalf("Some expectations are already set for the OrderRepoFacade.AddAuditLog method")
+	}
+
+	mmAddAuditLog.mock.funcAddAuditLog = f
+	mmAddAuditLog.mock.funcAddAuditLogOrigin = minimock.CallerInfo(1)
+	return mmAddAuditLog.mock
+}
+
+// When sets expectation for the OrderRepoFacade.AddAuditLog which will trigger the result defined by the following
+// Then helper
+func (mmAddAuditLog *mOrderRepoFacadeMockAddAuditLog) When(ctx context.Context, entry dto.AuditLogDTO) *OrderRepoFacadeMockAddAuditLogExpectation {
+	if mmAddAuditLog.mock.funcAddAuditLog != nil {
+		mmAddAuditLog.mock.t.Fatalf("OrderRepoFacadeMock.AddAuditLog mock is already set by Set")
+	}
+
+	expectation := &OrderRepoFacadeMockAddAuditLogExpectation{
+		mock:               mmAddAuditLog.mock,
+		params:             &OrderRepoFacadeMockAddAuditLogParams{ctx, entry},
+		expectationOrigins: OrderRepoFacadeMockAddAuditLogExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddAuditLog.expectations = append(mmAddAuditLog.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrderRepoFacade.AddAuditLog return parameters for the expectation previously defined by the When method
+func (e *OrderRepoFacadeMockAddAuditLogExpectation) Then(err error) *OrderRepoFacadeMock {
+	e.results = &OrderRepoFacadeMockAddAuditLogResults{err}
+	return e.mock
+}
+
+// Times sets number of times OrderRepoFacade.AddAuditLog should be invoked
+func (mmAddAuditLog *mOrderRepoFacadeMockAddAuditLog) Times(n uint64) *mOrderRepoFacadeMockAddAuditLog {
+	if n == 0 {
+		mmAddAuditLog.mock.t.Fatalf("Times of OrderRepoFacadeMock.AddAuditLog mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddAuditLog.expectedInvocations, n)
+	mmAddAuditLog.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddAuditLog
+}
+
+func (mmAddAuditLog *mOrderRepoFacadeMockAddAuditLog) invocationsDone() bool {
+	if len(mmAddAuditLog.expectations) == 0 && mmAddAuditLog.defaultExpectation == nil && mmAddAuditLog.mock.funcAddAuditLog == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddAuditLog.mock.afterAddAuditLogCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddAuditLog.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddAuditLog implements mm_usecase.OrderRepoFacade
+func (mmAddAuditLog *OrderRepoFacadeMock) AddAuditLog(ctx context.Context, entry dto.AuditLogDTO) (err error) {
+	mm_atomic.AddUint64(&mmAddAuditLog.beforeAddAuditLogCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddAuditLog.afterAddAuditLogCounter, 1)
+
+	mmAddAuditLog.t.Helper()
+
+	if mmAddAuditLog.inspectFuncAddAuditLog != nil {
+		mmAddAuditLog.inspectFuncAddAuditLog(ctx, entry)
+	}
+
+	mm_params := OrderRepoFacadeMockAddAuditLogParams{ctx, entry}
+
+	// Record call args
+	mmAddAuditLog.AddAuditLogMock.mutex.Lock()
+	mmAddAuditLog.AddAuditLogMock.callArgs = append(mmAddAuditLog.AddAuditLogMock.callArgs, &mm_params)
+	mmAddAuditLog.AddAuditLogMock.mutex.Unlock()
+
+	for _, e := range mmAddAuditLog.AddAuditLogMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddAuditLog.AddAuditLogMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddAuditLog.AddAuditLogMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddAuditLog.AddAuditLogMock.defaultExpectation.params
+		mm_want_ptrs := mmAddAuditLog.AddAuditLogMock.defaultExpectation.paramPtrs
+
+		mm_got := OrderRepoFacadeMockAddAuditLogParams{ctx, entry}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddAuditLog.t.Errorf("OrderRepoFacadeMock.AddAuditLog got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddAuditLog.AddAuditLogMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.entry != nil && !minimock.Equal(*mm_want_ptrs.entry, mm_got.entry) {
+				mmAddAuditLog.t.Errorf("OrderRepoFacadeMock.AddAuditLog got unexpected parameter entry, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddAuditLog.AddAuditLogMock.defaultExpectation.expectationOrigins.originEntry, *mm_want_ptrs.entry, mm_got.entry, minimock.Diff(*mm_want_ptrs.entry, mm_got.entry))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddAuditLog.t.Errorf("OrderRepoFacadeMock.AddAuditLog got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddAuditLog.AddAuditLogMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddAuditLog.AddAuditLogMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddAuditLog.t.Fatal("No results are set for the OrderRepoFacadeMock.AddAuditLog")
+		}
+		return (*mm_results).err
+	}
+	if mmAddAuditLog.funcAddAuditLog != nil {
+		return mmAddAuditLog.funcAddAuditLog(ctx, entry)
+	}
+	mmAddAuditLog.t.Fatalf("Unexpected call to OrderRepoFacadeMock.AddAuditLog. %v %v", ctx, entry)
+	return
+}
+
+// AddAuditLogAfterCounter returns a count of finished OrderRepoFacadeMock.AddAuditLog invocations
+func (mmAddAuditLog *OrderRepoFacadeMock) AddAuditLogAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddAuditLog.afterAddAuditLogCounter)
+}
+
+// AddAuditLogBeforeCounter returns a count of OrderRepoFacadeMock.AddAuditLog invocations
+func (mmAddAuditLog *OrderRepoFacadeMock) AddAuditLogBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddAuditLog.beforeAddAuditLogCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrderRepoFacadeMock.AddAuditLog.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddAuditLog *mOrderRepoFacadeMockAddAuditLog) Calls() []*OrderRepoFacadeMockAddAuditLogParams {
+	mmAddAuditLog.mutex.RLock()
+
+	argCopy := make([]*OrderRepoFacadeMockAddAuditLogParams, len(mmAddAuditLog.callArgs))
+	copy(argCopy, mmAddAuditLog.callArgs)
+
+	mmAddAuditLog.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddAuditLogDone returns true if the count of the AddAuditLog invocations corresponds
+// the number of defined expectations
+func (m *OrderRepoFacadeMock) MinimockAddAuditLogDone() bool {
+	if m.AddAuditLogMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddAuditLogMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddAuditLogMock.invocationsDone()
+}
+
+// MinimockAddAuditLogInspect logs each unmet expectation
+func (m *OrderRepoFacadeMock) MinimockAddAuditLogInspect() {
+	for _, e := range m.AddAuditLogMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrderRepoFacadeMock.AddAuditLog at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddAuditLogCounter := mm_atomic.LoadUint64(&m.afterAddAuditLogCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddAuditLogMock.defaultExpectation != nil && afterAddAuditLogCounter < 1 {
+		if m.AddAuditLogMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderRepoFacadeMock.AddAuditLog at\n%s", m.AddAuditLogMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrderRepoFacadeMock.AddAuditLog at\n%s with params: %#v", m.AddAuditLogMock.defaultExpectation.expectationOrigins.origin, *m.AddAuditLogMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddAuditLog != nil && afterAddAuditLogCounter < 1 {
+		m.t.Errorf("Expected call to OrderRepoFacadeMock.AddAuditLog at\n%s", m.funcAddAuditLogOrigin)
+	}
+
+	if !m.AddAuditLogMock.invocationsDone() && afterAddAuditLogCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderRepoFacadeMock.AddAuditLog at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddAuditLogMock.expectedInvocations), m.AddAuditLogMock.expectedInvocationsOrigin, afterAddAuditLogCounter)
+	}
+}
+
 type mOrderRepoFacadeMockAddOrder struct {
 	optional           bool
 	mock               *OrderRepoFacadeMock
@@ -435,53 +847,53 @@ func (m *OrderRepoFacadeMock) MinimockAddOrderInspect() {
 	}
 }
 
-type mOrderRepoFacadeMockGetClientOrdersList struct {
+type mOrderRepoFacadeMockArchiveOrders struct {
 	optional           bool
 	mock               *OrderRepoFacadeMock
-	defaultExpectation *OrderRepoFacadeMockGetClientOrdersListExpectation
-	expectations       []*OrderRepoFacadeMockGetClientOrdersListExpectation
+	defaultExpectation *OrderRepoFacadeMockArchiveOrdersExpectation
+	expectations       []*OrderRepoFacadeMockArchiveOrdersExpectation
 
-	callArgs []*OrderRepoFacadeMockGetClientOrdersListParams
+	callArgs []*OrderRepoFacadeMockArchiveOrdersParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// OrderRepoFacadeMockGetClientOrdersListExpectation specifies expectation struct of the OrderRepoFacade.GetClientOrdersList
-type OrderRepoFacadeMockGetClientOrdersListExpectation struct {
+// OrderRepoFacadeMockArchiveOrdersExpectation specifies expectation struct of the OrderRepoFacade.ArchiveOrders
+type OrderRepoFacadeMockArchiveOrdersExpectation struct {
 	mock               *OrderRepoFacadeMock
-	params             *OrderRepoFacadeMockGetClientOrdersListParams
-	paramPtrs          *OrderRepoFacadeMockGetClientOrdersListParamPtrs
-	expectationOrigins OrderRepoFacadeMockGetClientOrdersListExpectationOrigins
-	results            *OrderRepoFacadeMockGetClientOrdersListResults
+	params             *OrderRepoFacadeMockArchiveOrdersParams
+	paramPtrs          *OrderRepoFacadeMockArchiveOrdersParamPtrs
+	expectationOrigins OrderRepoFacadeMockArchiveOrdersExpectationOrigins
+	results            *OrderRepoFacadeMockArchiveOrdersResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// OrderRepoFacadeMockGetClientOrdersListParams contains parameters of the OrderRepoFacade.GetClientOrdersList
-type OrderRepoFacadeMockGetClientOrdersListParams struct {
-	ctx      context.Context
-	clientID int
+// OrderRepoFacadeMockArchiveOrdersParams contains parameters of the OrderRepoFacade.ArchiveOrders
+type OrderRepoFacadeMockArchiveOrdersParams struct {
+	ctx        context.Context
+	archiveDTO dto.ArchiveOrdersDTO
 }
 
-// OrderRepoFacadeMockGetClientOrdersListParamPtrs contains pointers to parameters of the OrderRepoFacade.GetClientOrdersList
-type OrderRepoFacadeMockGetClientOrdersListParamPtrs struct {
-	ctx      *context.Context
-	clientID *int
+// OrderRepoFacadeMockArchiveOrdersParamPtrs contains pointers to parameters of the OrderRepoFacade.ArchiveOrders
+type OrderRepoFacadeMockArchiveOrdersParamPtrs struct {
+	ctx        *context.Context
+	archiveDTO *dto.ArchiveOrdersDTO
 }
 
-// OrderRepoFacadeMockGetClientOrdersListResults contains results of the OrderRepoFacade.GetClientOrdersList
-type OrderRepoFacadeMockGetClientOrdersListResults struct {
-	lp1 *dto.ListOrdersDTO
+// OrderRepoFacadeMockArchiveOrdersResults contains results of the OrderRepoFacade.ArchiveOrders
+type OrderRepoFacadeMockArchiveOrdersResults struct {
+	ia1 []int64
 	err error
 }
 
-// OrderRepoFacadeMockGetClientOrdersListOrigins contains origins of expectations of the OrderRepoFacade.GetClientOrdersList
-type OrderRepoFacadeMockGetClientOrdersListExpectationOrigins struct {
-	origin         string
-	originCtx      string
-	originClientID string
+// OrderRepoFacadeMockArchiveOrdersOrigins contains origins of expectations of the OrderRepoFacade.ArchiveOrders
+type OrderRepoFacadeMockArchiveOrdersExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originArchiveDTO string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -489,89 +901,432 @@ type OrderRepoFacadeMockGetClientOrdersListExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetClientOrdersList *mOrderRepoFacadeMockGetClientOrdersList) Optional() *mOrderRepoFacadeMockGetClientOrdersList {
-	mmGetClientOrdersList.optional = true
-	return mmGetClientOrdersList
+func (mmArchiveOrders *mOrderRepoFacadeMockArchiveOrders) Optional() *mOrderRepoFacadeMockArchiveOrders {
+	mmArchiveOrders.optional = true
+	return mmArchiveOrders
 }
 
-// Expect sets up expected params for OrderRepoFacade.GetClientOrdersList
-func (mmGetClientOrdersList *mOrderRepoFacadeMockGetClientOrdersList) Expect(ctx context.Context, clientID int) *mOrderRepoFacadeMockGetClientOrdersList {
-	if mmGetClientOrdersList.mock.funcGetClientOrdersList != nil {
-		mmGetClientOrdersList.mock.t.Fatalf("OrderRepoFacadeMock.GetClientOrdersList mock is already set by Set")
+// Expect sets up expected params for OrderRepoFacade.ArchiveOrders
+func (mmArchiveOrders *mOrderRepoFacadeMockArchiveOrders) Expect(ctx context.Context, archiveDTO dto.ArchiveOrdersDTO) *mOrderRepoFacadeMockArchiveOrders {
+	if mmArchiveOrders.mock.funcArchiveOrders != nil {
+		mmArchiveOrders.mock.t.Fatalf("OrderRepoFacadeMock.ArchiveOrders mock is already set by Set")
 	}
 
-	if mmGetClientOrdersList.defaultExpectation == nil {
-		mmGetClientOrdersList.defaultExpectation = &OrderRepoFacadeMockGetClientOrdersListExpectation{}
+	if mmArchiveOrders.defaultExpectation == nil {
+		mmArchiveOrders.defaultExpectation = &OrderRepoFacadeMockArchiveOrdersExpectation{}
 	}
 
-	if mmGetClientOrdersList.defaultExpectation.paramPtrs != nil {
-		mmGetClientOrdersList.mock.t.Fatalf("OrderRepoFacadeMock.GetClientOrdersList mock is already set by ExpectParams functions")
+	if mmArchiveOrders.defaultExpectation.paramPtrs != nil {
+		mmArchiveOrders.mock.t.Fatalf("OrderRepoFacadeMock.ArchiveOrders mock is already set by ExpectParams functions")
 	}
 
-	mmGetClientOrdersList.defaultExpectation.params = &OrderRepoFacadeMockGetClientOrdersListParams{ctx, clientID}
-	mmGetClientOrdersList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetClientOrdersList.expectations {
-		if minimock.Equal(e.params, mmGetClientOrdersList.defaultExpectation.params) {
-			mmGetClientOrdersList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetClientOrdersList.defaultExpectation.params)
+	mmArchiveOrders.defaultExpectation.params = &OrderRepoFacadeMockArchiveOrdersParams{ctx, archiveDTO}
+	mmArchiveOrders.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmArchiveOrders.expectations {
+		if minimock.Equal(e.params, mmArchiveOrders.defaultExpectation.params) {
+			mmArchiveOrders.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmArchiveOrders.defaultExpectation.params)
 		}
 	}
 
-	return mmGetClientOrdersList
+	return mmArchiveOrders
 }
 
-// ExpectCtxParam1 sets up expected param ctx for OrderRepoFacade.GetClientOrdersList
-func (mmGetClientOrdersList *mOrderRepoFacadeMockGetClientOrdersList) ExpectCtxParam1(ctx context.Context) *mOrderRepoFacadeMockGetClientOrdersList {
-	if mmGetClientOrdersList.mock.funcGetClientOrdersList != nil {
-		mmGetClientOrdersList.mock.t.Fatalf("OrderRepoFacadeMock.GetClientOrdersList mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for OrderRepoFacade.ArchiveOrders
+func (mmArchiveOrders *mOrderRepoFacadeMockArchiveOrders) ExpectCtxParam1(ctx context.Context) *mOrderRepoFacadeMockArchiveOrders {
+	if mmArchiveOrders.mock.funcArchiveOrders != nil {
+		mmArchiveOrders.mock.t.Fatalf("OrderRepoFacadeMock.ArchiveOrders mock is already set by Set")
 	}
 
-	if mmGetClientOrdersList.defaultExpectation == nil {
-		mmGetClientOrdersList.defaultExpectation = &OrderRepoFacadeMockGetClientOrdersListExpectation{}
+	if mmArchiveOrders.defaultExpectation == nil {
+		mmArchiveOrders.defaultExpectation = &OrderRepoFacadeMockArchiveOrdersExpectation{}
 	}
 
-	if mmGetClientOrdersList.defaultExpectation.params != nil {
-		mmGetClientOrdersList.mock.t.Fatalf("OrderRepoFacadeMock.GetClientOrdersList mock is already set by Expect")
+	if mmArchiveOrders.defaultExpectation.params != nil {
+		mmArchiveOrders.mock.t.Fatalf("OrderRepoFacadeMock.ArchiveOrders mock is already set by Expect")
 	}
 
-	if mmGetClientOrdersList.defaultExpectation.paramPtrs == nil {
-		mmGetClientOrdersList.defaultExpectation.paramPtrs = &OrderRepoFacadeMockGetClientOrdersListParamPtrs{}
+	if mmArchiveOrders.defaultExpectation.paramPtrs == nil {
+		mmArchiveOrders.defaultExpectation.paramPtrs = &OrderRepoFacadeMockArchiveOrdersParamPtrs{}
 	}
-	mmGetClientOrdersList.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetClientOrdersList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmArchiveOrders.defaultExpectation.paramPtrs.ctx = &ctx
+	mmArchiveOrders.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetClientOrdersList
+	return mmArchiveOrders
 }
 
-// ExpectClientIDParam2 sets up expected param clientID for OrderRepoFacade.GetClientOrdersList
-func (mmGetClientOrdersList *mOrderRepoFacadeMockGetClientOrdersList) ExpectClientIDParam2(clientID int) *mOrderRepoFacadeMockGetClientOrdersList {
-	if mmGetClientOrdersList.mock.funcGetClientOrdersList != nil {
-		mmGetClientOrdersList.mock.t.Fatalf("OrderRepoFacadeMock.GetClientOrdersList mock is already set by Set")
+// ExpectArchiveDTOParam2 sets up expected param archiveDTO for OrderRepoFacade.ArchiveOrders
+func (mmArchiveOrders *mOrderRepoFacadeMockArchiveOrders) ExpectArchiveDTOParam2(archiveDTO dto.ArchiveOrdersDTO) *mOrderRepoFacadeMockArchiveOrders {
+	if mmArchiveOrders.mock.funcArchiveOrders != nil {
+		mmArchiveOrders.mock.t.Fatalf("OrderRepoFacadeMock.ArchiveOrders mock is already set by Set")
 	}
 
-	if mmGetClientOrdersList.defaultExpectation == nil {
-		mmGetClientOrdersList.defaultExpectation = &OrderRepoFacadeMockGetClientOrdersListExpectation{}
+	if mmArchiveOrders.defaultExpectation == nil {
+		mmArchiveOrders.defaultExpectation = &OrderRepoFacadeMockArchiveOrdersExpectation{}
 	}
 
-	if mmGetClientOrdersList.defaultExpectation.params != nil {
-		mmGetClientOrdersList.mock.t.Fatalf("OrderRepoFacadeMock.GetClientOrdersList mock is already set by Expect")
+	if mmArchiveOrders.defaultExpectation.params != nil {
+		mmArchiveOrders.mock.t.Fatalf("OrderRepoFacadeMock.ArchiveOrders mock is already set by Expect")
 	}
 
-	if mmGetClientOrdersList.defaultExpectation.paramPtrs == nil {
-		mmGetClientOrdersList.defaultExpectation.paramPtrs = &OrderRepoFacadeMockGetClientOrdersListParamPtrs{}
+	if mmArchiveOrders.defaultExpectation.paramPtrs == nil {
+		mmArchiveOrders.defaultExpectation.paramPtrs = &OrderRepoFacadeMockArchiveOrdersParamPtrs{}
 	}
-	mmGetClientOrdersList.defaultExpectation.paramPtrs.clientID = &clientID
-	mmGetClientOrdersList.defaultExpectation.expectationOrigins.originClientID = minimock.CallerInfo(1)
+	mmArchiveOrders.defaultExpectation.paramPtrs.archiveDTO = &archiveDTO
+	mmArchiveOrders.defaultExpectation.expectationOrigins.originArchiveDTO = minimock.CallerInfo(1)
 
-	return mmGetClientOrdersList
+	return mmArchiveOrders
 }
 
-// Inspect accepts an inspector function that has same arguments as the OrderRepoFacade.GetClientOrdersList
-func (mmGetClientOrdersList *mOrderRepoFacadeMockGetClientOrdersList) Inspect(f func(ctx context.Context, clientID int)) *mOrderRepoFacadeMockGetClientOrdersList {
-	if mmGetClientOrdersList.mock.inspectFuncGetClientOrdersList != nil {
-		mmGetClientOrdersList.mock.t.Fatalf("Inspect function is already set for OrderRepoFacadeMock.GetClientOrdersList")
+// Inspect accepts an inspector function that has same arguments as the OrderRepoFacade.ArchiveOrders
+func (mmArchiveOrders *mOrderRepoFacadeMockArchiveOrders) Inspect(f func(ctx context.Context, archiveDTO dto.ArchiveOrdersDTO)) *mOrderRepoFacadeMockArchiveOrders {
+	if mmArchiveOrders.mock.inspectFuncArchiveOrders != nil {
+		mmArchiveOrders.mock.t.Fatalf("Inspect function is already set for OrderRepoFacadeMock.ArchiveOrders")
 	}
 
-	mmGetClientOrdersList.mock.inspectFuncGetClientOrdersList = f
+	mmArchiveOrders.mock.inspectFuncArchiveOrders = f
+
+	return mmArchiveOrders
+}
+
+// Return sets up results that will be returned by OrderRepoFacade.ArchiveOrders
+func (mmArchiveOrders *mOrderRepoFacadeMockArchiveOrders) Return(ia1 []int64, err error) *OrderRepoFacadeMock {
+	if mmArchiveOrders.mock.funcArchiveOrders != nil {
+		mmArchiveOrders.mock.t.Fatalf("OrderRepoFacadeMock.ArchiveOrders mock is already set by Set")
+	}
+
+	if mmArchiveOrders.defaultExpectation == nil {
+		mmArchiveOrders.defaultExpectation = &OrderRepoFacadeMockArchiveOrdersExpectation{mock: mmArchiveOrders.mock}
+	}
+	mmArchiveOrders.defaultExpectation.results = &OrderRepoFacadeMockArchiveOrdersResults{ia1, err}
+	mmArchiveOrders.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmArchiveOrders.mock
+}
+
+// Set uses given function f to mock the OrderRepoFacade.ArchiveOrders method
+func (mmArchiveOrders *mOrderRepoFacadeMockArchiveOrders) Set(f func(ctx context.Context, archiveDTO dto.ArchiveOrdersDTO) (ia1 []int64, err error)) *OrderRepoFacadeMock {
+	if mmArchiveOrders.defaultExpectation != nil {
+		mmArchiveOrders.mock.t.Fatalf("Default expectation is already set for the OrderRepoFacade.ArchiveOrders method")
+	}
+
+	if len(mmArchiveOrders.expectations) > 0 {
+		mmArchiveOrders.mock.t.Fatalf("Some expectations are already set for the OrderRepoFacade.ArchiveOrders method")
+	}
+
+	mmArchiveOrders.mock.funcArchiveOrders = f
+	mmArchiveOrders.mock.funcArchiveOrdersOrigin = minimock.CallerInfo(1)
+	return mmArchiveOrders.mock
+}
+
+// When sets expectation for the OrderRepoFacade.ArchiveOrders which will trigger the result defined by the following
+// Then helper
+func (mmArchiveOrders *mOrderRepoFacadeMockArchiveOrders) When(ctx context.Context, archiveDTO dto.ArchiveOrdersDTO) *OrderRepoFacadeMockArchiveOrdersExpectation {
+	if mmArchiveOrders.mock.funcArchiveOrders != nil {
+		mmArchiveOrders.mock.t.Fatalf("OrderRepoFacadeMock.ArchiveOrders mock is already set by Set")
+	}
+
+	expectation := &OrderRepoFacadeMockArchiveOrdersExpectation{
+		mock:               mmArchiveOrders.mock,
+		params:             &OrderRepoFacadeMockArchiveOrdersParams{ctx, archiveDTO},
+		expectationOrigins: OrderRepoFacadeMockArchiveOrdersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmArchiveOrders.expectations = append(mmArchiveOrders.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrderRepoFacade.ArchiveOrders return parameters for the expectation previously defined by the When method
+func (e *OrderRepoFacadeMockArchiveOrdersExpectation) Then(ia1 []int64, err error) *OrderRepoFacadeMock {
+	e.results = &OrderRepoFacadeMockArchiveOrdersResults{ia1, err}
+	return e.mock
+}
+
+// Times sets number of times OrderRepoFacade.ArchiveOrders should be invoked
+func (mmArchiveOrders *mOrderRepoFacadeMockArchiveOrders) Times(n uint64) *mOrderRepoFacadeMockArchiveOrders {
+	if n == 0 {
+		mmArchiveOrders.mock.t.Fatalf("Times of OrderRepoFacadeMock.ArchiveOrders mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmArchiveOrders.expectedInvocations, n)
+	mmArchiveOrders.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmArchiveOrders
+}
+
+func (mmArchiveOrders *mOrderRepoFacadeMockArchiveOrders) invocationsDone() bool {
+	if len(mmArchiveOrders.expectations) == 0 && mmArchiveOrders.defaultExpectation == nil && mmArchiveOrders.mock.funcArchiveOrders == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmArchiveOrders.mock.afterArchiveOrdersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmArchiveOrders.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ArchiveOrders implements mm_usecase.OrderRepoFacade
+func (mmArchiveOrders *OrderRepoFacadeMock) ArchiveOrders(ctx context.Context, archiveDTO dto.ArchiveOrdersDTO) (ia1 []int64, err error) {
+	mm_atomic.AddUint64(&mmArchiveOrders.beforeArchiveOrdersCounter, 1)
+	defer mm_atomic.AddUint64(&mmArchiveOrders.afterArchiveOrdersCounter, 1)
+
+	mmArchiveOrders.t.Helper()
+
+	if mmArchiveOrders.inspectFuncArchiveOrders != nil {
+		mmArchiveOrders.inspectFuncArchiveOrders(ctx, archiveDTO)
+	}
+
+	mm_params := OrderRepoFacadeMockArchiveOrdersParams{ctx, archiveDTO}
+
+	// Record call args
+	mmArchiveOrders.ArchiveOrdersMock.mutex.Lock()
+	mmArchiveOrders.ArchiveOrdersMock.callArgs = append(mmArchiveOrders.ArchiveOrdersMock.callArgs, &mm_params)
+	mmArchiveOrders.ArchiveOrdersMock.mutex.Unlock()
+
+	for _, e := range mmArchiveOrders.ArchiveOrdersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ia1, e.results.err
+		}
+	}
+
+	if mmArchiveOrders.ArchiveOrdersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmArchiveOrders.ArchiveOrdersMock.defaultExpectation.Counter, 1)
+		mm_want := mmArchiveOrders.ArchiveOrdersMock.defaultExpectation.params
+		mm_want_ptrs := mmArchiveOrders.ArchiveOrdersMock.defaultExpectation.paramPtrs
+
+		mm_got := OrderRepoFacadeMockArchiveOrdersParams{ctx, archiveDTO}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmArchiveOrders.t.Errorf("OrderRepoFacadeMock.ArchiveOrders got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmArchiveOrders.ArchiveOrdersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.archiveDTO != nil && !minimock.Equal(*mm_want_ptrs.archiveDTO, mm_got.archiveDTO) {
+				mmArchiveOrders.t.Errorf("OrderRepoFacadeMock.ArchiveOrders got unexpected parameter archiveDTO, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmArchiveOrders.ArchiveOrdersMock.defaultExpectation.expectationOrigins.originArchiveDTO, *mm_want_ptrs.archiveDTO, mm_got.archiveDTO, minimock.Diff(*mm_want_ptrs.archiveDTO, mm_got.archiveDTO))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmArchiveOrders.t.Errorf("OrderRepoFacadeMock.ArchiveOrders got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmArchiveOrders.ArchiveOrdersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmArchiveOrders.ArchiveOrdersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmArchiveOrders.t.Fatal("No results are set for the OrderRepoFacadeMock.ArchiveOrders")
+		}
+		return (*mm_results).ia1, (*mm_results).err
+	}
+	if mmArchiveOrders.funcArchiveOrders != nil {
+		return mmArchiveOrders.funcArchiveOrders(ctx, archiveDTO)
+	}
+	mmArchiveOrders.t.Fatalf("Unexpected call to OrderRepoFacadeMock.ArchiveOrders. %v %v", ctx, archiveDTO)
+	return
+}
+
+// ArchiveOrdersAfterCounter returns a count of finished OrderRepoFacadeMock.ArchiveOrders invocations
+func (mmArchiveOrders *OrderRepoFacadeMock) ArchiveOrdersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmArchiveOrders.afterArchiveOrdersCounter)
+}
+
+// ArchiveOrdersBeforeCounter returns a count of OrderRepoFacadeMock.ArchiveOrders invocations
+func (mmArchiveOrders *OrderRepoFacadeMock) ArchiveOrdersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmArchiveOrders.beforeArchiveOrdersCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrderRepoFacadeMock.ArchiveOrders.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmArchiveOrders *mOrderRepoFacadeMockArchiveOrders) Calls() []*OrderRepoFacadeMockArchiveOrdersParams {
+	mmArchiveOrders.mutex.RLock()
+
+	argCopy := make([]*OrderRepoFacadeMockArchiveOrdersParams, len(mmArchiveOrders.callArgs))
+	copy(argCopy, mmArchiveOrders.callArgs)
+
+	mmArchiveOrders.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockArchiveOrdersDone returns true if the count of the ArchiveOrders invocations corresponds
+// the number of defined expectations
+func (m *OrderRepoFacadeMock) MinimockArchiveOrdersDone() bool {
+	if m.ArchiveOrdersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ArchiveOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ArchiveOrdersMock.invocationsDone()
+}
+
+// MinimockArchiveOrdersInspect logs each unmet expectation
+func (m *OrderRepoFacadeMock) MinimockArchiveOrdersInspect() {
+	for _, e := range m.ArchiveOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrderRepoFacadeMock.ArchiveOrders at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterArchiveOrdersCounter := mm_atomic.LoadUint64(&m.afterArchiveOrdersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ArchiveOrdersMock.defaultExpectation != nil && afterArchiveOrdersCounter < 1 {
+		if m.ArchiveOrdersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderRepoFacadeMock.ArchiveOrders at\n%s", m.ArchiveOrdersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrderRepoFacadeMock.ArchiveOrders at\n%s with params: %#v", m.ArchiveOrdersMock.defaultExpectation.expectationOrigins.origin, *m.ArchiveOrdersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcArchiveOrders != nil && afterArchiveOrdersCounter < 1 {
+		m.t.Errorf("Expected call to OrderRepoFacadeMock.ArchiveOrders at\n%s", m.funcArchiveOrdersOrigin)
+	}
+
+	if !m.ArchiveOrdersMock.invocationsDone() && afterArchiveOrdersCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderRepoFacadeMock.ArchiveOrders at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ArchiveOrdersMock.expectedInvocations), m.ArchiveOrdersMock.expectedInvocationsOrigin, afterArchiveOrdersCounter)
+	}
+}
+
+type mOrderRepoFacadeMockGetClientOrdersList struct {
+	optional           bool
+	mock               *OrderRepoFacadeMock
+	defaultExpectation *OrderRepoFacadeMockGetClientOrdersListExpectation
+	expectations       []*OrderRepoFacadeMockGetClientOrdersListExpectation
+
+	callArgs []*OrderRepoFacadeMockGetClientOrdersListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrderRepoFacadeMockGetClientOrdersListExpectation specifies expectation struct of the OrderRepoFacade.GetClientOrdersList
+type OrderRepoFacadeMockGetClientOrdersListExpectation struct {
+	mock               *OrderRepoFacadeMock
+	params             *OrderRepoFacadeMockGetClientOrdersListParams
+	paramPtrs          *OrderRepoFacadeMockGetClientOrdersListParamPtrs
+	expectationOrigins OrderRepoFacadeMockGetClientOrdersListExpectationOrigins
+	results            *OrderRepoFacadeMockGetClientOrdersListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrderRepoFacadeMockGetClientOrdersListParams contains parameters of the OrderRepoFacade.GetClientOrdersList
+type OrderRepoFacadeMockGetClientOrdersListParams struct {
+	ctx      context.Context
+	clientID int
+}
+
+// OrderRepoFacadeMockGetClientOrdersListParamPtrs contains pointers to parameters of the OrderRepoFacade.GetClientOrdersList
+type OrderRepoFacadeMockGetClientOrdersListParamPtrs struct {
+	ctx      *context.Context
+	clientID *int
+}
+
+// OrderRepoFacadeMockGetClientOrdersListResults contains results of the OrderRepoFacade.GetClientOrdersList
+type OrderRepoFacadeMockGetClientOrdersListResults struct {
+	lp1 *dto.ListOrdersDTO
+	err error
+}
+
+// OrderRepoFacadeMockGetClientOrdersListOrigins contains origins of expectations of the OrderRepoFacade.GetClientOrdersList
+type OrderRepoFacadeMockGetClientOrdersListExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originClientID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetClientOrdersList *mOrderRepoFacadeMockGetClientOrdersList) Optional() *mOrderRepoFacadeMockGetClientOrdersList {
+	mmGetClientOrdersList.optional = true
+	return mmGetClientOrdersList
+}
+
+// Expect sets up expected params for OrderRepoFacade.GetClientOrdersList
+func (mmGetClientOrdersList *mOrderRepoFacadeMockGetClientOrdersList) Expect(ctx context.Context, clientID int) *mOrderRepoFacadeMockGetClientOrdersList {
+	if mmGetClientOrdersList.mock.funcGetClientOrdersList != nil {
+		mmGetClientOrdersList.mock.t.Fatalf("OrderRepoFacadeMock.GetClientOrdersList mock is already set by Set")
+	}
+
+	if mmGetClientOrdersList.defaultExpectation == nil {
+		mmGetClientOrdersList.defaultExpectation = &OrderRepoFacadeMockGetClientOrdersListExpectation{}
+	}
+
+	if mmGetClientOrdersList.defaultExpectation.paramPtrs != nil {
+		mmGetClientOrdersList.mock.t.Fatalf("OrderRepoFacadeMock.GetClientOrdersList mock is already set by ExpectParams functions")
+	}
+
+	mmGetClientOrdersList.defaultExpectation.params = &OrderRepoFacadeMockGetClientOrdersListParams{ctx, clientID}
+	mmGetClientOrdersList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetClientOrdersList.expectations {
+		if minimock.Equal(e.params, mmGetClientOrdersList.defaultExpectation.params) {
+			mmGetClientOrdersList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetClientOrdersList.defaultExpectation.params)
+		}
+	}
+
+	return mmGetClientOrdersList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrderRepoFacade.GetClientOrdersList
+func (mmGetClientOrdersList *mOrderRepoFacadeMockGetClientOrdersList) ExpectCtxParam1(ctx context.Context) *mOrderRepoFacadeMockGetClientOrdersList {
+	if mmGetClientOrdersList.mock.funcGetClientOrdersList != nil {
+		mmGetClientOrdersList.mock.t.Fatalf("OrderRepoFacadeMock.GetClientOrdersList mock is already set by Set")
+	}
+
+	if mmGetClientOrdersList.defaultExpectation == nil {
+		mmGetClientOrdersList.defaultExpectation = &OrderRepoFacadeMockGetClientOrdersListExpectation{}
+	}
+
+	if mmGetClientOrdersList.defaultExpectation.params != nil {
+		mmGetClientOrdersList.mock.t.Fatalf("OrderRepoFacadeMock.GetClientOrdersList mock is already set by Expect")
+	}
+
+	if mmGetClientOrdersList.defaultExpectation.paramPtrs == nil {
+		mmGetClientOrdersList.defaultExpectation.paramPtrs = &OrderRepoFacadeMockGetClientOrdersListParamPtrs{}
+	}
+	mmGetClientOrdersList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetClientOrdersList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetClientOrdersList
+}
+
+// ExpectClientIDParam2 sets up expected param clientID for OrderRepoFacade.GetClientOrdersList
+func (mmGetClientOrdersList *mOrderRepoFacadeMockGetClientOrdersList) ExpectClientIDParam2(clientID int) *mOrderRepoFacadeMockGetClientOrdersList {
+	if mmGetClientOrdersList.mock.funcGetClientOrdersList != nil {
+		mmGetClientOrdersList.mock.t.Fatalf("OrderRepoFacadeMock.GetClientOrdersList mock is already set by Set")
+	}
+
+	if mmGetClientOrdersList.defaultExpectation == nil {
+		mmGetClientOrdersList.defaultExpectation = &OrderRepoFacadeMockGetClientOrdersListExpectation{}
+	}
+
+	if mmGetClientOrdersList.defaultExpectation.params != nil {
+		mmGetClientOrdersList.mock.t.Fatalf("OrderRepoFacadeMock.GetClientOrdersList mock is already set by Expect")
+	}
+
+	if mmGetClientOrdersList.defaultExpectation.paramPtrs == nil {
+		mmGetClientOrdersList.defaultExpectation.paramPtrs = &OrderRepoFacadeMockGetClientOrdersListParamPtrs{}
+	}
+	mmGetClientOrdersList.defaultExpectation.paramPtrs.clientID = &clientID
+	mmGetClientOrdersList.defaultExpectation.expectationOrigins.originClientID = minimock.CallerInfo(1)
+
+	return mmGetClientOrdersList
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrderRepoFacade.GetClientOrdersList
+func (mmGetClientOrdersList *mOrderRepoFacadeMockGetClientOrdersList) Inspect(f func(ctx context.Context, clientID int)) *mOrderRepoFacadeMockGetClientOrdersList {
+	if mmGetClientOrdersList.mock.inspectFuncGetClientOrdersList != nil {
+		mmGetClientOrdersList.mock.t.Fatalf("Inspect function is already set for OrderRepoFacadeMock.GetClientOrdersList")
+	}
+
+	mmGetClientOrdersList.mock.inspectFuncGetClientOrdersList = f
 
 	return mmGetClientOrdersList
 }
@@ -1490,16 +2245,18 @@ type OrderRepoFacadeMockGetRefundsListExpectation struct {
 
 // OrderRepoFacadeMockGetRefundsListParams contains parameters of the OrderRepoFacade.GetRefundsList
 type OrderRepoFacadeMockGetRefundsListParams struct {
-	ctx    context.Context
-	limit  int
-	offset int
+	ctx      context.Context
+	statuses []string
+	limit    int
+	offset   int
 }
 
 // OrderRepoFacadeMockGetRefundsListParamPtrs contains pointers to parameters of the OrderRepoFacade.GetRefundsList
 type OrderRepoFacadeMockGetRefundsListParamPtrs struct {
-	ctx    *context.Context
-	limit  *int
-	offset *int
+	ctx      *context.Context
+	statuses *[]string
+	limit    *int
+	offset   *int
 }
 
 // OrderRepoFacadeMockGetRefundsListResults contains results of the OrderRepoFacade.GetRefundsList
@@ -1510,10 +2267,11 @@ type OrderRepoFacadeMockGetRefundsListResults struct {
 
 // OrderRepoFacadeMockGetRefundsListOrigins contains origins of expectations of the OrderRepoFacade.GetRefundsList
 type OrderRepoFacadeMockGetRefundsListExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originLimit  string
-	originOffset string
+	origin         string
+	originCtx      string
+	originStatuses string
+	originLimit    string
+	originOffset   string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1527,7 +2285,7 @@ func (mmGetRefundsList *mOrderRepoFacadeMockGetRefundsList) Optional() *mOrderRe
 }
 
 // Expect sets up expected params for OrderRepoFacade.GetRefundsList
-func (mmGetRefundsList *mOrderRepoFacadeMockGetRefundsList) Expect(ctx context.Context, limit int, offset int) *mOrderRepoFacadeMockGetRefundsList {
+func (mmGetRefundsList *mOrderRepoFacadeMockGetRefundsList) Expect(ctx context.Context, statuses []string, limit int, offset int) *mOrderRepoFacadeMockGetRefundsList {
 	if mmGetRefundsList.mock.funcGetRefundsList != nil {
 		mmGetRefundsList.mock.t.Fatalf("OrderRepoFacadeMock.GetRefundsList mock is already set by Set")
 	}
@@ -1540,7 +2298,7 @@ func (mmGetRefundsList *mOrderRepoFacadeMockGetRefundsList) Expect(ctx context.C
 		mmGetRefundsList.mock.t.Fatalf("OrderRepoFacadeMock.GetRefundsList mock is already set by ExpectParams functions")
 	}
 
-	mmGetRefundsList.defaultExpectation.params = &OrderRepoFacadeMockGetRefundsListParams{ctx, limit, offset}
+	mmGetRefundsList.defaultExpectation.params = &OrderRepoFacadeMockGetRefundsListParams{ctx, statuses, limit, offset}
 	mmGetRefundsList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmGetRefundsList.expectations {
 		if minimock.Equal(e.params, mmGetRefundsList.defaultExpectation.params) {
@@ -1574,8 +2332,8 @@ func (mmGetRefundsList *mOrderRepoFacadeMockGetRefundsList) ExpectCtxParam1(ctx
 	return mmGetRefundsList
 }
 
-// ExpectLimitParam2 sets up expected param limit for OrderRepoFacade.GetRefundsList
-func (mmGetRefundsList *mOrderRepoFacadeMockGetRefundsList) ExpectLimitParam2(limit int) *mOrderRepoFacadeMockGetRefundsList {
+// ExpectStatusesParam2 sets up expected param statuses for OrderRepoFacade.GetRefundsList
+func (mmGetRefundsList *mOrderRepoFacadeMockGetRefundsList) ExpectStatusesParam2(statuses []string) *mOrderRepoFacadeMockGetRefundsList {
 	if mmGetRefundsList.mock.funcGetRefundsList != nil {
 		mmGetRefundsList.mock.t.Fatalf("OrderRepoFacadeMock.GetRefundsList mock is already set by Set")
 	}
@@ -1591,14 +2349,14 @@ func (mmGetRefundsList *mOrderRepoFacadeMockGetRefundsList) ExpectLimitParam2(li
 	if mmGetRefundsList.defaultExpectation.paramPtrs == nil {
 		mmGetRefundsList.defaultExpectation.paramPtrs = &OrderRepoFacadeMockGetRefundsListParamPtrs{}
 	}
-	mmGetRefundsList.defaultExpectation.paramPtrs.limit = &limit
-	mmGetRefundsList.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+	mmGetRefundsList.defaultExpectation.paramPtrs.statuses = &statuses
+	mmGetRefundsList.defaultExpectation.expectationOrigins.originStatuses = minimock.CallerInfo(1)
 
 	return mmGetRefundsList
 }
 
-// ExpectOffsetParam3 sets up expected param offset for OrderRepoFacade.GetRefundsList
-func (mmGetRefundsList *mOrderRepoFacadeMockGetRefundsList) ExpectOffsetParam3(offset int) *mOrderRepoFacadeMockGetRefundsList {
+// ExpectLimitParam3 sets up expected param limit for OrderRepoFacade.GetRefundsList
+func (mmGetRefundsList *mOrderRepoFacadeMockGetRefundsList) ExpectLimitParam3(limit int) *mOrderRepoFacadeMockGetRefundsList {
 	if mmGetRefundsList.mock.funcGetRefundsList != nil {
 		mmGetRefundsList.mock.t.Fatalf("OrderRepoFacadeMock.GetRefundsList mock is already set by Set")
 	}
@@ -1614,15 +2372,38 @@ func (mmGetRefundsList *mOrderRepoFacadeMockGetRefundsList) ExpectOffsetParam3(o
 	if mmGetRefundsList.defaultExpectation.paramPtrs == nil {
 		mmGetRefundsList.defaultExpectation.paramPtrs = &OrderRepoFacadeMockGetRefundsListParamPtrs{}
 	}
-	mmGetRefundsList.defaultExpectation.paramPtrs.offset = &offset
-	mmGetRefundsList.defaultExpectation.expectationOrigins.originOffset = minimock.CallerInfo(1)
+	mmGetRefundsList.defaultExpectation.paramPtrs.limit = &limit
+	mmGetRefundsList.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
 
 	return mmGetRefundsList
 }
 
-// Inspect accepts an inspector function that has same arguments as the OrderRepoFacade.GetRefundsList
-func (mmGetRefundsList *mOrderRepoFacadeMockGetRefundsList) Inspect(f func(ctx context.Context, limit int, offset int)) *mOrderRepoFacadeMockGetRefundsList {
-	if mmGetRefundsList.mock.inspectFuncGetRefundsList != nil {
+// ExpectOffsetParam4 sets up expected param offset for OrderRepoFacade.GetRefundsList
+func (mmGetRefundsList *mOrderRepoFacadeMockGetRefundsList) ExpectOffsetParam4(offset int) *mOrderRepoFacadeMockGetRefundsList {
+	if mmGetRefundsList.mock.funcGetRefundsList != nil {
+		mmGetRefundsList.mock.t.Fatalf("OrderRepoFacadeMock.GetRefundsList mock is already set by Set")
+	}
+
+	if mmGetRefundsList.defaultExpectation == nil {
+		mmGetRefundsList.defaultExpectation = &OrderRepoFacadeMockGetRefundsListExpectation{}
+	}
+
+	if mmGetRefundsList.defaultExpectation.params != nil {
+		mmGetRefundsList.mock.t.Fatalf("OrderRepoFacadeMock.GetRefundsList mock is already set by Expect")
+	}
+
+	if mmGetRefundsList.defaultExpectation.paramPtrs == nil {
+		mmGetRefundsList.defaultExpectation.paramPtrs = &OrderRepoFacadeMockGetRefundsListParamPtrs{}
+	}
+	mmGetRefundsList.defaultExpectation.paramPtrs.offset = &offset
+	mmGetRefundsList.defaultExpectation.expectationOrigins.originOffset = minimock.CallerInfo(1)
+
+	return mmGetRefundsList
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrderRepoFacade.GetRefundsList
+func (mmGetRefundsList *mOrderRepoFacadeMockGetRefundsList) Inspect(f func(ctx context.Context, statuses []string, limit int, offset int)) *mOrderRepoFacadeMockGetRefundsList {
+	if mmGetRefundsList.mock.inspectFuncGetRefundsList != nil {
 		mmGetRefundsList.mock.t.Fatalf("Inspect function is already set for OrderRepoFacadeMock.GetRefundsList")
 	}
 
@@ -1646,7 +2427,7 @@ func (mmGetRefundsList *mOrderRepoFacadeMockGetRefundsList) Return(lp1 *dto.List
 }
 
 // Set uses given function f to mock the OrderRepoFacade.GetRefundsList method
-func (mmGetRefundsList *mOrderRepoFacadeMockGetRefundsList) Set(f func(ctx context.Context, limit int, offset int) (lp1 *dto.ListOrdersDTO, err error)) *OrderRepoFacadeMock {
+func (mmGetRefundsList *mOrderRepoFacadeMockGetRefundsList) Set(f func(ctx context.Context, statuses []string, limit int, offset int) (lp1 *dto.ListOrdersDTO, err error)) *OrderRepoFacadeMock {
 	if mmGetRefundsList.defaultExpectation != nil {
 		mmGetRefundsList.mock.t.Fatalf("Default expectation is already set for the OrderRepoFacade.GetRefundsList method")
 	}
@@ -1662,14 +2443,14 @@ func (mmGetRefundsList *mOrderRepoFacadeMockGetRefundsList) Set(f func(ctx conte
 
 // When sets expectation for the OrderRepoFacade.GetRefundsList which will trigger the result defined by the following
 // Then helper
-func (mmGetRefundsList *mOrderRepoFacadeMockGetRefundsList) When(ctx context.Context, limit int, offset int) *OrderRepoFacadeMockGetRefundsListExpectation {
+func (mmGetRefundsList *mOrderRepoFacadeMockGetRefundsList) When(ctx context.Context, statuses []string, limit int, offset int) *OrderRepoFacadeMockGetRefundsListExpectation {
 	if mmGetRefundsList.mock.funcGetRefundsList != nil {
 		mmGetRefundsList.mock.t.Fatalf("OrderRepoFacadeMock.GetRefundsList mock is already set by Set")
 	}
 
 	expectation := &OrderRepoFacadeMockGetRefundsListExpectation{
 		mock:               mmGetRefundsList.mock,
-		params:             &OrderRepoFacadeMockGetRefundsListParams{ctx, limit, offset},
+		params:             &OrderRepoFacadeMockGetRefundsListParams{ctx, statuses, limit, offset},
 		expectationOrigins: OrderRepoFacadeMockGetRefundsListExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmGetRefundsList.expectations = append(mmGetRefundsList.expectations, expectation)
@@ -1704,17 +2485,17 @@ func (mmGetRefundsList *mOrderRepoFacadeMockGetRefundsList) invocationsDone() bo
 }
 
 // GetRefundsList implements mm_usecase.OrderRepoFacade
-func (mmGetRefundsList *OrderRepoFacadeMock) GetRefundsList(ctx context.Context, limit int, offset int) (lp1 *dto.ListOrdersDTO, err error) {
+func (mmGetRefundsList *OrderRepoFacadeMock) GetRefundsList(ctx context.Context, statuses []string, limit int, offset int) (lp1 *dto.ListOrdersDTO, err error) {
 	mm_atomic.AddUint64(&mmGetRefundsList.beforeGetRefundsListCounter, 1)
 	defer mm_atomic.AddUint64(&mmGetRefundsList.afterGetRefundsListCounter, 1)
 
 	mmGetRefundsList.t.Helper()
 
 	if mmGetRefundsList.inspectFuncGetRefundsList != nil {
-		mmGetRefundsList.inspectFuncGetRefundsList(ctx, limit, offset)
+		mmGetRefundsList.inspectFuncGetRefundsList(ctx, statuses, limit, offset)
 	}
 
-	mm_params := OrderRepoFacadeMockGetRefundsListParams{ctx, limit, offset}
+	mm_params := OrderRepoFacadeMockGetRefundsListParams{ctx, statuses, limit, offset}
 
 	// Record call args
 	mmGetRefundsList.GetRefundsListMock.mutex.Lock()
@@ -1733,7 +2514,7 @@ func (mmGetRefundsList *OrderRepoFacadeMock) GetRefundsList(ctx context.Context,
 		mm_want := mmGetRefundsList.GetRefundsListMock.defaultExpectation.params
 		mm_want_ptrs := mmGetRefundsList.GetRefundsListMock.defaultExpectation.paramPtrs
 
-		mm_got := OrderRepoFacadeMockGetRefundsListParams{ctx, limit, offset}
+		mm_got := OrderRepoFacadeMockGetRefundsListParams{ctx, statuses, limit, offset}
 
 		if mm_want_ptrs != nil {
 
@@ -1742,6 +2523,11 @@ func (mmGetRefundsList *OrderRepoFacadeMock) GetRefundsList(ctx context.Context,
 					mmGetRefundsList.GetRefundsListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
+			if mm_want_ptrs.statuses != nil && !minimock.Equal(*mm_want_ptrs.statuses, mm_got.statuses) {
+				mmGetRefundsList.t.Errorf("OrderRepoFacadeMock.GetRefundsList got unexpected parameter statuses, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRefundsList.GetRefundsListMock.defaultExpectation.expectationOrigins.originStatuses, *mm_want_ptrs.statuses, mm_got.statuses, minimock.Diff(*mm_want_ptrs.statuses, mm_got.statuses))
+			}
+
 			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
 				mmGetRefundsList.t.Errorf("OrderRepoFacadeMock.GetRefundsList got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 					mmGetRefundsList.GetRefundsListMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
@@ -1764,9 +2550,9 @@ func (mmGetRefundsList *OrderRepoFacadeMock) GetRefundsList(ctx context.Context,
 		return (*mm_results).lp1, (*mm_results).err
 	}
 	if mmGetRefundsList.funcGetRefundsList != nil {
-		return mmGetRefundsList.funcGetRefundsList(ctx, limit, offset)
+		return mmGetRefundsList.funcGetRefundsList(ctx, statuses, limit, offset)
 	}
-	mmGetRefundsList.t.Fatalf("Unexpected call to OrderRepoFacadeMock.GetRefundsList. %v %v %v", ctx, limit, offset)
+	mmGetRefundsList.t.Fatalf("Unexpected call to OrderRepoFacadeMock.GetRefundsList. %v %v %v %v", ctx, statuses, limit, offset)
 	return
 }
 
@@ -1838,52 +2624,53 @@ func (m *OrderRepoFacadeMock) MinimockGetRefundsListInspect() {
 	}
 }
 
-type mOrderRepoFacadeMockUpdateOrder struct {
+type mOrderRepoFacadeMockHandOverOrders struct {
 	optional           bool
 	mock               *OrderRepoFacadeMock
-	defaultExpectation *OrderRepoFacadeMockUpdateOrderExpectation
-	expectations       []*OrderRepoFacadeMockUpdateOrderExpectation
+	defaultExpectation *OrderRepoFacadeMockHandOverOrdersExpectation
+	expectations       []*OrderRepoFacadeMockHandOverOrdersExpectation
 
-	callArgs []*OrderRepoFacadeMockUpdateOrderParams
+	callArgs []*OrderRepoFacadeMockHandOverOrdersParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// OrderRepoFacadeMockUpdateOrderExpectation specifies expectation struct of the OrderRepoFacade.UpdateOrder
-type OrderRepoFacadeMockUpdateOrderExpectation struct {
+// OrderRepoFacadeMockHandOverOrdersExpectation specifies expectation struct of the OrderRepoFacade.HandOverOrders
+type OrderRepoFacadeMockHandOverOrdersExpectation struct {
 	mock               *OrderRepoFacadeMock
-	params             *OrderRepoFacadeMockUpdateOrderParams
-	paramPtrs          *OrderRepoFacadeMockUpdateOrderParamPtrs
-	expectationOrigins OrderRepoFacadeMockUpdateOrderExpectationOrigins
-	results            *OrderRepoFacadeMockUpdateOrderResults
+	params             *OrderRepoFacadeMockHandOverOrdersParams
+	paramPtrs          *OrderRepoFacadeMockHandOverOrdersParamPtrs
+	expectationOrigins OrderRepoFacadeMockHandOverOrdersExpectationOrigins
+	results            *OrderRepoFacadeMockHandOverOrdersResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// OrderRepoFacadeMockUpdateOrderParams contains parameters of the OrderRepoFacade.UpdateOrder
-type OrderRepoFacadeMockUpdateOrderParams struct {
-	ctx      context.Context
-	orderDTO dto.OrderDTO
+// OrderRepoFacadeMockHandOverOrdersParams contains parameters of the OrderRepoFacade.HandOverOrders
+type OrderRepoFacadeMockHandOverOrdersParams struct {
+	ctx         context.Context
+	handoverDTO dto.HandoverDTO
 }
 
-// OrderRepoFacadeMockUpdateOrderParamPtrs contains pointers to parameters of the OrderRepoFacade.UpdateOrder
-type OrderRepoFacadeMockUpdateOrderParamPtrs struct {
-	ctx      *context.Context
-	orderDTO *dto.OrderDTO
+// OrderRepoFacadeMockHandOverOrdersParamPtrs contains pointers to parameters of the OrderRepoFacade.HandOverOrders
+type OrderRepoFacadeMockHandOverOrdersParamPtrs struct {
+	ctx         *context.Context
+	handoverDTO *dto.HandoverDTO
 }
 
-// OrderRepoFacadeMockUpdateOrderResults contains results of the OrderRepoFacade.UpdateOrder
-type OrderRepoFacadeMockUpdateOrderResults struct {
+// OrderRepoFacadeMockHandOverOrdersResults contains results of the OrderRepoFacade.HandOverOrders
+type OrderRepoFacadeMockHandOverOrdersResults struct {
+	i1  int64
 	err error
 }
 
-// OrderRepoFacadeMockUpdateOrderOrigins contains origins of expectations of the OrderRepoFacade.UpdateOrder
-type OrderRepoFacadeMockUpdateOrderExpectationOrigins struct {
-	origin         string
-	originCtx      string
-	originOrderDTO string
+// OrderRepoFacadeMockHandOverOrdersOrigins contains origins of expectations of the OrderRepoFacade.HandOverOrders
+type OrderRepoFacadeMockHandOverOrdersExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originHandoverDTO string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1891,310 +2678,2098 @@ type OrderRepoFacadeMockUpdateOrderExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmUpdateOrder *mOrderRepoFacadeMockUpdateOrder) Optional() *mOrderRepoFacadeMockUpdateOrder {
-	mmUpdateOrder.optional = true
-	return mmUpdateOrder
+func (mmHandOverOrders *mOrderRepoFacadeMockHandOverOrders) Optional() *mOrderRepoFacadeMockHandOverOrders {
+	mmHandOverOrders.optional = true
+	return mmHandOverOrders
 }
 
-// Expect sets up expected params for OrderRepoFacade.UpdateOrder
-func (mmUpdateOrder *mOrderRepoFacadeMockUpdateOrder) Expect(ctx context.Context, orderDTO dto.OrderDTO) *mOrderRepoFacadeMockUpdateOrder {
-	if mmUpdateOrder.mock.funcUpdateOrder != nil {
-		mmUpdateOrder.mock.t.Fatalf("OrderRepoFacadeMock.UpdateOrder mock is already set by Set")
+// Expect sets up expected params for OrderRepoFacade.HandOverOrders
+func (mmHandOverOrders *mOrderRepoFacadeMockHandOverOrders) Expect(ctx context.Context, handoverDTO dto.HandoverDTO) *mOrderRepoFacadeMockHandOverOrders {
+	if mmHandOverOrders.mock.funcHandOverOrders != nil {
+		mmHandOverOrders.mock.t.Fatalf("OrderRepoFacadeMock.HandOverOrders mock is already set by Set")
 	}
 
-	if mmUpdateOrder.defaultExpectation == nil {
-		mmUpdateOrder.defaultExpectation = &OrderRepoFacadeMockUpdateOrderExpectation{}
+	if mmHandOverOrders.defaultExpectation == nil {
+		mmHandOverOrders.defaultExpectation = &OrderRepoFacadeMockHandOverOrdersExpectation{}
 	}
 
-	if mmUpdateOrder.defaultExpectation.paramPtrs != nil {
-		mmUpdateOrder.mock.t.Fatalf("OrderRepoFacadeMock.UpdateOrder mock is already set by ExpectParams functions")
+	if mmHandOverOrders.defaultExpectation.paramPtrs != nil {
+		mmHandOverOrders.mock.t.Fatalf("OrderRepoFacadeMock.HandOverOrders mock is already set by ExpectParams functions")
 	}
 
-	mmUpdateOrder.defaultExpectation.params = &OrderRepoFacadeMockUpdateOrderParams{ctx, orderDTO}
-	mmUpdateOrder.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmUpdateOrder.expectations {
-		if minimock.Equal(e.params, mmUpdateOrder.defaultExpectation.params) {
-			mmUpdateOrder.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateOrder.defaultExpectation.params)
+	mmHandOverOrders.defaultExpectation.params = &OrderRepoFacadeMockHandOverOrdersParams{ctx, handoverDTO}
+	mmHandOverOrders.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmHandOverOrders.expectations {
+		if minimock.Equal(e.params, mmHandOverOrders.defaultExpectation.params) {
+			mmHandOverOrders.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmHandOverOrders.defaultExpectation.params)
 		}
 	}
 
-	return mmUpdateOrder
+	return mmHandOverOrders
 }
 
-// ExpectCtxParam1 sets up expected param ctx for OrderRepoFacade.UpdateOrder
-func (mmUpdateOrder *mOrderRepoFacadeMockUpdateOrder) ExpectCtxParam1(ctx context.Context) *mOrderRepoFacadeMockUpdateOrder {
-	if mmUpdateOrder.mock.funcUpdateOrder != nil {
-		mmUpdateOrder.mock.t.Fatalf("OrderRepoFacadeMock.UpdateOrder mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for OrderRepoFacade.HandOverOrders
+func (mmHandOverOrders *mOrderRepoFacadeMockHandOverOrders) ExpectCtxParam1(ctx context.Context) *mOrderRepoFacadeMockHandOverOrders {
+	if mmHandOverOrders.mock.funcHandOverOrders != nil {
+		mmHandOverOrders.mock.t.Fatalf("OrderRepoFacadeMock.HandOverOrders mock is already set by Set")
 	}
 
-	if mmUpdateOrder.defaultExpectation == nil {
-		mmUpdateOrder.defaultExpectation = &OrderRepoFacadeMockUpdateOrderExpectation{}
+	if mmHandOverOrders.defaultExpectation == nil {
+		mmHandOverOrders.defaultExpectation = &OrderRepoFacadeMockHandOverOrdersExpectation{}
 	}
 
-	if mmUpdateOrder.defaultExpectation.params != nil {
-		mmUpdateOrder.mock.t.Fatalf("OrderRepoFacadeMock.UpdateOrder mock is already set by Expect")
+	if mmHandOverOrders.defaultExpectation.params != nil {
+		mmHandOverOrders.mock.t.Fatalf("OrderRepoFacadeMock.HandOverOrders mock is already set by Expect")
 	}
 
-	if mmUpdateOrder.defaultExpectation.paramPtrs == nil {
-		mmUpdateOrder.defaultExpectation.paramPtrs = &OrderRepoFacadeMockUpdateOrderParamPtrs{}
+	if mmHandOverOrders.defaultExpectation.paramPtrs == nil {
+		mmHandOverOrders.defaultExpectation.paramPtrs = &OrderRepoFacadeMockHandOverOrdersParamPtrs{}
 	}
-	mmUpdateOrder.defaultExpectation.paramPtrs.ctx = &ctx
-	mmUpdateOrder.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmHandOverOrders.defaultExpectation.paramPtrs.ctx = &ctx
+	mmHandOverOrders.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmUpdateOrder
+	return mmHandOverOrders
 }
 
-// ExpectOrderDTOParam2 sets up expected param orderDTO for OrderRepoFacade.UpdateOrder
-func (mmUpdateOrder *mOrderRepoFacadeMockUpdateOrder) ExpectOrderDTOParam2(orderDTO dto.OrderDTO) *mOrderRepoFacadeMockUpdateOrder {
-	if mmUpdateOrder.mock.funcUpdateOrder != nil {
-		mmUpdateOrder.mock.t.Fatalf("OrderRepoFacadeMock.UpdateOrder mock is already set by Set")
+// ExpectHandoverDTOParam2 sets up expected param handoverDTO for OrderRepoFacade.HandOverOrders
+func (mmHandOverOrders *mOrderRepoFacadeMockHandOverOrders) ExpectHandoverDTOParam2(handoverDTO dto.HandoverDTO) *mOrderRepoFacadeMockHandOverOrders {
+	if mmHandOverOrders.mock.funcHandOverOrders != nil {
+		mmHandOverOrders.mock.t.Fatalf("OrderRepoFacadeMock.HandOverOrders mock is already set by Set")
 	}
 
-	if mmUpdateOrder.defaultExpectation == nil {
-		mmUpdateOrder.defaultExpectation = &OrderRepoFacadeMockUpdateOrderExpectation{}
+	if mmHandOverOrders.defaultExpectation == nil {
+		mmHandOverOrders.defaultExpectation = &OrderRepoFacadeMockHandOverOrdersExpectation{}
 	}
 
-	if mmUpdateOrder.defaultExpectation.params != nil {
-		mmUpdateOrder.mock.t.Fatalf("OrderRepoFacadeMock.UpdateOrder mock is already set by Expect")
+	if mmHandOverOrders.defaultExpectation.params != nil {
+		mmHandOverOrders.mock.t.Fatalf("OrderRepoFacadeMock.HandOverOrders mock is already set by Expect")
 	}
 
-	if mmUpdateOrder.defaultExpectation.paramPtrs == nil {
-		mmUpdateOrder.defaultExpectation.paramPtrs = &OrderRepoFacadeMockUpdateOrderParamPtrs{}
+	if mmHandOverOrders.defaultExpectation.paramPtrs == nil {
+		mmHandOverOrders.defaultExpectation.paramPtrs = &OrderRepoFacadeMockHandOverOrdersParamPtrs{}
 	}
-	mmUpdateOrder.defaultExpectation.paramPtrs.orderDTO = &orderDTO
-	mmUpdateOrder.defaultExpectation.expectationOrigins.originOrderDTO = minimock.CallerInfo(1)
+	mmHandOverOrders.defaultExpectation.paramPtrs.handoverDTO = &handoverDTO
+	mmHandOverOrders.defaultExpectation.expectationOrigins.originHandoverDTO = minimock.CallerInfo(1)
 
-	return mmUpdateOrder
+	return mmHandOverOrders
 }
 
-// Inspect accepts an inspector function that has same arguments as the OrderRepoFacade.UpdateOrder
-func (mmUpdateOrder *mOrderRepoFacadeMockUpdateOrder) Inspect(f func(ctx context.Context, orderDTO dto.OrderDTO)) *mOrderRepoFacadeMockUpdateOrder {
-	if mmUpdateOrder.mock.inspectFuncUpdateOrder != nil {
-		mmUpdateOrder.mock.t.Fatalf("Inspect function is already set for OrderRepoFacadeMock.UpdateOrder")
+// Inspect accepts an inspector function that has same arguments as the OrderRepoFacade.HandOverOrders
+func (mmHandOverOrders *mOrderRepoFacadeMockHandOverOrders) Inspect(f func(ctx context.Context, handoverDTO dto.HandoverDTO)) *mOrderRepoFacadeMockHandOverOrders {
+	if mmHandOverOrders.mock.inspectFuncHandOverOrders != nil {
+		mmHandOverOrders.mock.t.Fatalf("Inspect function is already set for OrderRepoFacadeMock.HandOverOrders")
 	}
 
-	mmUpdateOrder.mock.inspectFuncUpdateOrder = f
+	mmHandOverOrders.mock.inspectFuncHandOverOrders = f
 
-	return mmUpdateOrder
+	return mmHandOverOrders
 }
 
-// Return sets up results that will be returned by OrderRepoFacade.UpdateOrder
-func (mmUpdateOrder *mOrderRepoFacadeMockUpdateOrder) Return(err error) *OrderRepoFacadeMock {
-	if mmUpdateOrder.mock.funcUpdateOrder != nil {
-		mmUpdateOrder.mock.t.Fatalf("OrderRepoFacadeMock.UpdateOrder mock is already set by Set")
+// Return sets up results that will be returned by OrderRepoFacade.HandOverOrders
+func (mmHandOverOrders *mOrderRepoFacadeMockHandOverOrders) Return(i1 int64, err error) *OrderRepoFacadeMock {
+	if mmHandOverOrders.mock.funcHandOverOrders != nil {
+		mmHandOverOrders.mock.t.Fatalf("OrderRepoFacadeMock.HandOverOrders mock is already set by Set")
 	}
 
-	if mmUpdateOrder.defaultExpectation == nil {
-		mmUpdateOrder.defaultExpectation = &OrderRepoFacadeMockUpdateOrderExpectation{mock: mmUpdateOrder.mock}
+	if mmHandOverOrders.defaultExpectation == nil {
+		mmHandOverOrders.defaultExpectation = &OrderRepoFacadeMockHandOverOrdersExpectation{mock: mmHandOverOrders.mock}
 	}
-	mmUpdateOrder.defaultExpectation.results = &OrderRepoFacadeMockUpdateOrderResults{err}
-	mmUpdateOrder.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmUpdateOrder.mock
+	mmHandOverOrders.defaultExpectation.results = &OrderRepoFacadeMockHandOverOrdersResults{i1, err}
+	mmHandOverOrders.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmHandOverOrders.mock
 }
 
-// Set uses given function f to mock the OrderRepoFacade.UpdateOrder method
-func (mmUpdateOrder *mOrderRepoFacadeMockUpdateOrder) Set(f func(ctx context.Context, orderDTO dto.OrderDTO) (err error)) *OrderRepoFacadeMock {
-	if mmUpdateOrder.defaultExpectation != nil {
-		mmUpdateOrder.mock.t.Fatalf("Default expectation is already set for the OrderRepoFacade.UpdateOrder method")
+// Set uses given function f to mock the OrderRepoFacade.HandOverOrders method
+func (mmHandOverOrders *mOrderRepoFacadeMockHandOverOrders) Set(f func(ctx context.Context, handoverDTO dto.HandoverDTO) (i1 int64, err error)) *OrderRepoFacadeMock {
+	if mmHandOverOrders.defaultExpectation != nil {
+		mmHandOverOrders.mock.t.Fatalf("Default expectation is already set for the OrderRepoFacade.HandOverOrders method")
 	}
 
-	if len(mmUpdateOrder.expectations) > 0 {
-		mmUpdateOrder.mock.t.Fatalf("Some expectations are already set for the OrderRepoFacade.UpdateOrder method")
+	if len(mmHandOverOrders.expectations) > 0 {
+		mmHandOverOrders.mock.t.Fatalf("Some expectations are already set for the OrderRepoFacade.HandOverOrders method")
 	}
 
-	mmUpdateOrder.mock.funcUpdateOrder = f
-	mmUpdateOrder.mock.funcUpdateOrderOrigin = minimock.CallerInfo(1)
-	return mmUpdateOrder.mock
+	mmHandOverOrders.mock.funcHandOverOrders = f
+	mmHandOverOrders.mock.funcHandOverOrdersOrigin = minimock.CallerInfo(1)
+	return mmHandOverOrders.mock
 }
 
-// When sets expectation for the OrderRepoFacade.UpdateOrder which will trigger the result defined by the following
+// When sets expectation for the OrderRepoFacade.HandOverOrders which will trigger the result defined by the following
 // Then helper
-func (mmUpdateOrder *mOrderRepoFacadeMockUpdateOrder) When(ctx context.Context, orderDTO dto.OrderDTO) *OrderRepoFacadeMockUpdateOrderExpectation {
-	if mmUpdateOrder.mock.funcUpdateOrder != nil {
-		mmUpdateOrder.mock.t.Fatalf("OrderRepoFacadeMock.UpdateOrder mock is already set by Set")
+func (mmHandOverOrders *mOrderRepoFacadeMockHandOverOrders) When(ctx context.Context, handoverDTO dto.HandoverDTO) *OrderRepoFacadeMockHandOverOrdersExpectation {
+	if mmHandOverOrders.mock.funcHandOverOrders != nil {
+		mmHandOverOrders.mock.t.Fatalf("OrderRepoFacadeMock.HandOverOrders mock is already set by Set")
 	}
 
-	expectation := &OrderRepoFacadeMockUpdateOrderExpectation{
-		mock:               mmUpdateOrder.mock,
-		params:             &OrderRepoFacadeMockUpdateOrderParams{ctx, orderDTO},
-		expectationOrigins: OrderRepoFacadeMockUpdateOrderExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &OrderRepoFacadeMockHandOverOrdersExpectation{
+		mock:               mmHandOverOrders.mock,
+		params:             &OrderRepoFacadeMockHandOverOrdersParams{ctx, handoverDTO},
+		expectationOrigins: OrderRepoFacadeMockHandOverOrdersExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmUpdateOrder.expectations = append(mmUpdateOrder.expectations, expectation)
+	mmHandOverOrders.expectations = append(mmHandOverOrders.expectations, expectation)
 	return expectation
 }
 
-// Then sets up OrderRepoFacade.UpdateOrder return parameters for the expectation previously defined by the When method
-func (e *OrderRepoFacadeMockUpdateOrderExpectation) Then(err error) *OrderRepoFacadeMock {
-	e.results = &OrderRepoFacadeMockUpdateOrderResults{err}
+// Then sets up OrderRepoFacade.HandOverOrders return parameters for the expectation previously defined by the When method
+func (e *OrderRepoFacadeMockHandOverOrdersExpectation) Then(i1 int64, err error) *OrderRepoFacadeMock {
+	e.results = &OrderRepoFacadeMockHandOverOrdersResults{i1, err}
 	return e.mock
 }
 
-// Times sets number of times OrderRepoFacade.UpdateOrder should be invoked
-func (mmUpdateOrder *mOrderRepoFacadeMockUpdateOrder) Times(n uint64) *mOrderRepoFacadeMockUpdateOrder {
+// Times sets number of times OrderRepoFacade.HandOverOrders should be invoked
+func (mmHandOverOrders *mOrderRepoFacadeMockHandOverOrders) Times(n uint64) *mOrderRepoFacadeMockHandOverOrders {
 	if n == 0 {
-		mmUpdateOrder.mock.t.Fatalf("Times of OrderRepoFacadeMock.UpdateOrder mock can not be zero")
+		mmHandOverOrders.mock.t.Fatalf("Times of OrderRepoFacadeMock.HandOverOrders mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmUpdateOrder.expectedInvocations, n)
-	mmUpdateOrder.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmUpdateOrder
+	mm_atomic.StoreUint64(&mmHandOverOrders.expectedInvocations, n)
+	mmHandOverOrders.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmHandOverOrders
 }
 
-func (mmUpdateOrder *mOrderRepoFacadeMockUpdateOrder) invocationsDone() bool {
-	if len(mmUpdateOrder.expectations) == 0 && mmUpdateOrder.defaultExpectation == nil && mmUpdateOrder.mock.funcUpdateOrder == nil {
+func (mmHandOverOrders *mOrderRepoFacadeMockHandOverOrders) invocationsDone() bool {
+	if len(mmHandOverOrders.expectations) == 0 && mmHandOverOrders.defaultExpectation == nil && mmHandOverOrders.mock.funcHandOverOrders == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmUpdateOrder.mock.afterUpdateOrderCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateOrder.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmHandOverOrders.mock.afterHandOverOrdersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmHandOverOrders.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// UpdateOrder implements mm_usecase.OrderRepoFacade
-func (mmUpdateOrder *OrderRepoFacadeMock) UpdateOrder(ctx context.Context, orderDTO dto.OrderDTO) (err error) {
-	mm_atomic.AddUint64(&mmUpdateOrder.beforeUpdateOrderCounter, 1)
-	defer mm_atomic.AddUint64(&mmUpdateOrder.afterUpdateOrderCounter, 1)
+// HandOverOrders implements mm_usecase.OrderRepoFacade
+func (mmHandOverOrders *OrderRepoFacadeMock) HandOverOrders(ctx context.Context, handoverDTO dto.HandoverDTO) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmHandOverOrders.beforeHandOverOrdersCounter, 1)
+	defer mm_atomic.AddUint64(&mmHandOverOrders.afterHandOverOrdersCounter, 1)
 
-	mmUpdateOrder.t.Helper()
+	mmHandOverOrders.t.Helper()
 
-	if mmUpdateOrder.inspectFuncUpdateOrder != nil {
-		mmUpdateOrder.inspectFuncUpdateOrder(ctx, orderDTO)
+	if mmHandOverOrders.inspectFuncHandOverOrders != nil {
+		mmHandOverOrders.inspectFuncHandOverOrders(ctx, handoverDTO)
 	}
 
-	mm_params := OrderRepoFacadeMockUpdateOrderParams{ctx, orderDTO}
+	mm_params := OrderRepoFacadeMockHandOverOrdersParams{ctx, handoverDTO}
 
 	// Record call args
-	mmUpdateOrder.UpdateOrderMock.mutex.Lock()
-	mmUpdateOrder.UpdateOrderMock.callArgs = append(mmUpdateOrder.UpdateOrderMock.callArgs, &mm_params)
-	mmUpdateOrder.UpdateOrderMock.mutex.Unlock()
+	mmHandOverOrders.HandOverOrdersMock.mutex.Lock()
+	mmHandOverOrders.HandOverOrdersMock.callArgs = append(mmHandOverOrders.HandOverOrdersMock.callArgs, &mm_params)
+	mmHandOverOrders.HandOverOrdersMock.mutex.Unlock()
 
-	for _, e := range mmUpdateOrder.UpdateOrderMock.expectations {
+	for _, e := range mmHandOverOrders.HandOverOrdersMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.i1, e.results.err
 		}
 	}
 
-	if mmUpdateOrder.UpdateOrderMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmUpdateOrder.UpdateOrderMock.defaultExpectation.Counter, 1)
-		mm_want := mmUpdateOrder.UpdateOrderMock.defaultExpectation.params
-		mm_want_ptrs := mmUpdateOrder.UpdateOrderMock.defaultExpectation.paramPtrs
+	if mmHandOverOrders.HandOverOrdersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmHandOverOrders.HandOverOrdersMock.defaultExpectation.Counter, 1)
+		mm_want := mmHandOverOrders.HandOverOrdersMock.defaultExpectation.params
+		mm_want_ptrs := mmHandOverOrders.HandOverOrdersMock.defaultExpectation.paramPtrs
 
-		mm_got := OrderRepoFacadeMockUpdateOrderParams{ctx, orderDTO}
+		mm_got := OrderRepoFacadeMockHandOverOrdersParams{ctx, handoverDTO}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmUpdateOrder.t.Errorf("OrderRepoFacadeMock.UpdateOrder got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmUpdateOrder.UpdateOrderMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmHandOverOrders.t.Errorf("OrderRepoFacadeMock.HandOverOrders got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHandOverOrders.HandOverOrdersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.orderDTO != nil && !minimock.Equal(*mm_want_ptrs.orderDTO, mm_got.orderDTO) {
-				mmUpdateOrder.t.Errorf("OrderRepoFacadeMock.UpdateOrder got unexpected parameter orderDTO, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmUpdateOrder.UpdateOrderMock.defaultExpectation.expectationOrigins.originOrderDTO, *mm_want_ptrs.orderDTO, mm_got.orderDTO, minimock.Diff(*mm_want_ptrs.orderDTO, mm_got.orderDTO))
+			if mm_want_ptrs.handoverDTO != nil && !minimock.Equal(*mm_want_ptrs.handoverDTO, mm_got.handoverDTO) {
+				mmHandOverOrders.t.Errorf("OrderRepoFacadeMock.HandOverOrders got unexpected parameter handoverDTO, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHandOverOrders.HandOverOrdersMock.defaultExpectation.expectationOrigins.originHandoverDTO, *mm_want_ptrs.handoverDTO, mm_got.handoverDTO, minimock.Diff(*mm_want_ptrs.handoverDTO, mm_got.handoverDTO))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmUpdateOrder.t.Errorf("OrderRepoFacadeMock.UpdateOrder got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmUpdateOrder.UpdateOrderMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmHandOverOrders.t.Errorf("OrderRepoFacadeMock.HandOverOrders got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmHandOverOrders.HandOverOrdersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmUpdateOrder.UpdateOrderMock.defaultExpectation.results
+		mm_results := mmHandOverOrders.HandOverOrdersMock.defaultExpectation.results
 		if mm_results == nil {
-			mmUpdateOrder.t.Fatal("No results are set for the OrderRepoFacadeMock.UpdateOrder")
+			mmHandOverOrders.t.Fatal("No results are set for the OrderRepoFacadeMock.HandOverOrders")
 		}
-		return (*mm_results).err
+		return (*mm_results).i1, (*mm_results).err
 	}
-	if mmUpdateOrder.funcUpdateOrder != nil {
-		return mmUpdateOrder.funcUpdateOrder(ctx, orderDTO)
+	if mmHandOverOrders.funcHandOverOrders != nil {
+		return mmHandOverOrders.funcHandOverOrders(ctx, handoverDTO)
 	}
-	mmUpdateOrder.t.Fatalf("Unexpected call to OrderRepoFacadeMock.UpdateOrder. %v %v", ctx, orderDTO)
+	mmHandOverOrders.t.Fatalf("Unexpected call to OrderRepoFacadeMock.HandOverOrders. %v %v", ctx, handoverDTO)
 	return
 }
 
-// UpdateOrderAfterCounter returns a count of finished OrderRepoFacadeMock.UpdateOrder invocations
-func (mmUpdateOrder *OrderRepoFacadeMock) UpdateOrderAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUpdateOrder.afterUpdateOrderCounter)
+// HandOverOrdersAfterCounter returns a count of finished OrderRepoFacadeMock.HandOverOrders invocations
+func (mmHandOverOrders *OrderRepoFacadeMock) HandOverOrdersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHandOverOrders.afterHandOverOrdersCounter)
 }
 
-// UpdateOrderBeforeCounter returns a count of OrderRepoFacadeMock.UpdateOrder invocations
-func (mmUpdateOrder *OrderRepoFacadeMock) UpdateOrderBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUpdateOrder.beforeUpdateOrderCounter)
+// HandOverOrdersBeforeCounter returns a count of OrderRepoFacadeMock.HandOverOrders invocations
+func (mmHandOverOrders *OrderRepoFacadeMock) HandOverOrdersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHandOverOrders.beforeHandOverOrdersCounter)
 }
 
-// Calls returns a list of arguments used in each call to OrderRepoFacadeMock.UpdateOrder.
+// Calls returns a list of arguments used in each call to OrderRepoFacadeMock.HandOverOrders.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmUpdateOrder *mOrderRepoFacadeMockUpdateOrder) Calls() []*OrderRepoFacadeMockUpdateOrderParams {
-	mmUpdateOrder.mutex.RLock()
+func (mmHandOverOrders *mOrderRepoFacadeMockHandOverOrders) Calls() []*OrderRepoFacadeMockHandOverOrdersParams {
+	mmHandOverOrders.mutex.RLock()
 
-	argCopy := make([]*OrderRepoFacadeMockUpdateOrderParams, len(mmUpdateOrder.callArgs))
-	copy(argCopy, mmUpdateOrder.callArgs)
+	argCopy := make([]*OrderRepoFacadeMockHandOverOrdersParams, len(mmHandOverOrders.callArgs))
+	copy(argCopy, mmHandOverOrders.callArgs)
 
-	mmUpdateOrder.mutex.RUnlock()
+	mmHandOverOrders.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockUpdateOrderDone returns true if the count of the UpdateOrder invocations corresponds
+// MinimockHandOverOrdersDone returns true if the count of the HandOverOrders invocations corresponds
 // the number of defined expectations
-func (m *OrderRepoFacadeMock) MinimockUpdateOrderDone() bool {
-	if m.UpdateOrderMock.optional {
+func (m *OrderRepoFacadeMock) MinimockHandOverOrdersDone() bool {
+	if m.HandOverOrdersMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.UpdateOrderMock.expectations {
+	for _, e := range m.HandOverOrdersMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.UpdateOrderMock.invocationsDone()
+	return m.HandOverOrdersMock.invocationsDone()
 }
 
-// MinimockUpdateOrderInspect logs each unmet expectation
-func (m *OrderRepoFacadeMock) MinimockUpdateOrderInspect() {
-	for _, e := range m.UpdateOrderMock.expectations {
+// MinimockHandOverOrdersInspect logs each unmet expectation
+func (m *OrderRepoFacadeMock) MinimockHandOverOrdersInspect() {
+	for _, e := range m.HandOverOrdersMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to OrderRepoFacadeMock.UpdateOrder at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to OrderRepoFacadeMock.HandOverOrders at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterUpdateOrderCounter := mm_atomic.LoadUint64(&m.afterUpdateOrderCounter)
+	afterHandOverOrdersCounter := mm_atomic.LoadUint64(&m.afterHandOverOrdersCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.UpdateOrderMock.defaultExpectation != nil && afterUpdateOrderCounter < 1 {
-		if m.UpdateOrderMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to OrderRepoFacadeMock.UpdateOrder at\n%s", m.UpdateOrderMock.defaultExpectation.returnOrigin)
+	if m.HandOverOrdersMock.defaultExpectation != nil && afterHandOverOrdersCounter < 1 {
+		if m.HandOverOrdersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderRepoFacadeMock.HandOverOrders at\n%s", m.HandOverOrdersMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to OrderRepoFacadeMock.UpdateOrder at\n%s with params: %#v", m.UpdateOrderMock.defaultExpectation.expectationOrigins.origin, *m.UpdateOrderMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to OrderRepoFacadeMock.HandOverOrders at\n%s with params: %#v", m.HandOverOrdersMock.defaultExpectation.expectationOrigins.origin, *m.HandOverOrdersMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcUpdateOrder != nil && afterUpdateOrderCounter < 1 {
-		m.t.Errorf("Expected call to OrderRepoFacadeMock.UpdateOrder at\n%s", m.funcUpdateOrderOrigin)
+	if m.funcHandOverOrders != nil && afterHandOverOrdersCounter < 1 {
+		m.t.Errorf("Expected call to OrderRepoFacadeMock.HandOverOrders at\n%s", m.funcHandOverOrdersOrigin)
 	}
 
-	if !m.UpdateOrderMock.invocationsDone() && afterUpdateOrderCounter > 0 {
-		m.t.Errorf("Expected %d calls to OrderRepoFacadeMock.UpdateOrder at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.UpdateOrderMock.expectedInvocations), m.UpdateOrderMock.expectedInvocationsOrigin, afterUpdateOrderCounter)
+	if !m.HandOverOrdersMock.invocationsDone() && afterHandOverOrdersCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderRepoFacadeMock.HandOverOrders at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.HandOverOrdersMock.expectedInvocations), m.HandOverOrdersMock.expectedInvocationsOrigin, afterHandOverOrdersCounter)
 	}
 }
 
-// MinimockFinish checks that all mocked methods have been called the expected number of times
-func (m *OrderRepoFacadeMock) MinimockFinish() {
-	m.finishOnce.Do(func() {
-		if !m.minimockDone() {
-			m.MinimockAddOrderInspect()
+type mOrderRepoFacadeMockListOrdersByStatus struct {
+	optional           bool
+	mock               *OrderRepoFacadeMock
+	defaultExpectation *OrderRepoFacadeMockListOrdersByStatusExpectation
+	expectations       []*OrderRepoFacadeMockListOrdersByStatusExpectation
 
-			m.MinimockGetClientOrdersListInspect()
+	callArgs []*OrderRepoFacadeMockListOrdersByStatusParams
+	mutex    sync.RWMutex
 
-			m.MinimockGetOrderByIDInspect()
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
 
-			m.MinimockGetOrdersByIDsInspect()
+// OrderRepoFacadeMockListOrdersByStatusExpectation specifies expectation struct of the OrderRepoFacade.ListOrdersByStatus
+type OrderRepoFacadeMockListOrdersByStatusExpectation struct {
+	mock               *OrderRepoFacadeMock
+	params             *OrderRepoFacadeMockListOrdersByStatusParams
+	paramPtrs          *OrderRepoFacadeMockListOrdersByStatusParamPtrs
+	expectationOrigins OrderRepoFacadeMockListOrdersByStatusExpectationOrigins
+	results            *OrderRepoFacadeMockListOrdersByStatusResults
+	returnOrigin       string
+	Counter            uint64
+}
 
-			m.MinimockGetRefundsListInspect()
+// OrderRepoFacadeMockListOrdersByStatusParams contains parameters of the OrderRepoFacade.ListOrdersByStatus
+type OrderRepoFacadeMockListOrdersByStatusParams struct {
+	ctx      context.Context
+	statuses []string
+	limit    int
+	offset   int
+}
 
-			m.MinimockUpdateOrderInspect()
+// OrderRepoFacadeMockListOrdersByStatusParamPtrs contains pointers to parameters of the OrderRepoFacade.ListOrdersByStatus
+type OrderRepoFacadeMockListOrdersByStatusParamPtrs struct {
+	ctx      *context.Context
+	statuses *[]string
+	limit    *int
+	offset   *int
+}
+
+// OrderRepoFacadeMockListOrdersByStatusResults contains results of the OrderRepoFacade.ListOrdersByStatus
+type OrderRepoFacadeMockListOrdersByStatusResults struct {
+	lp1 *dto.ListOrdersDTO
+	err error
+}
+
+// OrderRepoFacadeMockListOrdersByStatusOrigins contains origins of expectations of the OrderRepoFacade.ListOrdersByStatus
+type OrderRepoFacadeMockListOrdersByStatusExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originStatuses string
+	originLimit    string
+	originOffset   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListOrdersByStatus *mOrderRepoFacadeMockListOrdersByStatus) Optional() *mOrderRepoFacadeMockListOrdersByStatus {
+	mmListOrdersByStatus.optional = true
+	return mmListOrdersByStatus
+}
+
+// Expect sets up expected params for OrderRepoFacade.ListOrdersByStatus
+func (mmListOrdersByStatus *mOrderRepoFacadeMockListOrdersByStatus) Expect(ctx context.Context, statuses []string, limit int, offset int) *mOrderRepoFacadeMockListOrdersByStatus {
+	if mmListOrdersByStatus.mock.funcListOrdersByStatus != nil {
+		mmListOrdersByStatus.mock.t.Fatalf("OrderRepoFacadeMock.ListOrdersByStatus mock is already set by Set")
+	}
+
+	if mmListOrdersByStatus.defaultExpectation == nil {
+		mmListOrdersByStatus.defaultExpectation = &OrderRepoFacadeMockListOrdersByStatusExpectation{}
+	}
+
+	if mmListOrdersByStatus.defaultExpectation.paramPtrs != nil {
+		mmListOrdersByStatus.mock.t.Fatalf("OrderRepoFacadeMock.ListOrdersByStatus mock is already set by ExpectParams functions")
+	}
+
+	mmListOrdersByStatus.defaultExpectation.params = &OrderRepoFacadeMockListOrdersByStatusParams{ctx, statuses, limit, offset}
+	mmListOrdersByStatus.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListOrdersByStatus.expectations {
+		if minimock.Equal(e.params, mmListOrdersByStatus.defaultExpectation.params) {
+			mmListOrdersByStatus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListOrdersByStatus.defaultExpectation.params)
+		}
+	}
+
+	return mmListOrdersByStatus
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrderRepoFacade.ListOrdersByStatus
+func (mmListOrdersByStatus *mOrderRepoFacadeMockListOrdersByStatus) ExpectCtxParam1(ctx context.Context) *mOrderRepoFacadeMockListOrdersByStatus {
+	if mmListOrdersByStatus.mock.funcListOrdersByStatus != nil {
+		mmListOrdersByStatus.mock.t.Fatalf("OrderRepoFacadeMock.ListOrdersByStatus mock is already set by Set")
+	}
+
+	if mmListOrdersByStatus.defaultExpectation == nil {
+		mmListOrdersByStatus.defaultExpectation = &OrderRepoFacadeMockListOrdersByStatusExpectation{}
+	}
+
+	if mmListOrdersByStatus.defaultExpectation.params != nil {
+		mmListOrdersByStatus.mock.t.Fatalf("OrderRepoFacadeMock.ListOrdersByStatus mock is already set by Expect")
+	}
+
+	if mmListOrdersByStatus.defaultExpectation.paramPtrs == nil {
+		mmListOrdersByStatus.defaultExpectation.paramPtrs = &OrderRepoFacadeMockListOrdersByStatusParamPtrs{}
+	}
+	mmListOrdersByStatus.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListOrdersByStatus.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListOrdersByStatus
+}
+
+// ExpectStatusesParam2 sets up expected param statuses for OrderRepoFacade.ListOrdersByStatus
+func (mmListOrdersByStatus *mOrderRepoFacadeMockListOrdersByStatus) ExpectStatusesParam2(statuses []string) *mOrderRepoFacadeMockListOrdersByStatus {
+	if mmListOrdersByStatus.mock.funcListOrdersByStatus != nil {
+		mmListOrdersByStatus.mock.t.Fatalf("OrderRepoFacadeMock.ListOrdersByStatus mock is already set by Set")
+	}
+
+	if mmListOrdersByStatus.defaultExpectation == nil {
+		mmListOrdersByStatus.defaultExpectation = &OrderRepoFacadeMockListOrdersByStatusExpectation{}
+	}
+
+	if mmListOrdersByStatus.defaultExpectation.params != nil {
+		mmListOrdersByStatus.mock.t.Fatalf("OrderRepoFacadeMock.ListOrdersByStatus mock is already set by Expect")
+	}
+
+	if mmListOrdersByStatus.defaultExpectation.paramPtrs == nil {
+		mmListOrdersByStatus.defaultExpectation.paramPtrs = &OrderRepoFacadeMockListOrdersByStatusParamPtrs{}
+	}
+	mmListOrdersByStatus.defaultExpectation.paramPtrs.statuses = &statuses
+	mmListOrdersByStatus.defaultExpectation.expectationOrigins.originStatuses = minimock.CallerInfo(1)
+
+	return mmListOrdersByStatus
+}
+
+// ExpectLimitParam3 sets up expected param limit for OrderRepoFacade.ListOrdersByStatus
+func (mmListOrdersByStatus *mOrderRepoFacadeMockListOrdersByStatus) ExpectLimitParam3(limit int) *mOrderRepoFacadeMockListOrdersByStatus {
+	if mmListOrdersByStatus.mock.funcListOrdersByStatus != nil {
+		mmListOrdersByStatus.mock.t.Fatalf("OrderRepoFacadeMock.ListOrdersByStatus mock is already set by Set")
+	}
+
+	if mmListOrdersByStatus.defaultExpectation == nil {
+		mmListOrdersByStatus.defaultExpectation = &OrderRepoFacadeMockListOrdersByStatusExpectation{}
+	}
+
+	if mmListOrdersByStatus.defaultExpectation.params != nil {
+		mmListOrdersByStatus.mock.t.Fatalf("OrderRepoFacadeMock.ListOrdersByStatus mock is already set by Expect")
+	}
+
+	if mmListOrdersByStatus.defaultExpectation.paramPtrs == nil {
+		mmListOrdersByStatus.defaultExpectation.paramPtrs = &OrderRepoFacadeMockListOrdersByStatusParamPtrs{}
+	}
+	mmListOrdersByStatus.defaultExpectation.paramPtrs.limit = &limit
+	mmListOrdersByStatus.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmListOrdersByStatus
+}
+
+// ExpectOffsetParam4 sets up expected param offset for OrderRepoFacade.ListOrdersByStatus
+func (mmListOrdersByStatus *mOrderRepoFacadeMockListOrdersByStatus) ExpectOffsetParam4(offset int) *mOrderRepoFacadeMockListOrdersByStatus {
+	if mmListOrdersByStatus.mock.funcListOrdersByStatus != nil {
+		mmListOrdersByStatus.mock.t.Fatalf("OrderRepoFacadeMock.ListOrdersByStatus mock is already set by Set")
+	}
+
+	if mmListOrdersByStatus.defaultExpectation == nil {
+		mmListOrdersByStatus.defaultExpectation = &OrderRepoFacadeMockListOrdersByStatusExpectation{}
+	}
+
+	if mmListOrdersByStatus.defaultExpectation.params != nil {
+		mmListOrdersByStatus.mock.t.Fatalf("OrderRepoFacadeMock.ListOrdersByStatus mock is already set by Expect")
+	}
+
+	if mmListOrdersByStatus.defaultExpectation.paramPtrs == nil {
+		mmListOrdersByStatus.defaultExpectation.paramPtrs = &OrderRepoFacadeMockListOrdersByStatusParamPtrs{}
+	}
+	mmListOrdersByStatus.defaultExpectation.paramPtrs.offset = &offset
+	mmListOrdersByStatus.defaultExpectation.expectationOrigins.originOffset = minimock.CallerInfo(1)
+
+	return mmListOrdersByStatus
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrderRepoFacade.ListOrdersByStatus
+func (mmListOrdersByStatus *mOrderRepoFacadeMockListOrdersByStatus) Inspect(f func(ctx context.Context, statuses []string, limit int, offset int)) *mOrderRepoFacadeMockListOrdersByStatus {
+	if mmListOrdersByStatus.mock.inspectFuncListOrdersByStatus != nil {
+		mmListOrdersByStatus.mock.t.Fatalf("Inspect function is already set for OrderRepoFacadeMock.ListOrdersByStatus")
+	}
+
+	mmListOrdersByStatus.mock.inspectFuncListOrdersByStatus = f
+
+	return mmListOrdersByStatus
+}
+
+// Return sets up results that will be returned by OrderRepoFacade.ListOrdersByStatus
+func (mmListOrdersByStatus *mOrderRepoFacadeMockListOrdersByStatus) Return(lp1 *dto.ListOrdersDTO, err error) *OrderRepoFacadeMock {
+	if mmListOrdersByStatus.mock.funcListOrdersByStatus != nil {
+		mmListOrdersByStatus.mock.t.Fatalf("OrderRepoFacadeMock.ListOrdersByStatus mock is already set by Set")
+	}
+
+	if mmListOrdersByStatus.defaultExpectation == nil {
+		mmListOrdersByStatus.defaultExpectation = &OrderRepoFacadeMockListOrdersByStatusExpectation{mock: mmListOrdersByStatus.mock}
+	}
+	mmListOrdersByStatus.defaultExpectation.results = &OrderRepoFacadeMockListOrdersByStatusResults{lp1, err}
+	mmListOrdersByStatus.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListOrdersByStatus.mock
+}
+
+// Set uses given function f to mock the OrderRepoFacade.ListOrdersByStatus method
+func (mmListOrdersByStatus *mOrderRepoFacadeMockListOrdersByStatus) Set(f func(ctx context.Context, statuses []string, limit int, offset int) (lp1 *dto.ListOrdersDTO, err error)) *OrderRepoFacadeMock {
+	if mmListOrdersByStatus.defaultExpectation != nil {
+		mmListOrdersByStatus.mock.t.Fatalf("Default expectation is already set for the OrderRepoFacade.ListOrdersByStatus method")
+	}
+
+	if len(mmListOrdersByStatus.expectations) > 0 {
+		mmListOrdersByStatus.mock.t.Fatalf("Some expectations are already set for the OrderRepoFacade.ListOrdersByStatus method")
+	}
+
+	mmListOrdersByStatus.mock.funcListOrdersByStatus = f
+	mmListOrdersByStatus.mock.funcListOrdersByStatusOrigin = minimock.CallerInfo(1)
+	return mmListOrdersByStatus.mock
+}
+
+// When sets expectation for the OrderRepoFacade.ListOrdersByStatus which will trigger the result defined by the following
+// Then helper
+func (mmListOrdersByStatus *mOrderRepoFacadeMockListOrdersByStatus) When(ctx context.Context, statuses []string, limit int, offset int) *OrderRepoFacadeMockListOrdersByStatusExpectation {
+	if mmListOrdersByStatus.mock.funcListOrdersByStatus != nil {
+		mmListOrdersByStatus.mock.t.Fatalf("OrderRepoFacadeMock.ListOrdersByStatus mock is already set by Set")
+	}
+
+	expectation := &OrderRepoFacadeMockListOrdersByStatusExpectation{
+		mock:               mmListOrdersByStatus.mock,
+		params:             &OrderRepoFacadeMockListOrdersByStatusParams{ctx, statuses, limit, offset},
+		expectationOrigins: OrderRepoFacadeMockListOrdersByStatusExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListOrdersByStatus.expectations = append(mmListOrdersByStatus.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrderRepoFacade.ListOrdersByStatus return parameters for the expectation previously defined by the When method
+func (e *OrderRepoFacadeMockListOrdersByStatusExpectation) Then(lp1 *dto.ListOrdersDTO, err error) *OrderRepoFacadeMock {
+	e.results = &OrderRepoFacadeMockListOrdersByStatusResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times OrderRepoFacade.ListOrdersByStatus should be invoked
+func (mmListOrdersByStatus *mOrderRepoFacadeMockListOrdersByStatus) Times(n uint64) *mOrderRepoFacadeMockListOrdersByStatus {
+	if n == 0 {
+		mmListOrdersByStatus.mock.t.Fatalf("Times of OrderRepoFacadeMock.ListOrdersByStatus mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListOrdersByStatus.expectedInvocations, n)
+	mmListOrdersByStatus.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListOrdersByStatus
+}
+
+func (mmListOrdersByStatus *mOrderRepoFacadeMockListOrdersByStatus) invocationsDone() bool {
+	if len(mmListOrdersByStatus.expectations) == 0 && mmListOrdersByStatus.defaultExpectation == nil && mmListOrdersByStatus.mock.funcListOrdersByStatus == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListOrdersByStatus.mock.afterListOrdersByStatusCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListOrdersByStatus.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListOrdersByStatus implements mm_usecase.OrderRepoFacade
+func (mmListOrdersByStatus *OrderRepoFacadeMock) ListOrdersByStatus(ctx context.Context, statuses []string, limit int, offset int) (lp1 *dto.ListOrdersDTO, err error) {
+	mm_atomic.AddUint64(&mmListOrdersByStatus.beforeListOrdersByStatusCounter, 1)
+	defer mm_atomic.AddUint64(&mmListOrdersByStatus.afterListOrdersByStatusCounter, 1)
+
+	mmListOrdersByStatus.t.Helper()
+
+	if mmListOrdersByStatus.inspectFuncListOrdersByStatus != nil {
+		mmListOrdersByStatus.inspectFuncListOrdersByStatus(ctx, statuses, limit, offset)
+	}
+
+	mm_params := OrderRepoFacadeMockListOrdersByStatusParams{ctx, statuses, limit, offset}
+
+	// Record call args
+	mmListOrdersByStatus.ListOrdersByStatusMock.mutex.Lock()
+	mmListOrdersByStatus.ListOrdersByStatusMock.callArgs = append(mmListOrdersByStatus.ListOrdersByStatusMock.callArgs, &mm_params)
+	mmListOrdersByStatus.ListOrdersByStatusMock.mutex.Unlock()
+
+	for _, e := range mmListOrdersByStatus.ListOrdersByStatusMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmListOrdersByStatus.ListOrdersByStatusMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListOrdersByStatus.ListOrdersByStatusMock.defaultExpectation.Counter, 1)
+		mm_want := mmListOrdersByStatus.ListOrdersByStatusMock.defaultExpectation.params
+		mm_want_ptrs := mmListOrdersByStatus.ListOrdersByStatusMock.defaultExpectation.paramPtrs
+
+		mm_got := OrderRepoFacadeMockListOrdersByStatusParams{ctx, statuses, limit, offset}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListOrdersByStatus.t.Errorf("OrderRepoFacadeMock.ListOrdersByStatus got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListOrdersByStatus.ListOrdersByStatusMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.statuses != nil && !minimock.Equal(*mm_want_ptrs.statuses, mm_got.statuses) {
+				mmListOrdersByStatus.t.Errorf("OrderRepoFacadeMock.ListOrdersByStatus got unexpected parameter statuses, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListOrdersByStatus.ListOrdersByStatusMock.defaultExpectation.expectationOrigins.originStatuses, *mm_want_ptrs.statuses, mm_got.statuses, minimock.Diff(*mm_want_ptrs.statuses, mm_got.statuses))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListOrdersByStatus.t.Errorf("OrderRepoFacadeMock.ListOrdersByStatus got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListOrdersByStatus.ListOrdersByStatusMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+			if mm_want_ptrs.offset != nil && !minimock.Equal(*mm_want_ptrs.offset, mm_got.offset) {
+				mmListOrdersByStatus.t.Errorf("OrderRepoFacadeMock.ListOrdersByStatus got unexpected parameter offset, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListOrdersByStatus.ListOrdersByStatusMock.defaultExpectation.expectationOrigins.originOffset, *mm_want_ptrs.offset, mm_got.offset, minimock.Diff(*mm_want_ptrs.offset, mm_got.offset))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListOrdersByStatus.t.Errorf("OrderRepoFacadeMock.ListOrdersByStatus got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListOrdersByStatus.ListOrdersByStatusMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListOrdersByStatus.ListOrdersByStatusMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListOrdersByStatus.t.Fatal("No results are set for the OrderRepoFacadeMock.ListOrdersByStatus")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmListOrdersByStatus.funcListOrdersByStatus != nil {
+		return mmListOrdersByStatus.funcListOrdersByStatus(ctx, statuses, limit, offset)
+	}
+	mmListOrdersByStatus.t.Fatalf("Unexpected call to OrderRepoFacadeMock.ListOrdersByStatus. %v %v %v %v", ctx, statuses, limit, offset)
+	return
+}
+
+// ListOrdersByStatusAfterCounter returns a count of finished OrderRepoFacadeMock.ListOrdersByStatus invocations
+func (mmListOrdersByStatus *OrderRepoFacadeMock) ListOrdersByStatusAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListOrdersByStatus.afterListOrdersByStatusCounter)
+}
+
+// ListOrdersByStatusBeforeCounter returns a count of OrderRepoFacadeMock.ListOrdersByStatus invocations
+func (mmListOrdersByStatus *OrderRepoFacadeMock) ListOrdersByStatusBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListOrdersByStatus.beforeListOrdersByStatusCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrderRepoFacadeMock.ListOrdersByStatus.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListOrdersByStatus *mOrderRepoFacadeMockListOrdersByStatus) Calls() []*OrderRepoFacadeMockListOrdersByStatusParams {
+	mmListOrdersByStatus.mutex.RLock()
+
+	argCopy := make([]*OrderRepoFacadeMockListOrdersByStatusParams, len(mmListOrdersByStatus.callArgs))
+	copy(argCopy, mmListOrdersByStatus.callArgs)
+
+	mmListOrdersByStatus.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListOrdersByStatusDone returns true if the count of the ListOrdersByStatus invocations corresponds
+// the number of defined expectations
+func (m *OrderRepoFacadeMock) MinimockListOrdersByStatusDone() bool {
+	if m.ListOrdersByStatusMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListOrdersByStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListOrdersByStatusMock.invocationsDone()
+}
+
+// MinimockListOrdersByStatusInspect logs each unmet expectation
+func (m *OrderRepoFacadeMock) MinimockListOrdersByStatusInspect() {
+	for _, e := range m.ListOrdersByStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrderRepoFacadeMock.ListOrdersByStatus at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListOrdersByStatusCounter := mm_atomic.LoadUint64(&m.afterListOrdersByStatusCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListOrdersByStatusMock.defaultExpectation != nil && afterListOrdersByStatusCounter < 1 {
+		if m.ListOrdersByStatusMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderRepoFacadeMock.ListOrdersByStatus at\n%s", m.ListOrdersByStatusMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrderRepoFacadeMock.ListOrdersByStatus at\n%s with params: %#v", m.ListOrdersByStatusMock.defaultExpectation.expectationOrigins.origin, *m.ListOrdersByStatusMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListOrdersByStatus != nil && afterListOrdersByStatusCounter < 1 {
+		m.t.Errorf("Expected call to OrderRepoFacadeMock.ListOrdersByStatus at\n%s", m.funcListOrdersByStatusOrigin)
+	}
+
+	if !m.ListOrdersByStatusMock.invocationsDone() && afterListOrdersByStatusCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderRepoFacadeMock.ListOrdersByStatus at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListOrdersByStatusMock.expectedInvocations), m.ListOrdersByStatusMock.expectedInvocationsOrigin, afterListOrdersByStatusCounter)
+	}
+}
+
+type mOrderRepoFacadeMockQueryAuditLog struct {
+	optional           bool
+	mock               *OrderRepoFacadeMock
+	defaultExpectation *OrderRepoFacadeMockQueryAuditLogExpectation
+	expectations       []*OrderRepoFacadeMockQueryAuditLogExpectation
+
+	callArgs []*OrderRepoFacadeMockQueryAuditLogParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrderRepoFacadeMockQueryAuditLogExpectation specifies expectation struct of the OrderRepoFacade.QueryAuditLog
+type OrderRepoFacadeMockQueryAuditLogExpectation struct {
+	mock               *OrderRepoFacadeMock
+	params             *OrderRepoFacadeMockQueryAuditLogParams
+	paramPtrs          *OrderRepoFacadeMockQueryAuditLogParamPtrs
+	expectationOrigins OrderRepoFacadeMockQueryAuditLogExpectationOrigins
+	results            *OrderRepoFacadeMockQueryAuditLogResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrderRepoFacadeMockQueryAuditLogParams contains parameters of the OrderRepoFacade.QueryAuditLog
+type OrderRepoFacadeMockQueryAuditLogParams struct {
+	ctx    context.Context
+	filter dto.AuditLogFilterDTO
+}
+
+// OrderRepoFacadeMockQueryAuditLogParamPtrs contains pointers to parameters of the OrderRepoFacade.QueryAuditLog
+type OrderRepoFacadeMockQueryAuditLogParamPtrs struct {
+	ctx    *context.Context
+	filter *dto.AuditLogFilterDTO
+}
+
+// OrderRepoFacadeMockQueryAuditLogResults contains results of the OrderRepoFacade.QueryAuditLog
+type OrderRepoFacadeMockQueryAuditLogResults struct {
+	lp1 *dto.ListAuditLogDTO
+	err error
+}
+
+// OrderRepoFacadeMockQueryAuditLogOrigins contains origins of expectations of the OrderRepoFacade.QueryAuditLog
+type OrderRepoFacadeMockQueryAuditLogExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmQueryAuditLog *mOrderRepoFacadeMockQueryAuditLog) Optional() *mOrderRepoFacadeMockQueryAuditLog {
+	mmQueryAuditLog.optional = true
+	return mmQueryAuditLog
+}
+
+// Expect sets up expected params for OrderRepoFacade.QueryAuditLog
+func (mmQueryAuditLog *mOrderRepoFacadeMockQueryAuditLog) Expect(ctx context.Context, filter dto.AuditLogFilterDTO) *mOrderRepoFacadeMockQueryAuditLog {
+	if mmQueryAuditLog.mock.funcQueryAuditLog != nil {
+		mmQueryAuditLog.mock.t.Fatalf("OrderRepoFacadeMock.QueryAuditLog mock is already set by Set")
+	}
+
+	if mmQueryAuditLog.defaultExpectation == nil {
+		mmQueryAuditLog.defaultExpectation = &OrderRepoFacadeMockQueryAuditLogExpectation{}
+	}
+
+	if mmQueryAuditLog.defaultExpectation.paramPtrs != nil {
+		mmQueryAuditLog.mock.t.Fatalf("OrderRepoFacadeMock.QueryAuditLog mock is already set by ExpectParams functions")
+	}
+
+	mmQueryAuditLog.defaultExpectation.params = &OrderRepoFacadeMockQueryAuditLogParams{ctx, filter}
+	mmQueryAuditLog.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmQueryAuditLog.expectations {
+		if minimock.Equal(e.params, mmQueryAuditLog.defaultExpectation.params) {
+			mmQueryAuditLog.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmQueryAuditLog.defaultExpectation.params)
+		}
+	}
+
+	return mmQueryAuditLog
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrderRepoFacade.QueryAuditLog
+func (mmQueryAuditLog *mOrderRepoFacadeMockQueryAuditLog) ExpectCtxParam1(ctx context.Context) *mOrderRepoFacadeMockQueryAuditLog {
+	if mmQueryAuditLog.mock.funcQueryAuditLog != nil {
+		mmQueryAuditLog.mock.t.Fatalf("OrderRepoFacadeMock.QueryAuditLog mock is already set by Set")
+	}
+
+	if mmQueryAuditLog.defaultExpectation == nil {
+		mmQueryAuditLog.defaultExpectation = &OrderRepoFacadeMockQueryAuditLogExpectation{}
+	}
+
+	if mmQueryAuditLog.defaultExpectation.params != nil {
+		mmQueryAuditLog.mock.t.Fatalf("OrderRepoFacadeMock.QueryAuditLog mock is already set by Expect")
+	}
+
+	if mmQueryAuditLog.defaultExpectation.paramPtrs == nil {
+		mmQueryAuditLog.defaultExpectation.paramPtrs = &OrderRepoFacadeMockQueryAuditLogParamPtrs{}
+	}
+	mmQueryAuditLog.defaultExpectation.paramPtrs.ctx = &ctx
+	mmQueryAuditLog.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmQueryAuditLog
+}
+
+// ExpectFilterParam2 sets up expected param filter for OrderRepoFacade.QueryAuditLog
+func (mmQueryAuditLog *mOrderRepoFacadeMockQueryAuditLog) ExpectFilterParam2(filter dto.AuditLogFilterDTO) *mOrderRepoFacadeMockQueryAuditLog {
+	if mmQueryAuditLog.mock.funcQueryAuditLog != nil {
+		mmQueryAuditLog.mock.t.Fatalf("OrderRepoFacadeMock.QueryAuditLog mock is already set by Set")
+	}
+
+	if mmQueryAuditLog.defaultExpectation == nil {
+		mmQueryAuditLog.defaultExpectation = &OrderRepoFacadeMockQueryAuditLogExpectation{}
+	}
+
+	if mmQueryAuditLog.defaultExpectation.params != nil {
+		mmQueryAuditLog.mock.t.Fatalf("OrderRepoFacadeMock.QueryAuditLog mock is already set by Expect")
+	}
+
+	if mmQueryAuditLog.defaultExpectation.paramPtrs == nil {
+		mmQueryAuditLog.defaultExpectation.paramPtrs = &OrderRepoFacadeMockQueryAuditLogParamPtrs{}
+	}
+	mmQueryAuditLog.defaultExpectation.paramPtrs.filter = &filter
+	mmQueryAuditLog.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmQueryAuditLog
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrderRepoFacade.QueryAuditLog
+func (mmQueryAuditLog *mOrderRepoFacadeMockQueryAuditLog) Inspect(f func(ctx context.Context, filter dto.AuditLogFilterDTO)) *mOrderRepoFacadeMockQueryAuditLog {
+	if mmQueryAuditLog.mock.inspectFuncQueryAuditLog != nil {
+		mmQueryAuditLog.mock.t.Fatalf("Inspect function is already set for OrderRepoFacadeMock.QueryAuditLog")
+	}
+
+	mmQueryAuditLog.mock.inspectFuncQueryAuditLog = f
+
+	return mmQueryAuditLog
+}
+
+// Return sets up results that will be returned by OrderRepoFacade.QueryAuditLog
+func (mmQueryAuditLog *mOrderRepoFacadeMockQueryAuditLog) Return(lp1 *dto.ListAuditLogDTO, err error) *OrderRepoFacadeMock {
+	if mmQueryAuditLog.mock.funcQueryAuditLog != nil {
+		mmQueryAuditLog.mock.t.Fatalf("OrderRepoFacadeMock.QueryAuditLog mock is already set by Set")
+	}
+
+	if mmQueryAuditLog.defaultExpectation == nil {
+		mmQueryAuditLog.defaultExpectation = &OrderRepoFacadeMockQueryAuditLogExpectation{mock: mmQueryAuditLog.mock}
+	}
+	mmQueryAuditLog.defaultExpectation.results = &OrderRepoFacadeMockQueryAuditLogResults{lp1, err}
+	mmQueryAuditLog.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmQueryAuditLog.mock
+}
+
+// Set uses given function f to mock the OrderRepoFacade.QueryAuditLog method
+func (mmQueryAuditLog *mOrderRepoFacadeMockQueryAuditLog) Set(f func(ctx context.Context, filter dto.AuditLogFilterDTO) (lp1 *dto.ListAuditLogDTO, err error)) *OrderRepoFacadeMock {
+	if mmQueryAuditLog.defaultExpectation != nil {
+		mmQueryAuditLog.mock.t.Fatalf("Default expectation is already set for the OrderRepoFacade.QueryAuditLog method")
+	}
+
+	if len(mmQueryAuditLog.expectations) > 0 {
+		mmQueryAuditLog.mock.t.Fatalf("Some expectations are already set for the OrderRepoFacade.QueryAuditLog method")
+	}
+
+	mmQueryAuditLog.mock.funcQueryAuditLog = f
+	mmQueryAuditLog.mock.funcQueryAuditLogOrigin = minimock.CallerInfo(1)
+	return mmQueryAuditLog.mock
+}
+
+// When sets expectation for the OrderRepoFacade.QueryAuditLog which will trigger the result defined by the following
+// Then helper
+func (mmQueryAuditLog *mOrderRepoFacadeMockQueryAuditLog) When(ctx context.Context, filter dto.AuditLogFilterDTO) *OrderRepoFacadeMockQueryAuditLogExpectation {
+	if mmQueryAuditLog.mock.funcQueryAuditLog != nil {
+		mmQueryAuditLog.mock.t.Fatalf("OrderRepoFacadeMock.QueryAuditLog mock is already set by Set")
+	}
+
+	expectation := &OrderRepoFacadeMockQueryAuditLogExpectation{
+		mock:               mmQueryAuditLog.mock,
+		params:             &OrderRepoFacadeMockQueryAuditLogParams{ctx, filter},
+		expectationOrigins: OrderRepoFacadeMockQueryAuditLogExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmQueryAuditLog.expectations = append(mmQueryAuditLog.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrderRepoFacade.QueryAuditLog return parameters for the expectation previously defined by the When method
+func (e *OrderRepoFacadeMockQueryAuditLogExpectation) Then(lp1 *dto.ListAuditLogDTO, err error) *OrderRepoFacadeMock {
+	e.results = &OrderRepoFacadeMockQueryAuditLogResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times OrderRepoFacade.QueryAuditLog should be invoked
+func (mmQueryAuditLog *mOrderRepoFacadeMockQueryAuditLog) Times(n uint64) *mOrderRepoFacadeMockQueryAuditLog {
+	if n == 0 {
+		mmQueryAuditLog.mock.t.Fatalf("Times of OrderRepoFacadeMock.QueryAuditLog mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmQueryAuditLog.expectedInvocations, n)
+	mmQueryAuditLog.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmQueryAuditLog
+}
+
+func (mmQueryAuditLog *mOrderRepoFacadeMockQueryAuditLog) invocationsDone() bool {
+	if len(mmQueryAuditLog.expectations) == 0 && mmQueryAuditLog.defaultExpectation == nil && mmQueryAuditLog.mock.funcQueryAuditLog == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmQueryAuditLog.mock.afterQueryAuditLogCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmQueryAuditLog.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// QueryAuditLog implements mm_usecase.OrderRepoFacade
+func (mmQueryAuditLog *OrderRepoFacadeMock) QueryAuditLog(ctx context.Context, filter dto.AuditLogFilterDTO) (lp1 *dto.ListAuditLogDTO, err error) {
+	mm_atomic.AddUint64(&mmQueryAuditLog.beforeQueryAuditLogCounter, 1)
+	defer mm_atomic.AddUint64(&mmQueryAuditLog.afterQueryAuditLogCounter, 1)
+
+	mmQueryAuditLog.t.Helper()
+
+	if mmQueryAuditLog.inspectFuncQueryAuditLog != nil {
+		mmQueryAuditLog.inspectFuncQueryAuditLog(ctx, filter)
+	}
+
+	mm_params := OrderRepoFacadeMockQueryAuditLogParams{ctx, filter}
+
+	// Record call args
+	mmQueryAuditLog.QueryAuditLogMock.mutex.Lock()
+	mmQueryAuditLog.QueryAuditLogMock.callArgs = append(mmQueryAuditLog.QueryAuditLogMock.callArgs, &mm_params)
+	mmQueryAuditLog.QueryAuditLogMock.mutex.Unlock()
+
+	for _, e := range mmQueryAuditLog.QueryAuditLogMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmQueryAuditLog.QueryAuditLogMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmQueryAuditLog.QueryAuditLogMock.defaultExpectation.Counter, 1)
+		mm_want := mmQueryAuditLog.QueryAuditLogMock.defaultExpectation.params
+		mm_want_ptrs := mmQueryAuditLog.QueryAuditLogMock.defaultExpectation.paramPtrs
+
+		mm_got := OrderRepoFacadeMockQueryAuditLogParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmQueryAuditLog.t.Errorf("OrderRepoFacadeMock.QueryAuditLog got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmQueryAuditLog.QueryAuditLogMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmQueryAuditLog.t.Errorf("OrderRepoFacadeMock.QueryAuditLog got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmQueryAuditLog.QueryAuditLogMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmQueryAuditLog.t.Errorf("OrderRepoFacadeMock.QueryAuditLog got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmQueryAuditLog.QueryAuditLogMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmQueryAuditLog.QueryAuditLogMock.defaultExpectation.results
+		if mm_results == nil {
+			mmQueryAuditLog.t.Fatal("No results are set for the OrderRepoFacadeMock.QueryAuditLog")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmQueryAuditLog.funcQueryAuditLog != nil {
+		return mmQueryAuditLog.funcQueryAuditLog(ctx, filter)
+	}
+	mmQueryAuditLog.t.Fatalf("Unexpected call to OrderRepoFacadeMock.QueryAuditLog. %v %v", ctx, filter)
+	return
+}
+
+// QueryAuditLogAfterCounter returns a count of finished OrderRepoFacadeMock.QueryAuditLog invocations
+func (mmQueryAuditLog *OrderRepoFacadeMock) QueryAuditLogAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmQueryAuditLog.afterQueryAuditLogCounter)
+}
+
+// QueryAuditLogBeforeCounter returns a count of OrderRepoFacadeMock.QueryAuditLog invocations
+func (mmQueryAuditLog *OrderRepoFacadeMock) QueryAuditLogBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmQueryAuditLog.beforeQueryAuditLogCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrderRepoFacadeMock.QueryAuditLog.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmQueryAuditLog *mOrderRepoFacadeMockQueryAuditLog) Calls() []*OrderRepoFacadeMockQueryAuditLogParams {
+	mmQueryAuditLog.mutex.RLock()
+
+	argCopy := make([]*OrderRepoFacadeMockQueryAuditLogParams, len(mmQueryAuditLog.callArgs))
+	copy(argCopy, mmQueryAuditLog.callArgs)
+
+	mmQueryAuditLog.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockQueryAuditLogDone returns true if the count of the QueryAuditLog invocations corresponds
+// the number of defined expectations
+func (m *OrderRepoFacadeMock) MinimockQueryAuditLogDone() bool {
+	if m.QueryAuditLogMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.QueryAuditLogMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.QueryAuditLogMock.invocationsDone()
+}
+
+// MinimockQueryAuditLogInspect logs each unmet expectation
+func (m *OrderRepoFacadeMock) MinimockQueryAuditLogInspect() {
+	for _, e := range m.QueryAuditLogMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrderRepoFacadeMock.QueryAuditLog at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterQueryAuditLogCounter := mm_atomic.LoadUint64(&m.afterQueryAuditLogCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.QueryAuditLogMock.defaultExpectation != nil && afterQueryAuditLogCounter < 1 {
+		if m.QueryAuditLogMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderRepoFacadeMock.QueryAuditLog at\n%s", m.QueryAuditLogMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrderRepoFacadeMock.QueryAuditLog at\n%s with params: %#v", m.QueryAuditLogMock.defaultExpectation.expectationOrigins.origin, *m.QueryAuditLogMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcQueryAuditLog != nil && afterQueryAuditLogCounter < 1 {
+		m.t.Errorf("Expected call to OrderRepoFacadeMock.QueryAuditLog at\n%s", m.funcQueryAuditLogOrigin)
+	}
+
+	if !m.QueryAuditLogMock.invocationsDone() && afterQueryAuditLogCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderRepoFacadeMock.QueryAuditLog at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.QueryAuditLogMock.expectedInvocations), m.QueryAuditLogMock.expectedInvocationsOrigin, afterQueryAuditLogCounter)
+	}
+}
+
+type mOrderRepoFacadeMockUpdateOrder struct {
+	optional           bool
+	mock               *OrderRepoFacadeMock
+	defaultExpectation *OrderRepoFacadeMockUpdateOrderExpectation
+	expectations       []*OrderRepoFacadeMockUpdateOrderExpectation
+
+	callArgs []*OrderRepoFacadeMockUpdateOrderParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrderRepoFacadeMockUpdateOrderExpectation specifies expectation struct of the OrderRepoFacade.UpdateOrder
+type OrderRepoFacadeMockUpdateOrderExpectation struct {
+	mock               *OrderRepoFacadeMock
+	params             *OrderRepoFacadeMockUpdateOrderParams
+	paramPtrs          *OrderRepoFacadeMockUpdateOrderParamPtrs
+	expectationOrigins OrderRepoFacadeMockUpdateOrderExpectationOrigins
+	results            *OrderRepoFacadeMockUpdateOrderResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrderRepoFacadeMockUpdateOrderParams contains parameters of the OrderRepoFacade.UpdateOrder
+type OrderRepoFacadeMockUpdateOrderParams struct {
+	ctx      context.Context
+	orderDTO dto.OrderDTO
+}
+
+// OrderRepoFacadeMockUpdateOrderParamPtrs contains pointers to parameters of the OrderRepoFacade.UpdateOrder
+type OrderRepoFacadeMockUpdateOrderParamPtrs struct {
+	ctx      *context.Context
+	orderDTO *dto.OrderDTO
+}
+
+// OrderRepoFacadeMockUpdateOrderResults contains results of the OrderRepoFacade.UpdateOrder
+type OrderRepoFacadeMockUpdateOrderResults struct {
+	err error
+}
+
+// OrderRepoFacadeMockUpdateOrderOrigins contains origins of expectations of the OrderRepoFacade.UpdateOrder
+type OrderRepoFacadeMockUpdateOrderExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originOrderDTO string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateOrder *mOrderRepoFacadeMockUpdateOrder) Optional() *mOrderRepoFacadeMockUpdateOrder {
+	mmUpdateOrder.optional = true
+	return mmUpdateOrder
+}
+
+// Expect sets up expected params for OrderRepoFacade.UpdateOrder
+func (mmUpdateOrder *mOrderRepoFacadeMockUpdateOrder) Expect(ctx context.Context, orderDTO dto.OrderDTO) *mOrderRepoFacadeMockUpdateOrder {
+	if mmUpdateOrder.mock.funcUpdateOrder != nil {
+		mmUpdateOrder.mock.t.Fatalf("OrderRepoFacadeMock.UpdateOrder mock is already set by Set")
+	}
+
+	if mmUpdateOrder.defaultExpectation == nil {
+		mmUpdateOrder.defaultExpectation = &OrderRepoFacadeMockUpdateOrderExpectation{}
+	}
+
+	if mmUpdateOrder.defaultExpectation.paramPtrs != nil {
+		mmUpdateOrder.mock.t.Fatalf("OrderRepoFacadeMock.UpdateOrder mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateOrder.defaultExpectation.params = &OrderRepoFacadeMockUpdateOrderParams{ctx, orderDTO}
+	mmUpdateOrder.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateOrder.expectations {
+		if minimock.Equal(e.params, mmUpdateOrder.defaultExpectation.params) {
+			mmUpdateOrder.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateOrder.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateOrder
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrderRepoFacade.UpdateOrder
+func (mmUpdateOrder *mOrderRepoFacadeMockUpdateOrder) ExpectCtxParam1(ctx context.Context) *mOrderRepoFacadeMockUpdateOrder {
+	if mmUpdateOrder.mock.funcUpdateOrder != nil {
+		mmUpdateOrder.mock.t.Fatalf("OrderRepoFacadeMock.UpdateOrder mock is already set by Set")
+	}
+
+	if mmUpdateOrder.defaultExpectation == nil {
+		mmUpdateOrder.defaultExpectation = &OrderRepoFacadeMockUpdateOrderExpectation{}
+	}
+
+	if mmUpdateOrder.defaultExpectation.params != nil {
+		mmUpdateOrder.mock.t.Fatalf("OrderRepoFacadeMock.UpdateOrder mock is already set by Expect")
+	}
+
+	if mmUpdateOrder.defaultExpectation.paramPtrs == nil {
+		mmUpdateOrder.defaultExpectation.paramPtrs = &OrderRepoFacadeMockUpdateOrderParamPtrs{}
+	}
+	mmUpdateOrder.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateOrder.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateOrder
+}
+
+// ExpectOrderDTOParam2 sets up expected param orderDTO for OrderRepoFacade.UpdateOrder
+func (mmUpdateOrder *mOrderRepoFacadeMockUpdateOrder) ExpectOrderDTOParam2(orderDTO dto.OrderDTO) *mOrderRepoFacadeMockUpdateOrder {
+	if mmUpdateOrder.mock.funcUpdateOrder != nil {
+		mmUpdateOrder.mock.t.Fatalf("OrderRepoFacadeMock.UpdateOrder mock is already set by Set")
+	}
+
+	if mmUpdateOrder.defaultExpectation == nil {
+		mmUpdateOrder.defaultExpectation = &OrderRepoFacadeMockUpdateOrderExpectation{}
+	}
+
+	if mmUpdateOrder.defaultExpectation.params != nil {
+		mmUpdateOrder.mock.t.Fatalf("OrderRepoFacadeMock.UpdateOrder mock is already set by Expect")
+	}
+
+	if mmUpdateOrder.defaultExpectation.paramPtrs == nil {
+		mmUpdateOrder.defaultExpectation.paramPtrs = &OrderRepoFacadeMockUpdateOrderParamPtrs{}
+	}
+	mmUpdateOrder.defaultExpectation.paramPtrs.orderDTO = &orderDTO
+	mmUpdateOrder.defaultExpectation.expectationOrigins.originOrderDTO = minimock.CallerInfo(1)
+
+	return mmUpdateOrder
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrderRepoFacade.UpdateOrder
+func (mmUpdateOrder *mOrderRepoFacadeMockUpdateOrder) Inspect(f func(ctx context.Context, orderDTO dto.OrderDTO)) *mOrderRepoFacadeMockUpdateOrder {
+	if mmUpdateOrder.mock.inspectFuncUpdateOrder != nil {
+		mmUpdateOrder.mock.t.Fatalf("Inspect function is already set for OrderRepoFacadeMock.UpdateOrder")
+	}
+
+	mmUpdateOrder.mock.inspectFuncUpdateOrder = f
+
+	return mmUpdateOrder
+}
+
+// Return sets up results that will be returned by OrderRepoFacade.UpdateOrder
+func (mmUpdateOrder *mOrderRepoFacadeMockUpdateOrder) Return(err error) *OrderRepoFacadeMock {
+	if mmUpdateOrder.mock.funcUpdateOrder != nil {
+		mmUpdateOrder.mock.t.Fatalf("OrderRepoFacadeMock.UpdateOrder mock is already set by Set")
+	}
+
+	if mmUpdateOrder.defaultExpectation == nil {
+		mmUpdateOrder.defaultExpectation = &OrderRepoFacadeMockUpdateOrderExpectation{mock: mmUpdateOrder.mock}
+	}
+	mmUpdateOrder.defaultExpectation.results = &OrderRepoFacadeMockUpdateOrderResults{err}
+	mmUpdateOrder.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateOrder.mock
+}
+
+// Set uses given function f to mock the OrderRepoFacade.UpdateOrder method
+func (mmUpdateOrder *mOrderRepoFacadeMockUpdateOrder) Set(f func(ctx context.Context, orderDTO dto.OrderDTO) (err error)) *OrderRepoFacadeMock {
+	if mmUpdateOrder.defaultExpectation != nil {
+		mmUpdateOrder.mock.t.Fatalf("Default expectation is already set for the OrderRepoFacade.UpdateOrder method")
+	}
+
+	if len(mmUpdateOrder.expectations) > 0 {
+		mmUpdateOrder.mock.t.Fatalf("Some expectations are already set for the OrderRepoFacade.UpdateOrder method")
+	}
+
+	mmUpdateOrder.mock.funcUpdateOrder = f
+	mmUpdateOrder.mock.funcUpdateOrderOrigin = minimock.CallerInfo(1)
+	return mmUpdateOrder.mock
+}
+
+// When sets expectation for the OrderRepoFacade.UpdateOrder which will trigger the result defined by the following
+// Then helper
+func (mmUpdateOrder *mOrderRepoFacadeMockUpdateOrder) When(ctx context.Context, orderDTO dto.OrderDTO) *OrderRepoFacadeMockUpdateOrderExpectation {
+	if mmUpdateOrder.mock.funcUpdateOrder != nil {
+		mmUpdateOrder.mock.t.Fatalf("OrderRepoFacadeMock.UpdateOrder mock is already set by Set")
+	}
+
+	expectation := &OrderRepoFacadeMockUpdateOrderExpectation{
+		mock:               mmUpdateOrder.mock,
+		params:             &OrderRepoFacadeMockUpdateOrderParams{ctx, orderDTO},
+		expectationOrigins: OrderRepoFacadeMockUpdateOrderExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateOrder.expectations = append(mmUpdateOrder.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrderRepoFacade.UpdateOrder return parameters for the expectation previously defined by the When method
+func (e *OrderRepoFacadeMockUpdateOrderExpectation) Then(err error) *OrderRepoFacadeMock {
+	e.results = &OrderRepoFacadeMockUpdateOrderResults{err}
+	return e.mock
+}
+
+// Times sets number of times OrderRepoFacade.UpdateOrder should be invoked
+func (mmUpdateOrder *mOrderRepoFacadeMockUpdateOrder) Times(n uint64) *mOrderRepoFacadeMockUpdateOrder {
+	if n == 0 {
+		mmUpdateOrder.mock.t.Fatalf("Times of OrderRepoFacadeMock.UpdateOrder mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateOrder.expectedInvocations, n)
+	mmUpdateOrder.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateOrder
+}
+
+func (mmUpdateOrder *mOrderRepoFacadeMockUpdateOrder) invocationsDone() bool {
+	if len(mmUpdateOrder.expectations) == 0 && mmUpdateOrder.defaultExpectation == nil && mmUpdateOrder.mock.funcUpdateOrder == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateOrder.mock.afterUpdateOrderCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateOrder.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateOrder implements mm_usecase.OrderRepoFacade
+func (mmUpdateOrder *OrderRepoFacadeMock) UpdateOrder(ctx context.Context, orderDTO dto.OrderDTO) (err error) {
+	mm_atomic.AddUint64(&mmUpdateOrder.beforeUpdateOrderCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateOrder.afterUpdateOrderCounter, 1)
+
+	mmUpdateOrder.t.Helper()
+
+	if mmUpdateOrder.inspectFuncUpdateOrder != nil {
+		mmUpdateOrder.inspectFuncUpdateOrder(ctx, orderDTO)
+	}
+
+	mm_params := OrderRepoFacadeMockUpdateOrderParams{ctx, orderDTO}
+
+	// Record call args
+	mmUpdateOrder.UpdateOrderMock.mutex.Lock()
+	mmUpdateOrder.UpdateOrderMock.callArgs = append(mmUpdateOrder.UpdateOrderMock.callArgs, &mm_params)
+	mmUpdateOrder.UpdateOrderMock.mutex.Unlock()
+
+	for _, e := range mmUpdateOrder.UpdateOrderMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdateOrder.UpdateOrderMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateOrder.UpdateOrderMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateOrder.UpdateOrderMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateOrder.UpdateOrderMock.defaultExpectation.paramPtrs
+
+		mm_got := OrderRepoFacadeMockUpdateOrderParams{ctx, orderDTO}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateOrder.t.Errorf("OrderRepoFacadeMock.UpdateOrder got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateOrder.UpdateOrderMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.orderDTO != nil && !minimock.Equal(*mm_want_ptrs.orderDTO, mm_got.orderDTO) {
+				mmUpdateOrder.t.Errorf("OrderRepoFacadeMock.UpdateOrder got unexpected parameter orderDTO, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateOrder.UpdateOrderMock.defaultExpectation.expectationOrigins.originOrderDTO, *mm_want_ptrs.orderDTO, mm_got.orderDTO, minimock.Diff(*mm_want_ptrs.orderDTO, mm_got.orderDTO))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateOrder.t.Errorf("OrderRepoFacadeMock.UpdateOrder got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateOrder.UpdateOrderMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateOrder.UpdateOrderMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateOrder.t.Fatal("No results are set for the OrderRepoFacadeMock.UpdateOrder")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdateOrder.funcUpdateOrder != nil {
+		return mmUpdateOrder.funcUpdateOrder(ctx, orderDTO)
+	}
+	mmUpdateOrder.t.Fatalf("Unexpected call to OrderRepoFacadeMock.UpdateOrder. %v %v", ctx, orderDTO)
+	return
+}
+
+// UpdateOrderAfterCounter returns a count of finished OrderRepoFacadeMock.UpdateOrder invocations
+func (mmUpdateOrder *OrderRepoFacadeMock) UpdateOrderAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateOrder.afterUpdateOrderCounter)
+}
+
+// UpdateOrderBeforeCounter returns a count of OrderRepoFacadeMock.UpdateOrder invocations
+func (mmUpdateOrder *OrderRepoFacadeMock) UpdateOrderBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateOrder.beforeUpdateOrderCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrderRepoFacadeMock.UpdateOrder.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateOrder *mOrderRepoFacadeMockUpdateOrder) Calls() []*OrderRepoFacadeMockUpdateOrderParams {
+	mmUpdateOrder.mutex.RLock()
+
+	argCopy := make([]*OrderRepoFacadeMockUpdateOrderParams, len(mmUpdateOrder.callArgs))
+	copy(argCopy, mmUpdateOrder.callArgs)
+
+	mmUpdateOrder.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateOrderDone returns true if the count of the UpdateOrder invocations corresponds
+// the number of defined expectations
+func (m *OrderRepoFacadeMock) MinimockUpdateOrderDone() bool {
+	if m.UpdateOrderMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateOrderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateOrderMock.invocationsDone()
+}
+
+// MinimockUpdateOrderInspect logs each unmet expectation
+func (m *OrderRepoFacadeMock) MinimockUpdateOrderInspect() {
+	for _, e := range m.UpdateOrderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrderRepoFacadeMock.UpdateOrder at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateOrderCounter := mm_atomic.LoadUint64(&m.afterUpdateOrderCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateOrderMock.defaultExpectation != nil && afterUpdateOrderCounter < 1 {
+		if m.UpdateOrderMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderRepoFacadeMock.UpdateOrder at\n%s", m.UpdateOrderMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrderRepoFacadeMock.UpdateOrder at\n%s with params: %#v", m.UpdateOrderMock.defaultExpectation.expectationOrigins.origin, *m.UpdateOrderMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateOrder != nil && afterUpdateOrderCounter < 1 {
+		m.t.Errorf("Expected call to OrderRepoFacadeMock.UpdateOrder at\n%s", m.funcUpdateOrderOrigin)
+	}
+
+	if !m.UpdateOrderMock.invocationsDone() && afterUpdateOrderCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderRepoFacadeMock.UpdateOrder at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateOrderMock.expectedInvocations), m.UpdateOrderMock.expectedInvocationsOrigin, afterUpdateOrderCounter)
+	}
+}
+
+type mOrderRepoFacadeMockUpdateOrders struct {
+	optional           bool
+	mock               *OrderRepoFacadeMock
+	defaultExpectation *OrderRepoFacadeMockUpdateOrdersExpectation
+	expectations       []*OrderRepoFacadeMockUpdateOrdersExpectation
+
+	callArgs []*OrderRepoFacadeMockUpdateOrdersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrderRepoFacadeMockUpdateOrdersExpectation specifies expectation struct of the OrderRepoFacade.UpdateOrders
+type OrderRepoFacadeMockUpdateOrdersExpectation struct {
+	mock               *OrderRepoFacadeMock
+	params             *OrderRepoFacadeMockUpdateOrdersParams
+	paramPtrs          *OrderRepoFacadeMockUpdateOrdersParamPtrs
+	expectationOrigins OrderRepoFacadeMockUpdateOrdersExpectationOrigins
+	results            *OrderRepoFacadeMockUpdateOrdersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrderRepoFacadeMockUpdateOrdersParams contains parameters of the OrderRepoFacade.UpdateOrders
+type OrderRepoFacadeMockUpdateOrdersParams struct {
+	ctx       context.Context
+	ordersDTO []dto.OrderDTO
+}
+
+// OrderRepoFacadeMockUpdateOrdersParamPtrs contains pointers to parameters of the OrderRepoFacade.UpdateOrders
+type OrderRepoFacadeMockUpdateOrdersParamPtrs struct {
+	ctx       *context.Context
+	ordersDTO *[]dto.OrderDTO
+}
+
+// OrderRepoFacadeMockUpdateOrdersResults contains results of the OrderRepoFacade.UpdateOrders
+type OrderRepoFacadeMockUpdateOrdersResults struct {
+	err error
+}
+
+// OrderRepoFacadeMockUpdateOrdersOrigins contains origins of expectations of the OrderRepoFacade.UpdateOrders
+type OrderRepoFacadeMockUpdateOrdersExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originOrdersDTO string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateOrders *mOrderRepoFacadeMockUpdateOrders) Optional() *mOrderRepoFacadeMockUpdateOrders {
+	mmUpdateOrders.optional = true
+	return mmUpdateOrders
+}
+
+// Expect sets up expected params for OrderRepoFacade.UpdateOrders
+func (mmUpdateOrders *mOrderRepoFacadeMockUpdateOrders) Expect(ctx context.Context, ordersDTO []dto.OrderDTO) *mOrderRepoFacadeMockUpdateOrders {
+	if mmUpdateOrders.mock.funcUpdateOrders != nil {
+		mmUpdateOrders.mock.t.Fatalf("OrderRepoFacadeMock.UpdateOrders mock is already set by Set")
+	}
+
+	if mmUpdateOrders.defaultExpectation == nil {
+		mmUpdateOrders.defaultExpectation = &OrderRepoFacadeMockUpdateOrdersExpectation{}
+	}
+
+	if mmUpdateOrders.defaultExpectation.paramPtrs != nil {
+		mmUpdateOrders.mock.t.Fatalf("OrderRepoFacadeMock.UpdateOrders mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateOrders.defaultExpectation.params = &OrderRepoFacadeMockUpdateOrdersParams{ctx, ordersDTO}
+	mmUpdateOrders.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateOrders.expectations {
+		if minimock.Equal(e.params, mmUpdateOrders.defaultExpectation.params) {
+			mmUpdateOrders.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateOrders.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateOrders
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrderRepoFacade.UpdateOrders
+func (mmUpdateOrders *mOrderRepoFacadeMockUpdateOrders) ExpectCtxParam1(ctx context.Context) *mOrderRepoFacadeMockUpdateOrders {
+	if mmUpdateOrders.mock.funcUpdateOrders != nil {
+		mmUpdateOrders.mock.t.Fatalf("OrderRepoFacadeMock.UpdateOrders mock is already set by Set")
+	}
+
+	if mmUpdateOrders.defaultExpectation == nil {
+		mmUpdateOrders.defaultExpectation = &OrderRepoFacadeMockUpdateOrdersExpectation{}
+	}
+
+	if mmUpdateOrders.defaultExpectation.params != nil {
+		mmUpdateOrders.mock.t.Fatalf("OrderRepoFacadeMock.UpdateOrders mock is already set by Expect")
+	}
+
+	if mmUpdateOrders.defaultExpectation.paramPtrs == nil {
+		mmUpdateOrders.defaultExpectation.paramPtrs = &OrderRepoFacadeMockUpdateOrdersParamPtrs{}
+	}
+	mmUpdateOrders.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateOrders.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateOrders
+}
+
+// ExpectOrdersDTOParam2 sets up expected param ordersDTO for OrderRepoFacade.UpdateOrders
+func (mmUpdateOrders *mOrderRepoFacadeMockUpdateOrders) ExpectOrdersDTOParam2(ordersDTO []dto.OrderDTO) *mOrderRepoFacadeMockUpdateOrders {
+	if mmUpdateOrders.mock.funcUpdateOrders != nil {
+		mmUpdateOrders.mock.t.Fatalf("OrderRepoFacadeMock.UpdateOrders mock is already set by Set")
+	}
+
+	if mmUpdateOrders.defaultExpectation == nil {
+		mmUpdateOrders.defaultExpectation = &OrderRepoFacadeMockUpdateOrdersExpectation{}
+	}
+
+	if mmUpdateOrders.defaultExpectation.params != nil {
+		mmUpdateOrders.mock.t.Fatalf("OrderRepoFacadeMock.UpdateOrders mock is already set by Expect")
+	}
+
+	if mmUpdateOrders.defaultExpectation.paramPtrs == nil {
+		mmUpdateOrders.defaultExpectation.paramPtrs = &OrderRepoFacadeMockUpdateOrdersParamPtrs{}
+	}
+	mmUpdateOrders.defaultExpectation.paramPtrs.ordersDTO = &ordersDTO
+	mmUpdateOrders.defaultExpectation.expectationOrigins.originOrdersDTO = minimock.CallerInfo(1)
+
+	return mmUpdateOrders
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrderRepoFacade.UpdateOrders
+func (mmUpdateOrders *mOrderRepoFacadeMockUpdateOrders) Inspect(f func(ctx context.Context, ordersDTO []dto.OrderDTO)) *mOrderRepoFacadeMockUpdateOrders {
+	if mmUpdateOrders.mock.inspectFuncUpdateOrders != nil {
+		mmUpdateOrders.mock.t.Fatalf("Inspect function is already set for OrderRepoFacadeMock.UpdateOrders")
+	}
+
+	mmUpdateOrders.mock.inspectFuncUpdateOrders = f
+
+	return mmUpdateOrders
+}
+
+// Return sets up results that will be returned by OrderRepoFacade.UpdateOrders
+func (mmUpdateOrders *mOrderRepoFacadeMockUpdateOrders) Return(err error) *OrderRepoFacadeMock {
+	if mmUpdateOrders.mock.funcUpdateOrders != nil {
+		mmUpdateOrders.mock.t.Fatalf("OrderRepoFacadeMock.UpdateOrders mock is already set by Set")
+	}
+
+	if mmUpdateOrders.defaultExpectation == nil {
+		mmUpdateOrders.defaultExpectation = &OrderRepoFacadeMockUpdateOrdersExpectation{mock: mmUpdateOrders.mock}
+	}
+	mmUpdateOrders.defaultExpectation.results = &OrderRepoFacadeMockUpdateOrdersResults{err}
+	mmUpdateOrders.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateOrders.mock
+}
+
+// Set uses given function f to mock the OrderRepoFacade.UpdateOrders method
+func (mmUpdateOrders *mOrderRepoFacadeMockUpdateOrders) Set(f func(ctx context.Context, ordersDTO []dto.OrderDTO) (err error)) *OrderRepoFacadeMock {
+	if mmUpdateOrders.defaultExpectation != nil {
+		mmUpdateOrders.mock.t.Fatalf("Default expectation is already set for the OrderRepoFacade.UpdateOrders method")
+	}
+
+	if len(mmUpdateOrders.expectations) > 0 {
+		mmUpdateOrders.mock.t.Fatalf("Some expectations are already set for the OrderRepoFacade.UpdateOrders method")
+	}
+
+	mmUpdateOrders.mock.funcUpdateOrders = f
+	mmUpdateOrders.mock.funcUpdateOrdersOrigin = minimock.CallerInfo(1)
+	return mmUpdateOrders.mock
+}
+
+// When sets expectation for the OrderRepoFacade.UpdateOrders which will trigger the result defined by the following
+// Then helper
+func (mmUpdateOrders *mOrderRepoFacadeMockUpdateOrders) When(ctx context.Context, ordersDTO []dto.OrderDTO) *OrderRepoFacadeMockUpdateOrdersExpectation {
+	if mmUpdateOrders.mock.funcUpdateOrders != nil {
+		mmUpdateOrders.mock.t.Fatalf("OrderRepoFacadeMock.UpdateOrders mock is already set by Set")
+	}
+
+	expectation := &OrderRepoFacadeMockUpdateOrdersExpectation{
+		mock:               mmUpdateOrders.mock,
+		params:             &OrderRepoFacadeMockUpdateOrdersParams{ctx, ordersDTO},
+		expectationOrigins: OrderRepoFacadeMockUpdateOrdersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateOrders.expectations = append(mmUpdateOrders.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrderRepoFacade.UpdateOrders return parameters for the expectation previously defined by the When method
+func (e *OrderRepoFacadeMockUpdateOrdersExpectation) Then(err error) *OrderRepoFacadeMock {
+	e.results = &OrderRepoFacadeMockUpdateOrdersResults{err}
+	return e.mock
+}
+
+// Times sets number of times OrderRepoFacade.UpdateOrders should be invoked
+func (mmUpdateOrders *mOrderRepoFacadeMockUpdateOrders) Times(n uint64) *mOrderRepoFacadeMockUpdateOrders {
+	if n == 0 {
+		mmUpdateOrders.mock.t.Fatalf("Times of OrderRepoFacadeMock.UpdateOrders mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateOrders.expectedInvocations, n)
+	mmUpdateOrders.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateOrders
+}
+
+func (mmUpdateOrders *mOrderRepoFacadeMockUpdateOrders) invocationsDone() bool {
+	if len(mmUpdateOrders.expectations) == 0 && mmUpdateOrders.defaultExpectation == nil && mmUpdateOrders.mock.funcUpdateOrders == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateOrders.mock.afterUpdateOrdersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateOrders.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateOrders implements mm_usecase.OrderRepoFacade
+func (mmUpdateOrders *OrderRepoFacadeMock) UpdateOrders(ctx context.Context, ordersDTO []dto.OrderDTO) (err error) {
+	mm_atomic.AddUint64(&mmUpdateOrders.beforeUpdateOrdersCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateOrders.afterUpdateOrdersCounter, 1)
+
+	mmUpdateOrders.t.Helper()
+
+	if mmUpdateOrders.inspectFuncUpdateOrders != nil {
+		mmUpdateOrders.inspectFuncUpdateOrders(ctx, ordersDTO)
+	}
+
+	mm_params := OrderRepoFacadeMockUpdateOrdersParams{ctx, ordersDTO}
+
+	// Record call args
+	mmUpdateOrders.UpdateOrdersMock.mutex.Lock()
+	mmUpdateOrders.UpdateOrdersMock.callArgs = append(mmUpdateOrders.UpdateOrdersMock.callArgs, &mm_params)
+	mmUpdateOrders.UpdateOrdersMock.mutex.Unlock()
+
+	for _, e := range mmUpdateOrders.UpdateOrdersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdateOrders.UpdateOrdersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateOrders.UpdateOrdersMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateOrders.UpdateOrdersMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateOrders.UpdateOrdersMock.defaultExpectation.paramPtrs
+
+		mm_got := OrderRepoFacadeMockUpdateOrdersParams{ctx, ordersDTO}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateOrders.t.Errorf("OrderRepoFacadeMock.UpdateOrders got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateOrders.UpdateOrdersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.ordersDTO != nil && !minimock.Equal(*mm_want_ptrs.ordersDTO, mm_got.ordersDTO) {
+				mmUpdateOrders.t.Errorf("OrderRepoFacadeMock.UpdateOrders got unexpected parameter ordersDTO, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateOrders.UpdateOrdersMock.defaultExpectation.expectationOrigins.originOrdersDTO, *mm_want_ptrs.ordersDTO, mm_got.ordersDTO, minimock.Diff(*mm_want_ptrs.ordersDTO, mm_got.ordersDTO))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateOrders.t.Errorf("OrderRepoFacadeMock.UpdateOrders got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateOrders.UpdateOrdersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateOrders.UpdateOrdersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateOrders.t.Fatal("No results are set for the OrderRepoFacadeMock.UpdateOrders")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdateOrders.funcUpdateOrders != nil {
+		return mmUpdateOrders.funcUpdateOrders(ctx, ordersDTO)
+	}
+	mmUpdateOrders.t.Fatalf("Unexpected call to OrderRepoFacadeMock.UpdateOrders. %v %v", ctx, ordersDTO)
+	return
+}
+
+// UpdateOrdersAfterCounter returns a count of finished OrderRepoFacadeMock.UpdateOrders invocations
+func (mmUpdateOrders *OrderRepoFacadeMock) UpdateOrdersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateOrders.afterUpdateOrdersCounter)
+}
+
+// UpdateOrdersBeforeCounter returns a count of OrderRepoFacadeMock.UpdateOrders invocations
+func (mmUpdateOrders *OrderRepoFacadeMock) UpdateOrdersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateOrders.beforeUpdateOrdersCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrderRepoFacadeMock.UpdateOrders.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateOrders *mOrderRepoFacadeMockUpdateOrders) Calls() []*OrderRepoFacadeMockUpdateOrdersParams {
+	mmUpdateOrders.mutex.RLock()
+
+	argCopy := make([]*OrderRepoFacadeMockUpdateOrdersParams, len(mmUpdateOrders.callArgs))
+	copy(argCopy, mmUpdateOrders.callArgs)
+
+	mmUpdateOrders.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateOrdersDone returns true if the count of the UpdateOrders invocations corresponds
+// the number of defined expectations
+func (m *OrderRepoFacadeMock) MinimockUpdateOrdersDone() bool {
+	if m.UpdateOrdersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateOrdersMock.invocationsDone()
+}
+
+// MinimockUpdateOrdersInspect logs each unmet expectation
+func (m *OrderRepoFacadeMock) MinimockUpdateOrdersInspect() {
+	for _, e := range m.UpdateOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrderRepoFacadeMock.UpdateOrders at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateOrdersCounter := mm_atomic.LoadUint64(&m.afterUpdateOrdersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateOrdersMock.defaultExpectation != nil && afterUpdateOrdersCounter < 1 {
+		if m.UpdateOrdersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderRepoFacadeMock.UpdateOrders at\n%s", m.UpdateOrdersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrderRepoFacadeMock.UpdateOrders at\n%s with params: %#v", m.UpdateOrdersMock.defaultExpectation.expectationOrigins.origin, *m.UpdateOrdersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateOrders != nil && afterUpdateOrdersCounter < 1 {
+		m.t.Errorf("Expected call to OrderRepoFacadeMock.UpdateOrders at\n%s", m.funcUpdateOrdersOrigin)
+	}
+
+	if !m.UpdateOrdersMock.invocationsDone() && afterUpdateOrdersCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderRepoFacadeMock.UpdateOrders at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateOrdersMock.expectedInvocations), m.UpdateOrdersMock.expectedInvocationsOrigin, afterUpdateOrdersCounter)
+	}
+}
+
+type mOrderRepoFacadeMockWithinTx struct {
+	optional           bool
+	mock               *OrderRepoFacadeMock
+	defaultExpectation *OrderRepoFacadeMockWithinTxExpectation
+	expectations       []*OrderRepoFacadeMockWithinTxExpectation
+
+	callArgs []*OrderRepoFacadeMockWithinTxParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrderRepoFacadeMockWithinTxExpectation specifies expectation struct of the OrderRepoFacade.WithinTx
+type OrderRepoFacadeMockWithinTxExpectation struct {
+	mock               *OrderRepoFacadeMock
+	params             *OrderRepoFacadeMockWithinTxParams
+	paramPtrs          *OrderRepoFacadeMockWithinTxParamPtrs
+	expectationOrigins OrderRepoFacadeMockWithinTxExpectationOrigins
+	results            *OrderRepoFacadeMockWithinTxResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrderRepoFacadeMockWithinTxParams contains parameters of the OrderRepoFacade.WithinTx
+type OrderRepoFacadeMockWithinTxParams struct {
+	ctx context.Context
+	fn  func(ctxTx context.Context) error
+}
+
+// OrderRepoFacadeMockWithinTxParamPtrs contains pointers to parameters of the OrderRepoFacade.WithinTx
+type OrderRepoFacadeMockWithinTxParamPtrs struct {
+	ctx *context.Context
+	fn  *func(ctxTx context.Context) error
+}
+
+// OrderRepoFacadeMockWithinTxResults contains results of the OrderRepoFacade.WithinTx
+type OrderRepoFacadeMockWithinTxResults struct {
+	err error
+}
+
+// OrderRepoFacadeMockWithinTxOrigins contains origins of expectations of the OrderRepoFacade.WithinTx
+type OrderRepoFacadeMockWithinTxExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originFn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmWithinTx *mOrderRepoFacadeMockWithinTx) Optional() *mOrderRepoFacadeMockWithinTx {
+	mmWithinTx.optional = true
+	return mmWithinTx
+}
+
+// Expect sets up expected params for OrderRepoFacade.WithinTx
+func (mmWithinTx *mOrderRepoFacadeMockWithinTx) Expect(ctx context.Context, fn func(ctxTx context.Context) error) *mOrderRepoFacadeMockWithinTx {
+	if mmWithinTx.mock.funcWithinTx != nil {
+		mmWithinTx.mock.t.Fatalf("OrderRepoFacadeMock.WithinTx mock is already set by Set")
+	}
+
+	if mmWithinTx.defaultExpectation == nil {
+		mmWithinTx.defaultExpectation = &OrderRepoFacadeMockWithinTxExpectation{}
+	}
+
+	if mmWithinTx.defaultExpectation.paramPtrs != nil {
+		mmWithinTx.mock.t.Fatalf("OrderRepoFacadeMock.WithinTx mock is already set by ExpectParams functions")
+	}
+
+	mmWithinTx.defaultExpectation.params = &OrderRepoFacadeMockWithinTxParams{ctx, fn}
+	mmWithinTx.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmWithinTx.expectations {
+		if minimock.Equal(e.params, mmWithinTx.defaultExpectation.params) {
+			mmWithinTx.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmWithinTx.defaultExpectation.params)
+		}
+	}
+
+	return mmWithinTx
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrderRepoFacade.WithinTx
+func (mmWithinTx *mOrderRepoFacadeMockWithinTx) ExpectCtxParam1(ctx context.Context) *mOrderRepoFacadeMockWithinTx {
+	if mmWithinTx.mock.funcWithinTx != nil {
+		mmWithinTx.mock.t.Fatalf("OrderRepoFacadeMock.WithinTx mock is already set by Set")
+	}
+
+	if mmWithinTx.defaultExpectation == nil {
+		mmWithinTx.defaultExpectation = &OrderRepoFacadeMockWithinTxExpectation{}
+	}
+
+	if mmWithinTx.defaultExpectation.params != nil {
+		mmWithinTx.mock.t.Fatalf("OrderRepoFacadeMock.WithinTx mock is already set by Expect")
+	}
+
+	if mmWithinTx.defaultExpectation.paramPtrs == nil {
+		mmWithinTx.defaultExpectation.paramPtrs = &OrderRepoFacadeMockWithinTxParamPtrs{}
+	}
+	mmWithinTx.defaultExpectation.paramPtrs.ctx = &ctx
+	mmWithinTx.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmWithinTx
+}
+
+// ExpectFnParam2 sets up expected param fn for OrderRepoFacade.WithinTx
+func (mmWithinTx *mOrderRepoFacadeMockWithinTx) ExpectFnParam2(fn func(ctxTx context.Context) error) *mOrderRepoFacadeMockWithinTx {
+	if mmWithinTx.mock.funcWithinTx != nil {
+		mmWithinTx.mock.t.Fatalf("OrderRepoFacadeMock.WithinTx mock is already set by Set")
+	}
+
+	if mmWithinTx.defaultExpectation == nil {
+		mmWithinTx.defaultExpectation = &OrderRepoFacadeMockWithinTxExpectation{}
+	}
+
+	if mmWithinTx.defaultExpectation.params != nil {
+		mmWithinTx.mock.t.Fatalf("OrderRepoFacadeMock.WithinTx mock is already set by Expect")
+	}
+
+	if mmWithinTx.defaultExpectation.paramPtrs == nil {
+		mmWithinTx.defaultExpectation.paramPtrs = &OrderRepoFacadeMockWithinTxParamPtrs{}
+	}
+	mmWithinTx.defaultExpectation.paramPtrs.fn = &fn
+	mmWithinTx.defaultExpectation.expectationOrigins.originFn = minimock.CallerInfo(1)
+
+	return mmWithinTx
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrderRepoFacade.WithinTx
+func (mmWithinTx *mOrderRepoFacadeMockWithinTx) Inspect(f func(ctx context.Context, fn func(ctxTx context.Context) error)) *mOrderRepoFacadeMockWithinTx {
+	if mmWithinTx.mock.inspectFuncWithinTx != nil {
+		mmWithinTx.mock.t.Fatalf("Inspect function is already set for OrderRepoFacadeMock.WithinTx")
+	}
+
+	mmWithinTx.mock.inspectFuncWithinTx = f
+
+	return mmWithinTx
+}
+
+// Return sets up results that will be returned by OrderRepoFacade.WithinTx
+func (mmWithinTx *mOrderRepoFacadeMockWithinTx) Return(err error) *OrderRepoFacadeMock {
+	if mmWithinTx.mock.funcWithinTx != nil {
+		mmWithinTx.mock.t.Fatalf("OrderRepoFacadeMock.WithinTx mock is already set by Set")
+	}
+
+	if mmWithinTx.defaultExpectation == nil {
+		mmWithinTx.defaultExpectation = &OrderRepoFacadeMockWithinTxExpectation{mock: mmWithinTx.mock}
+	}
+	mmWithinTx.defaultExpectation.results = &OrderRepoFacadeMockWithinTxResults{err}
+	mmWithinTx.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmWithinTx.mock
+}
+
+// Set uses given function f to mock the OrderRepoFacade.WithinTx method
+func (mmWithinTx *mOrderRepoFacadeMockWithinTx) Set(f func(ctx context.Context, fn func(ctxTx context.Context) error) (err error)) *OrderRepoFacadeMock {
+	if mmWithinTx.defaultExpectation != nil {
+		mmWithinTx.mock.t.Fatalf("Default expectation is already set for the OrderRepoFacade.WithinTx method")
+	}
+
+	if len(mmWithinTx.expectations) > 0 {
+		mmWithinTx.mock.t.Fatalf("Some expectations are already set for the OrderRepoFacade.WithinTx method")
+	}
+
+	mmWithinTx.mock.funcWithinTx = f
+	mmWithinTx.mock.funcWithinTxOrigin = minimock.CallerInfo(1)
+	return mmWithinTx.mock
+}
+
+// When sets expectation for the OrderRepoFacade.WithinTx which will trigger the result defined by the following
+// Then helper
+func (mmWithinTx *mOrderRepoFacadeMockWithinTx) When(ctx context.Context, fn func(ctxTx context.Context) error) *OrderRepoFacadeMockWithinTxExpectation {
+	if mmWithinTx.mock.funcWithinTx != nil {
+		mmWithinTx.mock.t.Fatalf("OrderRepoFacadeMock.WithinTx mock is already set by Set")
+	}
+
+	expectation := &OrderRepoFacadeMockWithinTxExpectation{
+		mock:               mmWithinTx.mock,
+		params:             &OrderRepoFacadeMockWithinTxParams{ctx, fn},
+		expectationOrigins: OrderRepoFacadeMockWithinTxExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmWithinTx.expectations = append(mmWithinTx.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrderRepoFacade.WithinTx return parameters for the expectation previously defined by the When method
+func (e *OrderRepoFacadeMockWithinTxExpectation) Then(err error) *OrderRepoFacadeMock {
+	e.results = &OrderRepoFacadeMockWithinTxResults{err}
+	return e.mock
+}
+
+// Times sets number of times OrderRepoFacade.WithinTx should be invoked
+func (mmWithinTx *mOrderRepoFacadeMockWithinTx) Times(n uint64) *mOrderRepoFacadeMockWithinTx {
+	if n == 0 {
+		mmWithinTx.mock.t.Fatalf("Times of OrderRepoFacadeMock.WithinTx mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmWithinTx.expectedInvocations, n)
+	mmWithinTx.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmWithinTx
+}
+
+func (mmWithinTx *mOrderRepoFacadeMockWithinTx) invocationsDone() bool {
+	if len(mmWithinTx.expectations) == 0 && mmWithinTx.defaultExpectation == nil && mmWithinTx.mock.funcWithinTx == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmWithinTx.mock.afterWithinTxCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmWithinTx.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// WithinTx implements mm_usecase.OrderRepoFacade
+func (mmWithinTx *OrderRepoFacadeMock) WithinTx(ctx context.Context, fn func(ctxTx context.Context) error) (err error) {
+	mm_atomic.AddUint64(&mmWithinTx.beforeWithinTxCounter, 1)
+	defer mm_atomic.AddUint64(&mmWithinTx.afterWithinTxCounter, 1)
+
+	mmWithinTx.t.Helper()
+
+	if mmWithinTx.inspectFuncWithinTx != nil {
+		mmWithinTx.inspectFuncWithinTx(ctx, fn)
+	}
+
+	mm_params := OrderRepoFacadeMockWithinTxParams{ctx, fn}
+
+	// Record call args
+	mmWithinTx.WithinTxMock.mutex.Lock()
+	mmWithinTx.WithinTxMock.callArgs = append(mmWithinTx.WithinTxMock.callArgs, &mm_params)
+	mmWithinTx.WithinTxMock.mutex.Unlock()
+
+	for _, e := range mmWithinTx.WithinTxMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmWithinTx.WithinTxMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmWithinTx.WithinTxMock.defaultExpectation.Counter, 1)
+		mm_want := mmWithinTx.WithinTxMock.defaultExpectation.params
+		mm_want_ptrs := mmWithinTx.WithinTxMock.defaultExpectation.paramPtrs
+
+		mm_got := OrderRepoFacadeMockWithinTxParams{ctx, fn}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmWithinTx.t.Errorf("OrderRepoFacadeMock.WithinTx got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWithinTx.WithinTxMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.fn != nil && !minimock.Equal(*mm_want_ptrs.fn, mm_got.fn) {
+				mmWithinTx.t.Errorf("OrderRepoFacadeMock.WithinTx got unexpected parameter fn, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWithinTx.WithinTxMock.defaultExpectation.expectationOrigins.originFn, *mm_want_ptrs.fn, mm_got.fn, minimock.Diff(*mm_want_ptrs.fn, mm_got.fn))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmWithinTx.t.Errorf("OrderRepoFacadeMock.WithinTx got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmWithinTx.WithinTxMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmWithinTx.WithinTxMock.defaultExpectation.results
+		if mm_results == nil {
+			mmWithinTx.t.Fatal("No results are set for the OrderRepoFacadeMock.WithinTx")
+		}
+		return (*mm_results).err
+	}
+	if mmWithinTx.funcWithinTx != nil {
+		return mmWithinTx.funcWithinTx(ctx, fn)
+	}
+	mmWithinTx.t.Fatalf("Unexpected call to OrderRepoFacadeMock.WithinTx. %v %v", ctx, fn)
+	return
+}
+
+// WithinTxAfterCounter returns a count of finished OrderRepoFacadeMock.WithinTx invocations
+func (mmWithinTx *OrderRepoFacadeMock) WithinTxAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWithinTx.afterWithinTxCounter)
+}
+
+// WithinTxBeforeCounter returns a count of OrderRepoFacadeMock.WithinTx invocations
+func (mmWithinTx *OrderRepoFacadeMock) WithinTxBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWithinTx.beforeWithinTxCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrderRepoFacadeMock.WithinTx.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmWithinTx *mOrderRepoFacadeMockWithinTx) Calls() []*OrderRepoFacadeMockWithinTxParams {
+	mmWithinTx.mutex.RLock()
+
+	argCopy := make([]*OrderRepoFacadeMockWithinTxParams, len(mmWithinTx.callArgs))
+	copy(argCopy, mmWithinTx.callArgs)
+
+	mmWithinTx.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockWithinTxDone returns true if the count of the WithinTx invocations corresponds
+// the number of defined expectations
+func (m *OrderRepoFacadeMock) MinimockWithinTxDone() bool {
+	if m.WithinTxMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.WithinTxMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.WithinTxMock.invocationsDone()
+}
+
+// MinimockWithinTxInspect logs each unmet expectation
+func (m *OrderRepoFacadeMock) MinimockWithinTxInspect() {
+	for _, e := range m.WithinTxMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrderRepoFacadeMock.WithinTx at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterWithinTxCounter := mm_atomic.LoadUint64(&m.afterWithinTxCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.WithinTxMock.defaultExpectation != nil && afterWithinTxCounter < 1 {
+		if m.WithinTxMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderRepoFacadeMock.WithinTx at\n%s", m.WithinTxMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrderRepoFacadeMock.WithinTx at\n%s with params: %#v", m.WithinTxMock.defaultExpectation.expectationOrigins.origin, *m.WithinTxMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcWithinTx != nil && afterWithinTxCounter < 1 {
+		m.t.Errorf("Expected call to OrderRepoFacadeMock.WithinTx at\n%s", m.funcWithinTxOrigin)
+	}
+
+	if !m.WithinTxMock.invocationsDone() && afterWithinTxCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderRepoFacadeMock.WithinTx at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.WithinTxMock.expectedInvocations), m.WithinTxMock.expectedInvocationsOrigin, afterWithinTxCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *OrderRepoFacadeMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddAuditLogInspect()
+
+			m.MinimockAddOrderInspect()
+
+			m.MinimockArchiveOrdersInspect()
+
+			m.MinimockGetClientOrdersListInspect()
+
+			m.MinimockGetOrderByIDInspect()
+
+			m.MinimockGetOrdersByIDsInspect()
+
+			m.MinimockGetRefundsListInspect()
+
+			m.MinimockHandOverOrdersInspect()
+
+			m.MinimockListOrdersByStatusInspect()
+
+			m.MinimockQueryAuditLogInspect()
+
+			m.MinimockUpdateOrderInspect()
+
+			m.MinimockUpdateOrdersInspect()
+
+			m.MinimockWithinTxInspect()
 		}
 	})
 }
@@ -2218,10 +4793,17 @@ func (m *OrderRepoFacadeMock) MinimockWait(timeout mm_time.Duration) {
 func (m *OrderRepoFacadeMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockAddAuditLogDone() &&
 		m.MinimockAddOrderDone() &&
+		m.MinimockArchiveOrdersDone() &&
 		m.MinimockGetClientOrdersListDone() &&
 		m.MinimockGetOrderByIDDone() &&
 		m.MinimockGetOrdersByIDsDone() &&
 		m.MinimockGetRefundsListDone() &&
-		m.MinimockUpdateOrderDone()
+		m.MinimockHandOverOrdersDone() &&
+		m.MinimockListOrdersByStatusDone() &&
+		m.MinimockQueryAuditLogDone() &&
+		m.MinimockUpdateOrderDone() &&
+		m.MinimockUpdateOrdersDone() &&
+		m.MinimockWithinTxDone()
 }
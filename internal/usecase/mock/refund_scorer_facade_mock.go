@@ -0,0 +1,1424 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.0). DO NOT EDIT.
+
+package mock
+
+//go:generate minimock -i github.com/Na322Pr/route256/internal/usecase.RefundScorerFacade -o refund_scorer_facade_mock.go -n RefundScorerFacadeMock -p mock
+
+import (
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/Na322Pr/route256/internal/dto"
+	"github.com/gojuno/minimock/v3"
+)
+
+// RefundScorerFacadeMock implements mm_usecase.RefundScorerFacade
+type RefundScorerFacadeMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcFlaggedClients          func(now time.Time) (ca1 []dto.ClientRefundScoreDTO)
+	funcFlaggedClientsOrigin    string
+	inspectFuncFlaggedClients   func(now time.Time)
+	afterFlaggedClientsCounter  uint64
+	beforeFlaggedClientsCounter uint64
+	FlaggedClientsMock          mRefundScorerFacadeMockFlaggedClients
+
+	funcRecordIssue          func(clientID int, now time.Time)
+	funcRecordIssueOrigin    string
+	inspectFuncRecordIssue   func(clientID int, now time.Time)
+	afterRecordIssueCounter  uint64
+	beforeRecordIssueCounter uint64
+	RecordIssueMock          mRefundScorerFacadeMockRecordIssue
+
+	funcRecordRefund          func(clientID int, cost int, now time.Time)
+	funcRecordRefundOrigin    string
+	inspectFuncRecordRefund   func(clientID int, cost int, now time.Time)
+	afterRecordRefundCounter  uint64
+	beforeRecordRefundCounter uint64
+	RecordRefundMock          mRefundScorerFacadeMockRecordRefund
+
+	funcRequireApproval          func(clientID int, now time.Time) (b1 bool)
+	funcRequireApprovalOrigin    string
+	inspectFuncRequireApproval   func(clientID int, now time.Time)
+	afterRequireApprovalCounter  uint64
+	beforeRequireApprovalCounter uint64
+	RequireApprovalMock          mRefundScorerFacadeMockRequireApproval
+}
+
+// NewRefundScorerFacadeMock returns a mock for mm_usecase.RefundScorerFacade
+func NewRefundScorerFacadeMock(t minimock.Tester) *RefundScorerFacadeMock {
+	m := &RefundScorerFacadeMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.FlaggedClientsMock = mRefundScorerFacadeMockFlaggedClients{mock: m}
+	m.FlaggedClientsMock.callArgs = []*RefundScorerFacadeMockFlaggedClientsParams{}
+
+	m.RecordIssueMock = mRefundScorerFacadeMockRecordIssue{mock: m}
+	m.RecordIssueMock.callArgs = []*RefundScorerFacadeMockRecordIssueParams{}
+
+	m.RecordRefundMock = mRefundScorerFacadeMockRecordRefund{mock: m}
+	m.RecordRefundMock.callArgs = []*RefundScorerFacadeMockRecordRefundParams{}
+
+	m.RequireApprovalMock = mRefundScorerFacadeMockRequireApproval{mock: m}
+	m.RequireApprovalMock.callArgs = []*RefundScorerFacadeMockRequireApprovalParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRefundScorerFacadeMockFlaggedClients struct {
+	optional           bool
+	mock               *RefundScorerFacadeMock
+	defaultExpectation *RefundScorerFacadeMockFlaggedClientsExpectation
+	expectations       []*RefundScorerFacadeMockFlaggedClientsExpectation
+
+	callArgs []*RefundScorerFacadeMockFlaggedClientsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RefundScorerFacadeMockFlaggedClientsExpectation specifies expectation struct of the RefundScorerFacade.FlaggedClients
+type RefundScorerFacadeMockFlaggedClientsExpectation struct {
+	mock               *RefundScorerFacadeMock
+	params             *RefundScorerFacadeMockFlaggedClientsParams
+	paramPtrs          *RefundScorerFacadeMockFlaggedClientsParamPtrs
+	expectationOrigins RefundScorerFacadeMockFlaggedClientsExpectationOrigins
+	results            *RefundScorerFacadeMockFlaggedClientsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RefundScorerFacadeMockFlaggedClientsParams contains parameters of the RefundScorerFacade.FlaggedClients
+type RefundScorerFacadeMockFlaggedClientsParams struct {
+	now time.Time
+}
+
+// RefundScorerFacadeMockFlaggedClientsParamPtrs contains pointers to parameters of the RefundScorerFacade.FlaggedClients
+type RefundScorerFacadeMockFlaggedClientsParamPtrs struct {
+	now *time.Time
+}
+
+// RefundScorerFacadeMockFlaggedClientsResults contains results of the RefundScorerFacade.FlaggedClients
+type RefundScorerFacadeMockFlaggedClientsResults struct {
+	ca1 []dto.ClientRefundScoreDTO
+}
+
+// RefundScorerFacadeMockFlaggedClientsOrigins contains origins of expectations of the RefundScorerFacade.FlaggedClients
+type RefundScorerFacadeMockFlaggedClientsExpectationOrigins struct {
+	origin    string
+	originNow string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFlaggedClients *mRefundScorerFacadeMockFlaggedClients) Optional() *mRefundScorerFacadeMockFlaggedClients {
+	mmFlaggedClients.optional = true
+	return mmFlaggedClients
+}
+
+// Expect sets up expected params for RefundScorerFacade.FlaggedClients
+func (mmFlaggedClients *mRefundScorerFacadeMockFlaggedClients) Expect(now time.Time) *mRefundScorerFacadeMockFlaggedClients {
+	if mmFlaggedClients.mock.funcFlaggedClients != nil {
+		mmFlaggedClients.mock.t.Fatalf("RefundScorerFacadeMock.FlaggedClients mock is already set by Set")
+	}
+
+	if mmFlaggedClients.defaultExpectation == nil {
+		mmFlaggedClients.defaultExpectation = &RefundScorerFacadeMockFlaggedClientsExpectation{}
+	}
+
+	if mmFlaggedClients.defaultExpectation.paramPtrs != nil {
+		mmFlaggedClients.mock.t.Fatalf("RefundScorerFacadeMock.FlaggedClients mock is already set by ExpectParams functions")
+	}
+
+	mmFlaggedClients.defaultExpectation.params = &RefundScorerFacadeMockFlaggedClientsParams{now}
+	mmFlaggedClients.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFlaggedClients.expectations {
+		if minimock.Equal(e.params, mmFlaggedClients.defaultExpectation.params) {
+			mmFlaggedClients.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFlaggedClients.defaultExpectation.params)
+		}
+	}
+
+	return mmFlaggedClients
+}
+
+// ExpectNowParam1 sets up expected param now for RefundScorerFacade.FlaggedClients
+func (mmFlaggedClients *mRefundScorerFacadeMockFlaggedClients) ExpectNowParam1(now time.Time) *mRefundScorerFacadeMockFlaggedClients {
+	if mmFlaggedClients.mock.funcFlaggedClients != nil {
+		mmFlaggedClients.mock.t.Fatalf("RefundScorerFacadeMock.FlaggedClients mock is already set by Set")
+	}
+
+	if mmFlaggedClients.defaultExpectation == nil {
+		mmFlaggedClients.defaultExpectation = &RefundScorerFacadeMockFlaggedClientsExpectation{}
+	}
+
+	if mmFlaggedClients.defaultExpectation.params != nil {
+		mmFlaggedClients.mock.t.Fatalf("RefundScorerFacadeMock.FlaggedClients mock is already set by Expect")
+	}
+
+	if mmFlaggedClients.defaultExpectation.paramPtrs == nil {
+		mmFlaggedClients.defaultExpectation.paramPtrs = &RefundScorerFacadeMockFlaggedClientsParamPtrs{}
+	}
+	mmFlaggedClients.defaultExpectation.paramPtrs.now = &now
+	mmFlaggedClients.defaultExpectation.expectationOrigins.originNow = minimock.CallerInfo(1)
+
+	return mmFlaggedClients
+}
+
+// Inspect accepts an inspector function that has same arguments as the RefundScorerFacade.FlaggedClients
+func (mmFlaggedClients *mRefundScorerFacadeMockFlaggedClients) Inspect(f func(now time.Time)) *mRefundScorerFacadeMockFlaggedClients {
+	if mmFlaggedClients.mock.inspectFuncFlaggedClients != nil {
+		mmFlaggedClients.mock.t.Fatalf("Inspect function is already set for RefundScorerFacadeMock.FlaggedClients")
+	}
+
+	mmFlaggedClients.mock.inspectFuncFlaggedClients = f
+
+	return mmFlaggedClients
+}
+
+// Return sets up results that will be returned by RefundScorerFacade.FlaggedClients
+func (mmFlaggedClients *mRefundScorerFacadeMockFlaggedClients) Return(ca1 []dto.ClientRefundScoreDTO) *RefundScorerFacadeMock {
+	if mmFlaggedClients.mock.funcFlaggedClients != nil {
+		mmFlaggedClients.mock.t.Fatalf("RefundScorerFacadeMock.FlaggedClients mock is already set by Set")
+	}
+
+	if mmFlaggedClients.defaultExpectation == nil {
+		mmFlaggedClients.defaultExpectation = &RefundScorerFacadeMockFlaggedClientsExpectation{mock: mmFlaggedClients.mock}
+	}
+	mmFlaggedClients.defaultExpectation.results = &RefundScorerFacadeMockFlaggedClientsResults{ca1}
+	mmFlaggedClients.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFlaggedClients.mock
+}
+
+// Set uses given function f to mock the RefundScorerFacade.FlaggedClients method
+func (mmFlaggedClients *mRefundScorerFacadeMockFlaggedClients) Set(f func(now time.Time) (ca1 []dto.ClientRefundScoreDTO)) *RefundScorerFacadeMock {
+	if mmFlaggedClients.defaultExpectation != nil {
+		mmFlaggedClients.mock.t.Fatalf("Default expectation is already set for the RefundScorerFacade.FlaggedClients method")
+	}
+
+	if len(mmFlaggedClients.expectations) > 0 {
+		mmFlaggedClients.mock.t.Fatalf("Some expectations are already set for the RefundScorerFacade.FlaggedClients method")
+	}
+
+	mmFlaggedClients.mock.funcFlaggedClients = f
+	mmFlaggedClients.mock.funcFlaggedClientsOrigin = minimock.CallerInfo(1)
+	return mmFlaggedClients.mock
+}
+
+// When sets expectation for the RefundScorerFacade.FlaggedClients which will trigger the result defined by the following
+// Then helper
+func (mmFlaggedClients *mRefundScorerFacadeMockFlaggedClients) When(now time.Time) *RefundScorerFacadeMockFlaggedClientsExpectation {
+	if mmFlaggedClients.mock.funcFlaggedClients != nil {
+		mmFlaggedClients.mock.t.Fatalf("RefundScorerFacadeMock.FlaggedClients mock is already set by Set")
+	}
+
+	expectation := &RefundScorerFacadeMockFlaggedClientsExpectation{
+		mock:               mmFlaggedClients.mock,
+		params:             &RefundScorerFacadeMockFlaggedClientsParams{now},
+		expectationOrigins: RefundScorerFacadeMockFlaggedClientsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFlaggedClients.expectations = append(mmFlaggedClients.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RefundScorerFacade.FlaggedClients return parameters for the expectation previously defined by the When method
+func (e *RefundScorerFacadeMockFlaggedClientsExpectation) Then(ca1 []dto.ClientRefundScoreDTO) *RefundScorerFacadeMock {
+	e.results = &RefundScorerFacadeMockFlaggedClientsResults{ca1}
+	return e.mock
+}
+
+// Times sets number of times RefundScorerFacade.FlaggedClients should be invoked
+func (mmFlaggedClients *mRefundScorerFacadeMockFlaggedClients) Times(n uint64) *mRefundScorerFacadeMockFlaggedClients {
+	if n == 0 {
+		mmFlaggedClients.mock.t.Fatalf("Times of RefundScorerFacadeMock.FlaggedClients mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFlaggedClients.expectedInvocations, n)
+	mmFlaggedClients.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFlaggedClients
+}
+
+func (mmFlaggedClients *mRefundScorerFacadeMockFlaggedClients) invocationsDone() bool {
+	if len(mmFlaggedClients.expectations) == 0 && mmFlaggedClients.defaultExpectation == nil && mmFlaggedClients.mock.funcFlaggedClients == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFlaggedClients.mock.afterFlaggedClientsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFlaggedClients.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FlaggedClients implements mm_usecase.RefundScorerFacade
+func (mmFlaggedClients *RefundScorerFacadeMock) FlaggedClients(now time.Time) (ca1 []dto.ClientRefundScoreDTO) {
+	mm_atomic.AddUint64(&mmFlaggedClients.beforeFlaggedClientsCounter, 1)
+	defer mm_atomic.AddUint64(&mmFlaggedClients.afterFlaggedClientsCounter, 1)
+
+	mmFlaggedClients.t.Helper()
+
+	if mmFlaggedClients.inspectFuncFlaggedClients != nil {
+		mmFlaggedClients.inspectFuncFlaggedClients(now)
+	}
+
+	mm_params := RefundScorerFacadeMockFlaggedClientsParams{now}
+
+	// Record call args
+	mmFlaggedClients.FlaggedClientsMock.mutex.Lock()
+	mmFlaggedClients.FlaggedClientsMock.callArgs = append(mmFlaggedClients.FlaggedClientsMock.callArgs, &mm_params)
+	mmFlaggedClients.FlaggedClientsMock.mutex.Unlock()
+
+	for _, e := range mmFlaggedClients.FlaggedClientsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ca1
+		}
+	}
+
+	if mmFlaggedClients.FlaggedClientsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFlaggedClients.FlaggedClientsMock.defaultExpectation.Counter, 1)
+		mm_want := mmFlaggedClients.FlaggedClientsMock.defaultExpectation.params
+		mm_want_ptrs := mmFlaggedClients.FlaggedClientsMock.defaultExpectation.paramPtrs
+
+		mm_got := RefundScorerFacadeMockFlaggedClientsParams{now}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.now != nil && !minimock.Equal(*mm_want_ptrs.now, mm_got.now) {
+				mmFlaggedClients.t.Errorf("RefundScorerFacadeMock.FlaggedClients got unexpected parameter now, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFlaggedClients.FlaggedClientsMock.defaultExpectation.expectationOrigins.originNow, *mm_want_ptrs.now, mm_got.now, minimock.Diff(*mm_want_ptrs.now, mm_got.now))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFlaggedClients.t.Errorf("RefundScorerFacadeMock.FlaggedClients got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFlaggedClients.FlaggedClientsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFlaggedClients.FlaggedClientsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFlaggedClients.t.Fatal("No results are set for the RefundScorerFacadeMock.FlaggedClients")
+		}
+		return (*mm_results).ca1
+	}
+	if mmFlaggedClients.funcFlaggedClients != nil {
+		return mmFlaggedClients.funcFlaggedClients(now)
+	}
+	mmFlaggedClients.t.Fatalf("Unexpected call to RefundScorerFacadeMock.FlaggedClients. %v", now)
+	return
+}
+
+// FlaggedClientsAfterCounter returns a count of finished RefundScorerFacadeMock.FlaggedClients invocations
+func (mmFlaggedClients *RefundScorerFacadeMock) FlaggedClientsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFlaggedClients.afterFlaggedClientsCounter)
+}
+
+// FlaggedClientsBeforeCounter returns a count of RefundScorerFacadeMock.FlaggedClients invocations
+func (mmFlaggedClients *RefundScorerFacadeMock) FlaggedClientsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFlaggedClients.beforeFlaggedClientsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RefundScorerFacadeMock.FlaggedClients.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFlaggedClients *mRefundScorerFacadeMockFlaggedClients) Calls() []*RefundScorerFacadeMockFlaggedClientsParams {
+	mmFlaggedClients.mutex.RLock()
+
+	argCopy := make([]*RefundScorerFacadeMockFlaggedClientsParams, len(mmFlaggedClients.callArgs))
+	copy(argCopy, mmFlaggedClients.callArgs)
+
+	mmFlaggedClients.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFlaggedClientsDone returns true if the count of the FlaggedClients invocations corresponds
+// the number of defined expectations
+func (m *RefundScorerFacadeMock) MinimockFlaggedClientsDone() bool {
+	if m.FlaggedClientsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FlaggedClientsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FlaggedClientsMock.invocationsDone()
+}
+
+// MinimockFlaggedClientsInspect logs each unmet expectation
+func (m *RefundScorerFacadeMock) MinimockFlaggedClientsInspect() {
+	for _, e := range m.FlaggedClientsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RefundScorerFacadeMock.FlaggedClients at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFlaggedClientsCounter := mm_atomic.LoadUint64(&m.afterFlaggedClientsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FlaggedClientsMock.defaultExpectation != nil && afterFlaggedClientsCounter < 1 {
+		if m.FlaggedClientsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RefundScorerFacadeMock.FlaggedClients at\n%s", m.FlaggedClientsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RefundScorerFacadeMock.FlaggedClients at\n%s with params: %#v", m.FlaggedClientsMock.defaultExpectation.expectationOrigins.origin, *m.FlaggedClientsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFlaggedClients != nil && afterFlaggedClientsCounter < 1 {
+		m.t.Errorf("Expected call to RefundScorerFacadeMock.FlaggedClients at\n%s", m.funcFlaggedClientsOrigin)
+	}
+
+	if !m.FlaggedClientsMock.invocationsDone() && afterFlaggedClientsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RefundScorerFacadeMock.FlaggedClients at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FlaggedClientsMock.expectedInvocations), m.FlaggedClientsMock.expectedInvocationsOrigin, afterFlaggedClientsCounter)
+	}
+}
+
+type mRefundScorerFacadeMockRecordIssue struct {
+	optional           bool
+	mock               *RefundScorerFacadeMock
+	defaultExpectation *RefundScorerFacadeMockRecordIssueExpectation
+	expectations       []*RefundScorerFacadeMockRecordIssueExpectation
+
+	callArgs []*RefundScorerFacadeMockRecordIssueParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RefundScorerFacadeMockRecordIssueExpectation specifies expectation struct of the RefundScorerFacade.RecordIssue
+type RefundScorerFacadeMockRecordIssueExpectation struct {
+	mock               *RefundScorerFacadeMock
+	params             *RefundScorerFacadeMockRecordIssueParams
+	paramPtrs          *RefundScorerFacadeMockRecordIssueParamPtrs
+	expectationOrigins RefundScorerFacadeMockRecordIssueExpectationOrigins
+
+	returnOrigin string
+	Counter      uint64
+}
+
+// RefundScorerFacadeMockRecordIssueParams contains parameters of the RefundScorerFacade.RecordIssue
+type RefundScorerFacadeMockRecordIssueParams struct {
+	clientID int
+	now      time.Time
+}
+
+// RefundScorerFacadeMockRecordIssueParamPtrs contains pointers to parameters of the RefundScorerFacade.RecordIssue
+type RefundScorerFacadeMockRecordIssueParamPtrs struct {
+	clientID *int
+	now      *time.Time
+}
+
+// RefundScorerFacadeMockRecordIssueOrigins contains origins of expectations of the RefundScorerFacade.RecordIssue
+type RefundScorerFacadeMockRecordIssueExpectationOrigins struct {
+	origin         string
+	originClientID string
+	originNow      string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRecordIssue *mRefundScorerFacadeMockRecordIssue) Optional() *mRefundScorerFacadeMockRecordIssue {
+	mmRecordIssue.optional = true
+	return mmRecordIssue
+}
+
+// Expect sets up expected params for RefundScorerFacade.RecordIssue
+func (mmRecordIssue *mRefundScorerFacadeMockRecordIssue) Expect(clientID int, now time.Time) *mRefundScorerFacadeMockRecordIssue {
+	if mmRecordIssue.mock.funcRecordIssue != nil {
+		mmRecordIssue.mock.t.Fatalf("RefundScorerFacadeMock.RecordIssue mock is already set by Set")
+	}
+
+	if mmRecordIssue.defaultExpectation == nil {
+		mmRecordIssue.defaultExpectation = &RefundScorerFacadeMockRecordIssueExpectation{}
+	}
+
+	if mmRecordIssue.defaultExpectation.paramPtrs != nil {
+		mmRecordIssue.mock.t.Fatalf("RefundScorerFacadeMock.RecordIssue mock is already set by ExpectParams functions")
+	}
+
+	mmRecordIssue.defaultExpectation.params = &RefundScorerFacadeMockRecordIssueParams{clientID, now}
+	mmRecordIssue.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRecordIssue.expectations {
+		if minimock.Equal(e.params, mmRecordIssue.defaultExpectation.params) {
+			mmRecordIssue.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRecordIssue.defaultExpectation.params)
+		}
+	}
+
+	return mmRecordIssue
+}
+
+// ExpectClientIDParam1 sets up expected param clientID for RefundScorerFacade.RecordIssue
+func (mmRecordIssue *mRefundScorerFacadeMockRecordIssue) ExpectClientIDParam1(clientID int) *mRefundScorerFacadeMockRecordIssue {
+	if mmRecordIssue.mock.funcRecordIssue != nil {
+		mmRecordIssue.mock.t.Fatalf("RefundScorerFacadeMock.RecordIssue mock is already set by Set")
+	}
+
+	if mmRecordIssue.defaultExpectation == nil {
+		mmRecordIssue.defaultExpectation = &RefundScorerFacadeMockRecordIssueExpectation{}
+	}
+
+	if mmRecordIssue.defaultExpectation.params != nil {
+		mmRecordIssue.mock.t.Fatalf("RefundScorerFacadeMock.RecordIssue mock is already set by Expect")
+	}
+
+	if mmRecordIssue.defaultExpectation.paramPtrs == nil {
+		mmRecordIssue.defaultExpectation.paramPtrs = &RefundScorerFacadeMockRecordIssueParamPtrs{}
+	}
+	mmRecordIssue.defaultExpectation.paramPtrs.clientID = &clientID
+	mmRecordIssue.defaultExpectation.expectationOrigins.originClientID = minimock.CallerInfo(1)
+
+	return mmRecordIssue
+}
+
+// ExpectNowParam2 sets up expected param now for RefundScorerFacade.RecordIssue
+func (mmRecordIssue *mRefundScorerFacadeMockRecordIssue) ExpectNowParam2(now time.Time) *mRefundScorerFacadeMockRecordIssue {
+	if mmRecordIssue.mock.funcRecordIssue != nil {
+		mmRecordIssue.mock.t.Fatalf("RefundScorerFacadeMock.RecordIssue mock is already set by Set")
+	}
+
+	if mmRecordIssue.defaultExpectation == nil {
+		mmRecordIssue.defaultExpectation = &RefundScorerFacadeMockRecordIssueExpectation{}
+	}
+
+	if mmRecordIssue.defaultExpectation.params != nil {
+		mmRecordIssue.mock.t.Fatalf("RefundScorerFacadeMock.RecordIssue mock is already set by Expect")
+	}
+
+	if mmRecordIssue.defaultExpectation.paramPtrs == nil {
+		mmRecordIssue.defaultExpectation.paramPtrs = &RefundScorerFacadeMockRecordIssueParamPtrs{}
+	}
+	mmRecordIssue.defaultExpectation.paramPtrs.now = &now
+	mmRecordIssue.defaultExpectation.expectationOrigins.originNow = minimock.CallerInfo(1)
+
+	return mmRecordIssue
+}
+
+// Inspect accepts an inspector function that has same arguments as the RefundScorerFacade.RecordIssue
+func (mmRecordIssue *mRefundScorerFacadeMockRecordIssue) Inspect(f func(clientID int, now time.Time)) *mRefundScorerFacadeMockRecordIssue {
+	if mmRecordIssue.mock.inspectFuncRecordIssue != nil {
+		mmRecordIssue.mock.t.Fatalf("Inspect function is already set for RefundScorerFacadeMock.RecordIssue")
+	}
+
+	mmRecordIssue.mock.inspectFuncRecordIssue = f
+
+	return mmRecordIssue
+}
+
+// Return sets up results that will be returned by RefundScorerFacade.RecordIssue
+func (mmRecordIssue *mRefundScorerFacadeMockRecordIssue) Return() *RefundScorerFacadeMock {
+	if mmRecordIssue.mock.funcRecordIssue != nil {
+		mmRecordIssue.mock.t.Fatalf("RefundScorerFacadeMock.RecordIssue mock is already set by Set")
+	}
+
+	if mmRecordIssue.defaultExpectation == nil {
+		mmRecordIssue.defaultExpectation = &RefundScorerFacadeMockRecordIssueExpectation{mock: mmRecordIssue.mock}
+	}
+
+	mmRecordIssue.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRecordIssue.mock
+}
+
+// Set uses given function f to mock the RefundScorerFacade.RecordIssue method
+func (mmRecordIssue *mRefundScorerFacadeMockRecordIssue) Set(f func(clientID int, now time.Time)) *RefundScorerFacadeMock {
+	if mmRecordIssue.defaultExpectation != nil {
+		mmRecordIssue.mock.t.Fatalf("Default expectation is already set for the RefundScorerFacade.RecordIssue method")
+	}
+
+	if len(mmRecordIssue.expectations) > 0 {
+		mmRecordIssue.mock.t.Fatalf("Some expectations are already set for the RefundScorerFacade.RecordIssue method")
+	}
+
+	mmRecordIssue.mock.funcRecordIssue = f
+	mmRecordIssue.mock.funcRecordIssueOrigin = minimock.CallerInfo(1)
+	return mmRecordIssue.mock
+}
+
+// Times sets number of times RefundScorerFacade.RecordIssue should be invoked
+func (mmRecordIssue *mRefundScorerFacadeMockRecordIssue) Times(n uint64) *mRefundScorerFacadeMockRecordIssue {
+	if n == 0 {
+		mmRecordIssue.mock.t.Fatalf("Times of RefundScorerFacadeMock.RecordIssue mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRecordIssue.expectedInvocations, n)
+	mmRecordIssue.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRecordIssue
+}
+
+func (mmRecordIssue *mRefundScorerFacadeMockRecordIssue) invocationsDone() bool {
+	if len(mmRecordIssue.expectations) == 0 && mmRecordIssue.defaultExpectation == nil && mmRecordIssue.mock.funcRecordIssue == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRecordIssue.mock.afterRecordIssueCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRecordIssue.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RecordIssue implements mm_usecase.RefundScorerFacade
+func (mmRecordIssue *RefundScorerFacadeMock) RecordIssue(clientID int, now time.Time) {
+	mm_atomic.AddUint64(&mmRecordIssue.beforeRecordIssueCounter, 1)
+	defer mm_atomic.AddUint64(&mmRecordIssue.afterRecordIssueCounter, 1)
+
+	mmRecordIssue.t.Helper()
+
+	if mmRecordIssue.inspectFuncRecordIssue != nil {
+		mmRecordIssue.inspectFuncRecordIssue(clientID, now)
+	}
+
+	mm_params := RefundScorerFacadeMockRecordIssueParams{clientID, now}
+
+	// Record call args
+	mmRecordIssue.RecordIssueMock.mutex.Lock()
+	mmRecordIssue.RecordIssueMock.callArgs = append(mmRecordIssue.RecordIssueMock.callArgs, &mm_params)
+	mmRecordIssue.RecordIssueMock.mutex.Unlock()
+
+	for _, e := range mmRecordIssue.RecordIssueMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmRecordIssue.RecordIssueMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRecordIssue.RecordIssueMock.defaultExpectation.Counter, 1)
+		mm_want := mmRecordIssue.RecordIssueMock.defaultExpectation.params
+		mm_want_ptrs := mmRecordIssue.RecordIssueMock.defaultExpectation.paramPtrs
+
+		mm_got := RefundScorerFacadeMockRecordIssueParams{clientID, now}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.clientID != nil && !minimock.Equal(*mm_want_ptrs.clientID, mm_got.clientID) {
+				mmRecordIssue.t.Errorf("RefundScorerFacadeMock.RecordIssue got unexpected parameter clientID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRecordIssue.RecordIssueMock.defaultExpectation.expectationOrigins.originClientID, *mm_want_ptrs.clientID, mm_got.clientID, minimock.Diff(*mm_want_ptrs.clientID, mm_got.clientID))
+			}
+
+			if mm_want_ptrs.now != nil && !minimock.Equal(*mm_want_ptrs.now, mm_got.now) {
+				mmRecordIssue.t.Errorf("RefundScorerFacadeMock.RecordIssue got unexpected parameter now, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRecordIssue.RecordIssueMock.defaultExpectation.expectationOrigins.originNow, *mm_want_ptrs.now, mm_got.now, minimock.Diff(*mm_want_ptrs.now, mm_got.now))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRecordIssue.t.Errorf("RefundScorerFacadeMock.RecordIssue got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRecordIssue.RecordIssueMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmRecordIssue.funcRecordIssue != nil {
+		mmRecordIssue.funcRecordIssue(clientID, now)
+		return
+	}
+	mmRecordIssue.t.Fatalf("Unexpected call to RefundScorerFacadeMock.RecordIssue. %v %v", clientID, now)
+
+}
+
+// RecordIssueAfterCounter returns a count of finished RefundScorerFacadeMock.RecordIssue invocations
+func (mmRecordIssue *RefundScorerFacadeMock) RecordIssueAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecordIssue.afterRecordIssueCounter)
+}
+
+// RecordIssueBeforeCounter returns a count of RefundScorerFacadeMock.RecordIssue invocations
+func (mmRecordIssue *RefundScorerFacadeMock) RecordIssueBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecordIssue.beforeRecordIssueCounter)
+}
+
+// Calls returns a list of arguments used in each call to RefundScorerFacadeMock.RecordIssue.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRecordIssue *mRefundScorerFacadeMockRecordIssue) Calls() []*RefundScorerFacadeMockRecordIssueParams {
+	mmRecordIssue.mutex.RLock()
+
+	argCopy := make([]*RefundScorerFacadeMockRecordIssueParams, len(mmRecordIssue.callArgs))
+	copy(argCopy, mmRecordIssue.callArgs)
+
+	mmRecordIssue.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRecordIssueDone returns true if the count of the RecordIssue invocations corresponds
+// the number of defined expectations
+func (m *RefundScorerFacadeMock) MinimockRecordIssueDone() bool {
+	if m.RecordIssueMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RecordIssueMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RecordIssueMock.invocationsDone()
+}
+
+// MinimockRecordIssueInspect logs each unmet expectation
+func (m *RefundScorerFacadeMock) MinimockRecordIssueInspect() {
+	for _, e := range m.RecordIssueMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RefundScorerFacadeMock.RecordIssue at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRecordIssueCounter := mm_atomic.LoadUint64(&m.afterRecordIssueCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RecordIssueMock.defaultExpectation != nil && afterRecordIssueCounter < 1 {
+		if m.RecordIssueMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RefundScorerFacadeMock.RecordIssue at\n%s", m.RecordIssueMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RefundScorerFacadeMock.RecordIssue at\n%s with params: %#v", m.RecordIssueMock.defaultExpectation.expectationOrigins.origin, *m.RecordIssueMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRecordIssue != nil && afterRecordIssueCounter < 1 {
+		m.t.Errorf("Expected call to RefundScorerFacadeMock.RecordIssue at\n%s", m.funcRecordIssueOrigin)
+	}
+
+	if !m.RecordIssueMock.invocationsDone() && afterRecordIssueCounter > 0 {
+		m.t.Errorf("Expected %d calls to RefundScorerFacadeMock.RecordIssue at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RecordIssueMock.expectedInvocations), m.RecordIssueMock.expectedInvocationsOrigin, afterRecordIssueCounter)
+	}
+}
+
+type mRefundScorerFacadeMockRecordRefund struct {
+	optional           bool
+	mock               *RefundScorerFacadeMock
+	defaultExpectation *RefundScorerFacadeMockRecordRefundExpectation
+	expectations       []*RefundScorerFacadeMockRecordRefundExpectation
+
+	callArgs []*RefundScorerFacadeMockRecordRefundParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RefundScorerFacadeMockRecordRefundExpectation specifies expectation struct of the RefundScorerFacade.RecordRefund
+type RefundScorerFacadeMockRecordRefundExpectation struct {
+	mock               *RefundScorerFacadeMock
+	params             *RefundScorerFacadeMockRecordRefundParams
+	paramPtrs          *RefundScorerFacadeMockRecordRefundParamPtrs
+	expectationOrigins RefundScorerFacadeMockRecordRefundExpectationOrigins
+
+	returnOrigin string
+	Counter      uint64
+}
+
+// RefundScorerFacadeMockRecordRefundParams contains parameters of the RefundScorerFacade.RecordRefund
+type RefundScorerFacadeMockRecordRefundParams struct {
+	clientID int
+	cost     int
+	now      time.Time
+}
+
+// RefundScorerFacadeMockRecordRefundParamPtrs contains pointers to parameters of the RefundScorerFacade.RecordRefund
+type RefundScorerFacadeMockRecordRefundParamPtrs struct {
+	clientID *int
+	cost     *int
+	now      *time.Time
+}
+
+// RefundScorerFacadeMockRecordRefundOrigins contains origins of expectations of the RefundScorerFacade.RecordRefund
+type RefundScorerFacadeMockRecordRefundExpectationOrigins struct {
+	origin         string
+	originClientID string
+	originCost     string
+	originNow      string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRecordRefund *mRefundScorerFacadeMockRecordRefund) Optional() *mRefundScorerFacadeMockRecordRefund {
+	mmRecordRefund.optional = true
+	return mmRecordRefund
+}
+
+// Expect sets up expected params for RefundScorerFacade.RecordRefund
+func (mmRecordRefund *mRefundScorerFacadeMockRecordRefund) Expect(clientID int, cost int, now time.Time) *mRefundScorerFacadeMockRecordRefund {
+	if mmRecordRefund.mock.funcRecordRefund != nil {
+		mmRecordRefund.mock.t.Fatalf("RefundScorerFacadeMock.RecordRefund mock is already set by Set")
+	}
+
+	if mmRecordRefund.defaultExpectation == nil {
+		mmRecordRefund.defaultExpectation = &RefundScorerFacadeMockRecordRefundExpectation{}
+	}
+
+	if mmRecordRefund.defaultExpectation.paramPtrs != nil {
+		mmRecordRefund.mock.t.Fatalf("RefundScorerFacadeMock.RecordRefund mock is already set by ExpectParams functions")
+	}
+
+	mmRecordRefund.defaultExpectation.params = &RefundScorerFacadeMockRecordRefundParams{clientID, cost, now}
+	mmRecordRefund.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRecordRefund.expectations {
+		if minimock.Equal(e.params, mmRecordRefund.defaultExpectation.params) {
+			mmRecordRefund.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRecordRefund.defaultExpectation.params)
+		}
+	}
+
+	return mmRecordRefund
+}
+
+// ExpectClientIDParam1 sets up expected param clientID for RefundScorerFacade.RecordRefund
+func (mmRecordRefund *mRefundScorerFacadeMockRecordRefund) ExpectClientIDParam1(clientID int) *mRefundScorerFacadeMockRecordRefund {
+	if mmRecordRefund.mock.funcRecordRefund != nil {
+		mmRecordRefund.mock.t.Fatalf("RefundScorerFacadeMock.RecordRefund mock is already set by Set")
+	}
+
+	if mmRecordRefund.defaultExpectation == nil {
+		mmRecordRefund.defaultExpectation = &RefundScorerFacadeMockRecordRefundExpectation{}
+	}
+
+	if mmRecordRefund.defaultExpectation.params != nil {
+		mmRecordRefund.mock.t.Fatalf("RefundScorerFacadeMock.RecordRefund mock is already set by Expect")
+	}
+
+	if mmRecordRefund.defaultExpectation.paramPtrs == nil {
+		mmRecordRefund.defaultExpectation.paramPtrs = &RefundScorerFacadeMockRecordRefundParamPtrs{}
+	}
+	mmRecordRefund.defaultExpectation.paramPtrs.clientID = &clientID
+	mmRecordRefund.defaultExpectation.expectationOrigins.originClientID = minimock.CallerInfo(1)
+
+	return mmRecordRefund
+}
+
+// ExpectCostParam2 sets up expected param cost for RefundScorerFacade.RecordRefund
+func (mmRecordRefund *mRefundScorerFacadeMockRecordRefund) ExpectCostParam2(cost int) *mRefundScorerFacadeMockRecordRefund {
+	if mmRecordRefund.mock.funcRecordRefund != nil {
+		mmRecordRefund.mock.t.Fatalf("RefundScorerFacadeMock.RecordRefund mock is already set by Set")
+	}
+
+	if mmRecordRefund.defaultExpectation == nil {
+		mmRecordRefund.defaultExpectation = &RefundScorerFacadeMockRecordRefundExpectation{}
+	}
+
+	if mmRecordRefund.defaultExpectation.params != nil {
+		mmRecordRefund.mock.t.Fatalf("RefundScorerFacadeMock.RecordRefund mock is already set by Expect")
+	}
+
+	if mmRecordRefund.defaultExpectation.paramPtrs == nil {
+		mmRecordRefund.defaultExpectation.paramPtrs = &RefundScorerFacadeMockRecordRefundParamPtrs{}
+	}
+	mmRecordRefund.defaultExpectation.paramPtrs.cost = &cost
+	mmRecordRefund.defaultExpectation.expectationOrigins.originCost = minimock.CallerInfo(1)
+
+	return mmRecordRefund
+}
+
+// ExpectNowParam3 sets up expected param now for RefundScorerFacade.RecordRefund
+func (mmRecordRefund *mRefundScorerFacadeMockRecordRefund) ExpectNowParam3(now time.Time) *mRefundScorerFacadeMockRecordRefund {
+	if mmRecordRefund.mock.funcRecordRefund != nil {
+		mmRecordRefund.mock.t.Fatalf("RefundScorerFacadeMock.RecordRefund mock is already set by Set")
+	}
+
+	if mmRecordRefund.defaultExpectation == nil {
+		mmRecordRefund.defaultExpectation = &RefundScorerFacadeMockRecordRefundExpectation{}
+	}
+
+	if mmRecordRefund.defaultExpectation.params != nil {
+		mmRecordRefund.mock.t.Fatalf("RefundScorerFacadeMock.RecordRefund mock is already set by Expect")
+	}
+
+	if mmRecordRefund.defaultExpectation.paramPtrs == nil {
+		mmRecordRefund.defaultExpectation.paramPtrs = &RefundScorerFacadeMockRecordRefundParamPtrs{}
+	}
+	mmRecordRefund.defaultExpectation.paramPtrs.now = &now
+	mmRecordRefund.defaultExpectation.expectationOrigins.originNow = minimock.CallerInfo(1)
+
+	return mmRecordRefund
+}
+
+// Inspect accepts an inspector function that has same arguments as the RefundScorerFacade.RecordRefund
+func (mmRecordRefund *mRefundScorerFacadeMockRecordRefund) Inspect(f func(clientID int, cost int, now time.Time)) *mRefundScorerFacadeMockRecordRefund {
+	if mmRecordRefund.mock.inspectFuncRecordRefund != nil {
+		mmRecordRefund.mock.t.Fatalf("Inspect function is already set for RefundScorerFacadeMock.RecordRefund")
+	}
+
+	mmRecordRefund.mock.inspectFuncRecordRefund = f
+
+	return mmRecordRefund
+}
+
+// Return sets up results that will be returned by RefundScorerFacade.RecordRefund
+func (mmRecordRefund *mRefundScorerFacadeMockRecordRefund) Return() *RefundScorerFacadeMock {
+	if mmRecordRefund.mock.funcRecordRefund != nil {
+		mmRecordRefund.mock.t.Fatalf("RefundScorerFacadeMock.RecordRefund mock is already set by Set")
+	}
+
+	if mmRecordRefund.defaultExpectation == nil {
+		mmRecordRefund.defaultExpectation = &RefundScorerFacadeMockRecordRefundExpectation{mock: mmRecordRefund.mock}
+	}
+
+	mmRecordRefund.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRecordRefund.mock
+}
+
+// Set uses given function f to mock the RefundScorerFacade.RecordRefund method
+func (mmRecordRefund *mRefundScorerFacadeMockRecordRefund) Set(f func(clientID int, cost int, now time.Time)) *RefundScorerFacadeMock {
+	if mmRecordRefund.defaultExpectation != nil {
+		mmRecordRefund.mock.t.Fatalf("Default expectation is already set for the RefundScorerFacade.RecordRefund method")
+	}
+
+	if len(mmRecordRefund.expectations) > 0 {
+		mmRecordRefund.mock.t.Fatalf("Some expectations are already set for the RefundScorerFacade.RecordRefund method")
+	}
+
+	mmRecordRefund.mock.funcRecordRefund = f
+	mmRecordRefund.mock.funcRecordRefundOrigin = minimock.CallerInfo(1)
+	return mmRecordRefund.mock
+}
+
+// Times sets number of times RefundScorerFacade.RecordRefund should be invoked
+func (mmRecordRefund *mRefundScorerFacadeMockRecordRefund) Times(n uint64) *mRefundScorerFacadeMockRecordRefund {
+	if n == 0 {
+		mmRecordRefund.mock.t.Fatalf("Times of RefundScorerFacadeMock.RecordRefund mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRecordRefund.expectedInvocations, n)
+	mmRecordRefund.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRecordRefund
+}
+
+func (mmRecordRefund *mRefundScorerFacadeMockRecordRefund) invocationsDone() bool {
+	if len(mmRecordRefund.expectations) == 0 && mmRecordRefund.defaultExpectation == nil && mmRecordRefund.mock.funcRecordRefund == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRecordRefund.mock.afterRecordRefundCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRecordRefund.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RecordRefund implements mm_usecase.RefundScorerFacade
+func (mmRecordRefund *RefundScorerFacadeMock) RecordRefund(clientID int, cost int, now time.Time) {
+	mm_atomic.AddUint64(&mmRecordRefund.beforeRecordRefundCounter, 1)
+	defer mm_atomic.AddUint64(&mmRecordRefund.afterRecordRefundCounter, 1)
+
+	mmRecordRefund.t.Helper()
+
+	if mmRecordRefund.inspectFuncRecordRefund != nil {
+		mmRecordRefund.inspectFuncRecordRefund(clientID, cost, now)
+	}
+
+	mm_params := RefundScorerFacadeMockRecordRefundParams{clientID, cost, now}
+
+	// Record call args
+	mmRecordRefund.RecordRefundMock.mutex.Lock()
+	mmRecordRefund.RecordRefundMock.callArgs = append(mmRecordRefund.RecordRefundMock.callArgs, &mm_params)
+	mmRecordRefund.RecordRefundMock.mutex.Unlock()
+
+	for _, e := range mmRecordRefund.RecordRefundMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmRecordRefund.RecordRefundMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRecordRefund.RecordRefundMock.defaultExpectation.Counter, 1)
+		mm_want := mmRecordRefund.RecordRefundMock.defaultExpectation.params
+		mm_want_ptrs := mmRecordRefund.RecordRefundMock.defaultExpectation.paramPtrs
+
+		mm_got := RefundScorerFacadeMockRecordRefundParams{clientID, cost, now}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.clientID != nil && !minimock.Equal(*mm_want_ptrs.clientID, mm_got.clientID) {
+				mmRecordRefund.t.Errorf("RefundScorerFacadeMock.RecordRefund got unexpected parameter clientID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRecordRefund.RecordRefundMock.defaultExpectation.expectationOrigins.originClientID, *mm_want_ptrs.clientID, mm_got.clientID, minimock.Diff(*mm_want_ptrs.clientID, mm_got.clientID))
+			}
+
+			if mm_want_ptrs.cost != nil && !minimock.Equal(*mm_want_ptrs.cost, mm_got.cost) {
+				mmRecordRefund.t.Errorf("RefundScorerFacadeMock.RecordRefund got unexpected parameter cost, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRecordRefund.RecordRefundMock.defaultExpectation.expectationOrigins.originCost, *mm_want_ptrs.cost, mm_got.cost, minimock.Diff(*mm_want_ptrs.cost, mm_got.cost))
+			}
+
+			if mm_want_ptrs.now != nil && !minimock.Equal(*mm_want_ptrs.now, mm_got.now) {
+				mmRecordRefund.t.Errorf("RefundScorerFacadeMock.RecordRefund got unexpected parameter now, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRecordRefund.RecordRefundMock.defaultExpectation.expectationOrigins.originNow, *mm_want_ptrs.now, mm_got.now, minimock.Diff(*mm_want_ptrs.now, mm_got.now))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRecordRefund.t.Errorf("RefundScorerFacadeMock.RecordRefund got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRecordRefund.RecordRefundMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmRecordRefund.funcRecordRefund != nil {
+		mmRecordRefund.funcRecordRefund(clientID, cost, now)
+		return
+	}
+	mmRecordRefund.t.Fatalf("Unexpected call to RefundScorerFacadeMock.RecordRefund. %v %v %v", clientID, cost, now)
+
+}
+
+// RecordRefundAfterCounter returns a count of finished RefundScorerFacadeMock.RecordRefund invocations
+func (mmRecordRefund *RefundScorerFacadeMock) RecordRefundAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecordRefund.afterRecordRefundCounter)
+}
+
+// RecordRefundBeforeCounter returns a count of RefundScorerFacadeMock.RecordRefund invocations
+func (mmRecordRefund *RefundScorerFacadeMock) RecordRefundBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecordRefund.beforeRecordRefundCounter)
+}
+
+// Calls returns a list of arguments used in each call to RefundScorerFacadeMock.RecordRefund.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRecordRefund *mRefundScorerFacadeMockRecordRefund) Calls() []*RefundScorerFacadeMockRecordRefundParams {
+	mmRecordRefund.mutex.RLock()
+
+	argCopy := make([]*RefundScorerFacadeMockRecordRefundParams, len(mmRecordRefund.callArgs))
+	copy(argCopy, mmRecordRefund.callArgs)
+
+	mmRecordRefund.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRecordRefundDone returns true if the count of the RecordRefund invocations corresponds
+// the number of defined expectations
+func (m *RefundScorerFacadeMock) MinimockRecordRefundDone() bool {
+	if m.RecordRefundMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RecordRefundMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RecordRefundMock.invocationsDone()
+}
+
+// MinimockRecordRefundInspect logs each unmet expectation
+func (m *RefundScorerFacadeMock) MinimockRecordRefundInspect() {
+	for _, e := range m.RecordRefundMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RefundScorerFacadeMock.RecordRefund at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRecordRefundCounter := mm_atomic.LoadUint64(&m.afterRecordRefundCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RecordRefundMock.defaultExpectation != nil && afterRecordRefundCounter < 1 {
+		if m.RecordRefundMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RefundScorerFacadeMock.RecordRefund at\n%s", m.RecordRefundMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RefundScorerFacadeMock.RecordRefund at\n%s with params: %#v", m.RecordRefundMock.defaultExpectation.expectationOrigins.origin, *m.RecordRefundMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRecordRefund != nil && afterRecordRefundCounter < 1 {
+		m.t.Errorf("Expected call to RefundScorerFacadeMock.RecordRefund at\n%s", m.funcRecordRefundOrigin)
+	}
+
+	if !m.RecordRefundMock.invocationsDone() && afterRecordRefundCounter > 0 {
+		m.t.Errorf("Expected %d calls to RefundScorerFacadeMock.RecordRefund at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RecordRefundMock.expectedInvocations), m.RecordRefundMock.expectedInvocationsOrigin, afterRecordRefundCounter)
+	}
+}
+
+type mRefundScorerFacadeMockRequireApproval struct {
+	optional           bool
+	mock               *RefundScorerFacadeMock
+	defaultExpectation *RefundScorerFacadeMockRequireApprovalExpectation
+	expectations       []*RefundScorerFacadeMockRequireApprovalExpectation
+
+	callArgs []*RefundScorerFacadeMockRequireApprovalParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RefundScorerFacadeMockRequireApprovalExpectation specifies expectation struct of the RefundScorerFacade.RequireApproval
+type RefundScorerFacadeMockRequireApprovalExpectation struct {
+	mock               *RefundScorerFacadeMock
+	params             *RefundScorerFacadeMockRequireApprovalParams
+	paramPtrs          *RefundScorerFacadeMockRequireApprovalParamPtrs
+	expectationOrigins RefundScorerFacadeMockRequireApprovalExpectationOrigins
+	results            *RefundScorerFacadeMockRequireApprovalResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RefundScorerFacadeMockRequireApprovalParams contains parameters of the RefundScorerFacade.RequireApproval
+type RefundScorerFacadeMockRequireApprovalParams struct {
+	clientID int
+	now      time.Time
+}
+
+// RefundScorerFacadeMockRequireApprovalParamPtrs contains pointers to parameters of the RefundScorerFacade.RequireApproval
+type RefundScorerFacadeMockRequireApprovalParamPtrs struct {
+	clientID *int
+	now      *time.Time
+}
+
+// RefundScorerFacadeMockRequireApprovalResults contains results of the RefundScorerFacade.RequireApproval
+type RefundScorerFacadeMockRequireApprovalResults struct {
+	b1 bool
+}
+
+// RefundScorerFacadeMockRequireApprovalOrigins contains origins of expectations of the RefundScorerFacade.RequireApproval
+type RefundScorerFacadeMockRequireApprovalExpectationOrigins struct {
+	origin         string
+	originClientID string
+	originNow      string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRequireApproval *mRefundScorerFacadeMockRequireApproval) Optional() *mRefundScorerFacadeMockRequireApproval {
+	mmRequireApproval.optional = true
+	return mmRequireApproval
+}
+
+// Expect sets up expected params for RefundScorerFacade.RequireApproval
+func (mmRequireApproval *mRefundScorerFacadeMockRequireApproval) Expect(clientID int, now time.Time) *mRefundScorerFacadeMockRequireApproval {
+	if mmRequireApproval.mock.funcRequireApproval != nil {
+		mmRequireApproval.mock.t.Fatalf("RefundScorerFacadeMock.RequireApproval mock is already set by Set")
+	}
+
+	if mmRequireApproval.defaultExpectation == nil {
+		mmRequireApproval.defaultExpectation = &RefundScorerFacadeMockRequireApprovalExpectation{}
+	}
+
+	if mmRequireApproval.defaultExpectation.paramPtrs != nil {
+		mmRequireApproval.mock.t.Fatalf("RefundScorerFacadeMock.RequireApproval mock is already set by ExpectParams functions")
+	}
+
+	mmRequireApproval.defaultExpectation.params = &RefundScorerFacadeMockRequireApprovalParams{clientID, now}
+	mmRequireApproval.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRequireApproval.expectations {
+		if minimock.Equal(e.params, mmRequireApproval.defaultExpectation.params) {
+			mmRequireApproval.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRequireApproval.defaultExpectation.params)
+		}
+	}
+
+	return mmRequireApproval
+}
+
+// ExpectClientIDParam1 sets up expected param clientID for RefundScorerFacade.RequireApproval
+func (mmRequireApproval *mRefundScorerFacadeMockRequireApproval) ExpectClientIDParam1(clientID int) *mRefundScorerFacadeMockRequireApproval {
+	if mmRequireApproval.mock.funcRequireApproval != nil {
+		mmRequireApproval.mock.t.Fatalf("RefundScorerFacadeMock.RequireApproval mock is already set by Set")
+	}
+
+	if mmRequireApproval.defaultExpectation == nil {
+		mmRequireApproval.defaultExpectation = &RefundScorerFacadeMockRequireApprovalExpectation{}
+	}
+
+	if mmRequireApproval.defaultExpectation.params != nil {
+		mmRequireApproval.mock.t.Fatalf("RefundScorerFacadeMock.RequireApproval mock is already set by Expect")
+	}
+
+	if mmRequireApproval.defaultExpectation.paramPtrs == nil {
+		mmRequireApproval.defaultExpectation.paramPtrs = &RefundScorerFacadeMockRequireApprovalParamPtrs{}
+	}
+	mmRequireApproval.defaultExpectation.paramPtrs.clientID = &clientID
+	mmRequireApproval.defaultExpectation.expectationOrigins.originClientID = minimock.CallerInfo(1)
+
+	return mmRequireApproval
+}
+
+// ExpectNowParam2 sets up expected param now for RefundScorerFacade.RequireApproval
+func (mmRequireApproval *mRefundScorerFacadeMockRequireApproval) ExpectNowParam2(now time.Time) *mRefundScorerFacadeMockRequireApproval {
+	if mmRequireApproval.mock.funcRequireApproval != nil {
+		mmRequireApproval.mock.t.Fatalf("RefundScorerFacadeMock.RequireApproval mock is already set by Set")
+	}
+
+	if mmRequireApproval.defaultExpectation == nil {
+		mmRequireApproval.defaultExpectation = &RefundScorerFacadeMockRequireApprovalExpectation{}
+	}
+
+	if mmRequireApproval.defaultExpectation.params != nil {
+		mmRequireApproval.mock.t.Fatalf("RefundScorerFacadeMock.RequireApproval mock is already set by Expect")
+	}
+
+	if mmRequireApproval.defaultExpectation.paramPtrs == nil {
+		mmRequireApproval.defaultExpectation.paramPtrs = &RefundScorerFacadeMockRequireApprovalParamPtrs{}
+	}
+	mmRequireApproval.defaultExpectation.paramPtrs.now = &now
+	mmRequireApproval.defaultExpectation.expectationOrigins.originNow = minimock.CallerInfo(1)
+
+	return mmRequireApproval
+}
+
+// Inspect accepts an inspector function that has same arguments as the RefundScorerFacade.RequireApproval
+func (mmRequireApproval *mRefundScorerFacadeMockRequireApproval) Inspect(f func(clientID int, now time.Time)) *mRefundScorerFacadeMockRequireApproval {
+	if mmRequireApproval.mock.inspectFuncRequireApproval != nil {
+		mmRequireApproval.mock.t.Fatalf("Inspect function is already set for RefundScorerFacadeMock.RequireApproval")
+	}
+
+	mmRequireApproval.mock.inspectFuncRequireApproval = f
+
+	return mmRequireApproval
+}
+
+// Return sets up results that will be returned by RefundScorerFacade.RequireApproval
+func (mmRequireApproval *mRefundScorerFacadeMockRequireApproval) Return(b1 bool) *RefundScorerFacadeMock {
+	if mmRequireApproval.mock.funcRequireApproval != nil {
+		mmRequireApproval.mock.t.Fatalf("RefundScorerFacadeMock.RequireApproval mock is already set by Set")
+	}
+
+	if mmRequireApproval.defaultExpectation == nil {
+		mmRequireApproval.defaultExpectation = &RefundScorerFacadeMockRequireApprovalExpectation{mock: mmRequireApproval.mock}
+	}
+	mmRequireApproval.defaultExpectation.results = &RefundScorerFacadeMockRequireApprovalResults{b1}
+	mmRequireApproval.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRequireApproval.mock
+}
+
+// Set uses given function f to mock the RefundScorerFacade.RequireApproval method
+func (mmRequireApproval *mRefundScorerFacadeMockRequireApproval) Set(f func(clientID int, now time.Time) (b1 bool)) *RefundScorerFacadeMock {
+	if mmRequireApproval.defaultExpectation != nil {
+		mmRequireApproval.mock.t.Fatalf("Default expectation is already set for the RefundScorerFacade.RequireApproval method")
+	}
+
+	if len(mmRequireApproval.expectations) > 0 {
+		mmRequireApproval.mock.t.Fatalf("Some expectations are already set for the RefundScorerFacade.RequireApproval method")
+	}
+
+	mmRequireApproval.mock.funcRequireApproval = f
+	mmRequireApproval.mock.funcRequireApprovalOrigin = minimock.CallerInfo(1)
+	return mmRequireApproval.mock
+}
+
+// When sets expectation for the RefundScorerFacade.RequireApproval which will trigger the result defined by the following
+// Then helper
+func (mmRequireApproval *mRefundScorerFacadeMockRequireApproval) When(clientID int, now time.Time) *RefundScorerFacadeMockRequireApprovalExpectation {
+	if mmRequireApproval.mock.funcRequireApproval != nil {
+		mmRequireApproval.mock.t.Fatalf("RefundScorerFacadeMock.RequireApproval mock is already set by Set")
+	}
+
+	expectation := &RefundScorerFacadeMockRequireApprovalExpectation{
+		mock:               mmRequireApproval.mock,
+		params:             &RefundScorerFacadeMockRequireApprovalParams{clientID, now},
+		expectationOrigins: RefundScorerFacadeMockRequireApprovalExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRequireApproval.expectations = append(mmRequireApproval.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RefundScorerFacade.RequireApproval return parameters for the expectation previously defined by the When method
+func (e *RefundScorerFacadeMockRequireApprovalExpectation) Then(b1 bool) *RefundScorerFacadeMock {
+	e.results = &RefundScorerFacadeMockRequireApprovalResults{b1}
+	return e.mock
+}
+
+// Times sets number of times RefundScorerFacade.RequireApproval should be invoked
+func (mmRequireApproval *mRefundScorerFacadeMockRequireApproval) Times(n uint64) *mRefundScorerFacadeMockRequireApproval {
+	if n == 0 {
+		mmRequireApproval.mock.t.Fatalf("Times of RefundScorerFacadeMock.RequireApproval mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRequireApproval.expectedInvocations, n)
+	mmRequireApproval.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRequireApproval
+}
+
+func (mmRequireApproval *mRefundScorerFacadeMockRequireApproval) invocationsDone() bool {
+	if len(mmRequireApproval.expectations) == 0 && mmRequireApproval.defaultExpectation == nil && mmRequireApproval.mock.funcRequireApproval == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRequireApproval.mock.afterRequireApprovalCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRequireApproval.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RequireApproval implements mm_usecase.RefundScorerFacade
+func (mmRequireApproval *RefundScorerFacadeMock) RequireApproval(clientID int, now time.Time) (b1 bool) {
+	mm_atomic.AddUint64(&mmRequireApproval.beforeRequireApprovalCounter, 1)
+	defer mm_atomic.AddUint64(&mmRequireApproval.afterRequireApprovalCounter, 1)
+
+	mmRequireApproval.t.Helper()
+
+	if mmRequireApproval.inspectFuncRequireApproval != nil {
+		mmRequireApproval.inspectFuncRequireApproval(clientID, now)
+	}
+
+	mm_params := RefundScorerFacadeMockRequireApprovalParams{clientID, now}
+
+	// Record call args
+	mmRequireApproval.RequireApprovalMock.mutex.Lock()
+	mmRequireApproval.RequireApprovalMock.callArgs = append(mmRequireApproval.RequireApprovalMock.callArgs, &mm_params)
+	mmRequireApproval.RequireApprovalMock.mutex.Unlock()
+
+	for _, e := range mmRequireApproval.RequireApprovalMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1
+		}
+	}
+
+	if mmRequireApproval.RequireApprovalMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRequireApproval.RequireApprovalMock.defaultExpectation.Counter, 1)
+		mm_want := mmRequireApproval.RequireApprovalMock.defaultExpectation.params
+		mm_want_ptrs := mmRequireApproval.RequireApprovalMock.defaultExpectation.paramPtrs
+
+		mm_got := RefundScorerFacadeMockRequireApprovalParams{clientID, now}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.clientID != nil && !minimock.Equal(*mm_want_ptrs.clientID, mm_got.clientID) {
+				mmRequireApproval.t.Errorf("RefundScorerFacadeMock.RequireApproval got unexpected parameter clientID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRequireApproval.RequireApprovalMock.defaultExpectation.expectationOrigins.originClientID, *mm_want_ptrs.clientID, mm_got.clientID, minimock.Diff(*mm_want_ptrs.clientID, mm_got.clientID))
+			}
+
+			if mm_want_ptrs.now != nil && !minimock.Equal(*mm_want_ptrs.now, mm_got.now) {
+				mmRequireApproval.t.Errorf("RefundScorerFacadeMock.RequireApproval got unexpected parameter now, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRequireApproval.RequireApprovalMock.defaultExpectation.expectationOrigins.originNow, *mm_want_ptrs.now, mm_got.now, minimock.Diff(*mm_want_ptrs.now, mm_got.now))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRequireApproval.t.Errorf("RefundScorerFacadeMock.RequireApproval got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRequireApproval.RequireApprovalMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRequireApproval.RequireApprovalMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRequireApproval.t.Fatal("No results are set for the RefundScorerFacadeMock.RequireApproval")
+		}
+		return (*mm_results).b1
+	}
+	if mmRequireApproval.funcRequireApproval != nil {
+		return mmRequireApproval.funcRequireApproval(clientID, now)
+	}
+	mmRequireApproval.t.Fatalf("Unexpected call to RefundScorerFacadeMock.RequireApproval. %v %v", clientID, now)
+	return
+}
+
+// RequireApprovalAfterCounter returns a count of finished RefundScorerFacadeMock.RequireApproval invocations
+func (mmRequireApproval *RefundScorerFacadeMock) RequireApprovalAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequireApproval.afterRequireApprovalCounter)
+}
+
+// RequireApprovalBeforeCounter returns a count of RefundScorerFacadeMock.RequireApproval invocations
+func (mmRequireApproval *RefundScorerFacadeMock) RequireApprovalBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequireApproval.beforeRequireApprovalCounter)
+}
+
+// Calls returns a list of arguments used in each call to RefundScorerFacadeMock.RequireApproval.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRequireApproval *mRefundScorerFacadeMockRequireApproval) Calls() []*RefundScorerFacadeMockRequireApprovalParams {
+	mmRequireApproval.mutex.RLock()
+
+	argCopy := make([]*RefundScorerFacadeMockRequireApprovalParams, len(mmRequireApproval.callArgs))
+	copy(argCopy, mmRequireApproval.callArgs)
+
+	mmRequireApproval.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRequireApprovalDone returns true if the count of the RequireApproval invocations corresponds
+// the number of defined expectations
+func (m *RefundScorerFacadeMock) MinimockRequireApprovalDone() bool {
+	if m.RequireApprovalMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RequireApprovalMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RequireApprovalMock.invocationsDone()
+}
+
+// MinimockRequireApprovalInspect logs each unmet expectation
+func (m *RefundScorerFacadeMock) MinimockRequireApprovalInspect() {
+	for _, e := range m.RequireApprovalMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RefundScorerFacadeMock.RequireApproval at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRequireApprovalCounter := mm_atomic.LoadUint64(&m.afterRequireApprovalCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RequireApprovalMock.defaultExpectation != nil && afterRequireApprovalCounter < 1 {
+		if m.RequireApprovalMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RefundScorerFacadeMock.RequireApproval at\n%s", m.RequireApprovalMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RefundScorerFacadeMock.RequireApproval at\n%s with params: %#v", m.RequireApprovalMock.defaultExpectation.expectationOrigins.origin, *m.RequireApprovalMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRequireApproval != nil && afterRequireApprovalCounter < 1 {
+		m.t.Errorf("Expected call to RefundScorerFacadeMock.RequireApproval at\n%s", m.funcRequireApprovalOrigin)
+	}
+
+	if !m.RequireApprovalMock.invocationsDone() && afterRequireApprovalCounter > 0 {
+		m.t.Errorf("Expected %d calls to RefundScorerFacadeMock.RequireApproval at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RequireApprovalMock.expectedInvocations), m.RequireApprovalMock.expectedInvocationsOrigin, afterRequireApprovalCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RefundScorerFacadeMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockFlaggedClientsInspect()
+
+			m.MinimockRecordIssueInspect()
+
+			m.MinimockRecordRefundInspect()
+
+			m.MinimockRequireApprovalInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RefundScorerFacadeMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RefundScorerFacadeMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockFlaggedClientsDone() &&
+		m.MinimockRecordIssueDone() &&
+		m.MinimockRecordRefundDone() &&
+		m.MinimockRequireApprovalDone()
+}
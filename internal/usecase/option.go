@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/Na322Pr/route256/internal/clock"
+)
+
+// Option is an optional OrderUseCase dependency
+type Option func(*OrderUseCase)
+
+// WithRefundScorer enables refund abuse scoring
+func WithRefundScorer(scorer RefundScorerFacade) Option {
+	return func(uc *OrderUseCase) {
+		uc.scorer = scorer
+	}
+}
+
+// WithArchivePolicy sets age of completed orders to archive and archive batch size
+func WithArchivePolicy(olderThan time.Duration, batchSize int) Option {
+	return func(uc *OrderUseCase) {
+		if olderThan > 0 {
+			uc.archive.olderThan = olderThan
+		}
+
+		if batchSize > 0 {
+			uc.archive.batchSize = batchSize
+		}
+	}
+}
+
+// WithClock replaces system clock used for storage and refund deadlines
+func WithClock(clk clock.Clock) Option {
+	return func(uc *OrderUseCase) {
+		uc.clock = clk
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/Na322Pr/route256/internal/clock"
 	"github.com/Na322Pr/route256/internal/domain"
 	"github.com/Na322Pr/route256/internal/dto"
 	"github.com/Na322Pr/route256/internal/kafka/event"
@@ -18,45 +19,100 @@ type OrderRepoFacade interface {
 	GetOrderByID(ctx context.Context, id int64) (*dto.OrderDTO, error)
 	GetOrdersByIDs(ctx context.Context, ids []int64) (*dto.ListOrdersDTO, error)
 	GetClientOrdersList(ctx context.Context, clientID int) (*dto.ListOrdersDTO, error)
-	GetRefundsList(ctx context.Context, limit, offset int) (*dto.ListOrdersDTO, error) // Update() error
+	GetRefundsList(ctx context.Context, statuses []string, limit, offset int) (*dto.ListOrdersDTO, error) // Update() error
+	ListOrdersByStatus(ctx context.Context, statuses []string, limit, offset int) (*dto.ListOrdersDTO, error)
+	HandOverOrders(ctx context.Context, handoverDTO dto.HandoverDTO) (int64, error)
+	UpdateOrders(ctx context.Context, ordersDTO []dto.OrderDTO) error
+	ArchiveOrders(ctx context.Context, archiveDTO dto.ArchiveOrdersDTO) ([]int64, error)
+
+	// WithinTx runs fn in one read-write transaction joined by facade calls made with ctxTx
+	WithinTx(ctx context.Context, fn func(ctxTx context.Context) error) error
+	AddAuditLog(ctx context.Context, entry dto.AuditLogDTO) error
+	QueryAuditLog(ctx context.Context, filter dto.AuditLogFilterDTO) (*dto.ListAuditLogDTO, error)
 }
 
 type EventLogProducerFacade interface {
 	ProduceEvent(order dto.OrderDTO, eventType event.EventType) error
+	ProduceItemEvent(order dto.OrderDTO, item dto.OrderItemDTO, eventType event.EventType) error
 }
 
-type OrderCacheFacade interface {
-	Get(orderID int64) (*dto.OrderDTO, bool)
-	Set(orderDTO *dto.OrderDTO, now time.Time) error
+const (
+	RefundsFilterAll            = ""
+	RefundsFilterAwaitingReturn = "awaitingReturn"
+	RefundsFilterReturned       = "returned"
+)
+
+var refundsFilterStatuses = map[string][]string{
+	RefundsFilterAll: {
+		domain.OrderStatusMap[domain.OrderStatusRefunded],
+		domain.OrderStatusMap[domain.OrderStatusPartiallyRefunded],
+		domain.OrderStatusMap[domain.OrderStatusReturnedToSeller],
+	},
+	RefundsFilterAwaitingReturn: {
+		domain.OrderStatusMap[domain.OrderStatusRefunded],
+		domain.OrderStatusMap[domain.OrderStatusPartiallyRefunded],
+	},
+	RefundsFilterReturned: {
+		domain.OrderStatusMap[domain.OrderStatusReturnedToSeller],
+	},
+}
+
+var itemEventTypes = map[domain.OrderItemStatus]event.EventType{
+	domain.OrderItemStatusPickedUp: event.EventTypeItemGiveOut,
+	domain.OrderItemStatusRefused:  event.EventTypeItemRefused,
+	domain.OrderItemStatusRefunded: event.EventTypeItemRefund,
+}
+
+type RefundScorerFacade interface {
+	RecordIssue(clientID int, now time.Time)
+	RecordRefund(clientID int, cost int, now time.Time)
+	RequireApproval(clientID int, now time.Time) bool
+	FlaggedClients(now time.Time) []dto.ClientRefundScoreDTO
 }
 
 type OrderUseCase struct {
-	repo  OrderRepoFacade
-	prod  EventLogProducerFacade
-	cache OrderCacheFacade
+	repo   OrderRepoFacade
+	prod   EventLogProducerFacade
+	scorer RefundScorerFacade
+	clock  clock.Clock
+
+	archive archivePolicy
 }
 
 func NewOrderUseCase(
 	repo OrderRepoFacade,
 	prod EventLogProducerFacade,
-	cache OrderCacheFacade,
+	opts ...Option,
 ) *OrderUseCase {
-	return &OrderUseCase{
+	uc := &OrderUseCase{
 		repo:  repo,
 		prod:  prod,
-		cache: cache,
+		clock: clock.Real,
+		archive: archivePolicy{
+			olderThan: defaultArchiveOlderThan,
+			batchSize: defaultArchiveBatchSize,
+		},
+	}
+
+	for _, opt := range opts {
+		opt(uc)
 	}
+
+	return uc
 }
 
 func (uc *OrderUseCase) ReceiveOrderFromCourier(ctx context.Context, req dto.AddOrder) error {
 	op := "OrderUseCase.ReceiveOrderFromCourier"
 
-	order, err := domain.NewOrder(req)
+	order, err := domain.NewOrder(req, uc.clock)
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	err = uc.repo.AddOrder(ctx, *order.ToDTO())
+	orderDTO := *order.ToDTO()
+	err = uc.audited(ctx, op, nil, []dto.OrderDTO{orderDTO}, func(ctxTx context.Context) error {
+		return uc.repo.AddOrder(ctxTx, orderDTO)
+	})
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
@@ -69,15 +125,17 @@ func (uc *OrderUseCase) ReceiveOrderFromCourier(ctx context.Context, req dto.Add
 }
 
 func (uc *OrderUseCase) ReturnOrderToCourier(ctx context.Context, orderID int64) error {
+	return retryOnConflict(func() error {
+		return uc.returnOrderToCourier(ctx, orderID)
+	})
+}
+
+func (uc *OrderUseCase) returnOrderToCourier(ctx context.Context, orderID int64) error {
 	op := "OrderUseCase.ReturnOrderToCourier"
-	var err error
 
-	orderDTO, ok := uc.cache.Get(orderID)
-	if !ok {
-		orderDTO, err = uc.repo.GetOrderByID(ctx, orderID)
-		if err != nil {
-			return fmt.Errorf("%s: %w", op, err)
-		}
+	orderDTO, err := uc.repo.GetOrderByID(ctx, orderID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
 	}
 
 	var order domain.Order
@@ -85,6 +143,19 @@ func (uc *OrderUseCase) ReturnOrderToCourier(ctx context.Context, orderID int64)
 
 	orderStatus := order.GetOrderStatus()
 
+	// order stays picked up, only items refused at pick up go back
+	if orderStatus == "pickedUp" && order.HasRefusedItems() {
+		if err := order.ReturnRefusedItems(); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if err := uc.updateOrder(ctx, op, *orderDTO, *order.ToDTO()); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		return nil
+	}
+
 	if orderStatus == "pickedUp" {
 		return ErrOrderPickedUp
 	}
@@ -93,17 +164,32 @@ func (uc *OrderUseCase) ReturnOrderToCourier(ctx context.Context, orderID int64)
 		return ErrOrderDeleted
 	}
 
-	if orderStatus == "received" && order.GetOrderStoreUntil().After(time.Now()) {
-		return ErrOrderStoreTimeNotExpired
+	if orderStatus == "pendingApproval" {
+		return ErrOrderPendingApproval
 	}
 
-	order.SetStatus(domain.OrderStatusDelete)
+	if orderStatus == "refunded" || orderStatus == "partiallyRefunded" {
+		return ErrOrderAwaitingReturn
+	}
 
-	if err := uc.repo.UpdateOrder(ctx, *order.ToDTO()); err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+	if orderStatus == "returnedToSeller" {
+		return ErrOrderReturnedToSeller
+	}
+
+	if orderStatus == "received" && order.GetOrderStoreUntil().After(uc.clock.Now()) {
+		return ErrOrderStoreTimeNotExpired
 	}
 
-	if err := uc.cache.Set(order.ToDTO(), time.Now()); err != nil {
+	// order refused at pick up leaves with its items, so they are returned as in a picked up one
+	if order.HasRefusedItems() {
+		if err := order.ReturnRefusedItems(); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	order.SetStatus(domain.OrderStatusDelete)
+
+	if err := uc.updateOrder(ctx, op, *orderDTO, *order.ToDTO()); err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
@@ -141,35 +227,244 @@ func (uc *OrderUseCase) GiveOrderToClient(ctx context.Context, orderIDs []int64)
 		}
 	}
 
-	for i := 0; i < len(orders); i++ {
-		orders[i].SetStatus(domain.OrderStatusPickedUp)
-		orders[i].SetPickUpTime(time.Now())
+	issued, err := uc.giveClientPool(ctx, op, listOrdersDTO.Orders)
+
+	// orders written before a failure are issued, their events are sent anyway
+	for _, order := range issued {
+		if err := uc.prod.ProduceEvent(*order.ToDTO(), event.EventTypeGiveOut); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		err := uc.produceItemEvents(order, domain.OrderItemStatusPickedUp)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if uc.scorer != nil {
+		for _, order := range issued {
+			uc.scorer.RecordIssue(order.GetOrderClientID(), order.GetOrderPickUpTime())
+		}
+	}
+
+	metrics.AddIssuedOrdersTotal(len(issued), "temp")
+
+	if err != nil {
+		return fmt.Errorf("%s: %d of %d orders not issued: %w", op, len(orders)-len(issued), len(orders), err)
+	}
+
+	return nil
+}
+
+// CheckoutClient issues kept orders and queues refused ones for return to courier
+func (uc *OrderUseCase) CheckoutClient(ctx context.Context, keptIDs, refusedIDs []int64) (*dto.ListOrdersDTO, error) {
+	var checkout *dto.ListOrdersDTO
+
+	err := retryOnConflict(func() (err error) {
+		checkout, err = uc.checkoutClient(ctx, keptIDs, refusedIDs)
+		return err
+	})
+
+	return checkout, err
+}
+
+func (uc *OrderUseCase) checkoutClient(ctx context.Context, keptIDs, refusedIDs []int64) (*dto.ListOrdersDTO, error) {
+	op := "OrderUseCase.CheckoutClient"
+
+	if len(keptIDs)+len(refusedIDs) == 0 {
+		return nil, fmt.Errorf("%s: %s", op, "no order IDs")
+	}
+
+	refused := make(map[int64]struct{}, len(refusedIDs))
+	for _, id := range refusedIDs {
+		refused[id] = struct{}{}
+	}
+
+	for _, id := range keptIDs {
+		if _, ok := refused[id]; ok {
+			return nil, fmt.Errorf("%s: order %d: %w", op, id, ErrCheckoutOrdersOverlap)
+		}
+	}
+
+	orderIDs := append(append(make([]int64, 0, len(keptIDs)+len(refusedIDs)), keptIDs...), refusedIDs...)
+
+	listOrdersDTO, err := uc.repo.GetOrdersByIDs(ctx, orderIDs)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	uc.giveClientPool(ctx, orders)
+	if len(listOrdersDTO.Orders) != len(orderIDs) {
+		return nil, fmt.Errorf("%s: %s", op, "some orders not found")
+	}
+
+	now := uc.clock.Now()
+	clientID := listOrdersDTO.Orders[0].ClientID
+
+	var orders []*domain.Order
+	for i := 0; i < len(listOrdersDTO.Orders); i++ {
+		var order domain.Order
+		order.FromDTO(listOrdersDTO.Orders[i])
 
+		if order.GetOrderClientID() != clientID {
+			return nil, fmt.Errorf("%s: %w", op, ErrOrderClientMismatch)
+		}
+
+		if order.GetOrderStatus() != "received" {
+			return nil, fmt.Errorf("%s: order %d: %w", op, order.GetOrderID(), ErrOrderNotReceived)
+		}
+
+		if order.GetOrderStoreUntil().Before(now) {
+			return nil, fmt.Errorf("%s: order %d: %w", op, order.GetOrderID(), ErrOrderStoreTimeExpired)
+		}
+
+		if _, ok := refused[order.GetOrderID()]; ok {
+			order.SetStatus(domain.OrderStatusRefusedAtPickup)
+			order.RefuseAll()
+		} else {
+			order.SetStatus(domain.OrderStatusPickedUp)
+			order.SetPickUpTime(now)
+			order.GiveOutAll()
+		}
+
+		orders = append(orders, &order)
+	}
+
+	checkout := &dto.ListOrdersDTO{Orders: make([]dto.OrderDTO, 0, len(orders))}
 	for _, order := range orders {
-		if err := uc.prod.ProduceEvent(*order.ToDTO(), event.EventTypeGiveOut); err != nil {
-			return fmt.Errorf("%s: %w", op, err)
+		checkout.Orders = append(checkout.Orders, *order.ToDTO())
+	}
+
+	err = uc.audited(ctx, op, listOrdersDTO.Orders, checkout.Orders, func(ctxTx context.Context) error {
+		return uc.repo.UpdateOrders(ctxTx, checkout.Orders)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for i, order := range orders {
+		order.IncrementVersion()
+		checkout.Orders[i] = *order.ToDTO()
+
+		eventType, itemStatus := event.EventTypeGiveOut, domain.OrderItemStatusPickedUp
+		if order.GetOrderStatus() == "refusedAtPickup" {
+			eventType, itemStatus = event.EventTypeRefusedAtPickup, domain.OrderItemStatusRefused
 		}
+
+		if err := uc.prod.ProduceEvent(*order.ToDTO(), eventType); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		if err := uc.produceItemEvents(order, itemStatus); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if uc.scorer != nil {
+		for _, order := range orders {
+			if order.GetOrderStatus() == "pickedUp" {
+				uc.scorer.RecordIssue(order.GetOrderClientID(), order.GetOrderPickUpTime())
+			}
+		}
+	}
+
+	metrics.AddIssuedOrdersTotal(len(keptIDs), "temp")
+
+	return checkout, nil
+}
+
+func (uc *OrderUseCase) GiveOutOrderItems(ctx context.Context, orderID int64, skus []string) (*dto.OrderDTO, error) {
+	var orderDTO *dto.OrderDTO
+
+	err := retryOnConflict(func() (err error) {
+		orderDTO, err = uc.giveOutOrderItems(ctx, orderID, skus)
+		return err
+	})
+
+	return orderDTO, err
+}
+
+func (uc *OrderUseCase) giveOutOrderItems(ctx context.Context, orderID int64, skus []string) (*dto.OrderDTO, error) {
+	op := "OrderUseCase.GiveOutOrderItems"
+
+	orderDTO, err := uc.repo.GetOrderByID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var order domain.Order
+	order.FromDTO(*orderDTO)
+
+	if order.GetOrderStatus() != "received" {
+		return nil, fmt.Errorf("%s: %w", op, ErrOrderNotReceived)
+	}
+
+	if err := order.GiveOutItems(skus); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	order.SetStatus(domain.OrderStatusPickedUp)
+	order.SetPickUpTime(uc.clock.Now())
+
+	if err := uc.updateOrder(ctx, op, *orderDTO, *order.ToDTO()); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
+	order.IncrementVersion()
 
-	metrics.AddIssuedOrdersTotal(len(orders), "temp")
+	if err := uc.prod.ProduceEvent(*order.ToDTO(), event.EventTypeGiveOut); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = uc.produceItemEvents(&order, domain.OrderItemStatusPickedUp, domain.OrderItemStatusRefused)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if uc.scorer != nil {
+		uc.scorer.RecordIssue(order.GetOrderClientID(), order.GetOrderPickUpTime())
+	}
+
+	metrics.AddIssuedOrdersTotal(1, "temp")
+
+	return order.ToDTO(), nil
+}
+
+// produceItemEvents emits an event for every order item in one of the given statuses
+func (uc *OrderUseCase) produceItemEvents(order *domain.Order, statuses ...domain.OrderItemStatus) error {
+	orderDTO := order.ToDTO()
+
+	for _, item := range order.GetOrderItems() {
+		for _, status := range statuses {
+			if item.GetStatus() != domain.OrderItemStatusMap[status] {
+				continue
+			}
+
+			err := uc.prod.ProduceItemEvent(*orderDTO, item.ToDTO(order.GetOrderID()), itemEventTypes[status])
+			if err != nil {
+				return err
+			}
+		}
+	}
 
 	return nil
 }
 
-func (uc *OrderUseCase) giveClientPool(ctx context.Context, orders []*domain.Order) {
+type giveClientResult struct {
+	order *domain.Order
+	err   error
+}
+
+// giveClientPool issues orders concurrently and returns the written ones with the first failure
+func (uc *OrderUseCase) giveClientPool(ctx context.Context, op string, orders []dto.OrderDTO) ([]*domain.Order, error) {
 	const numWorkers = 4
 	numOrders := len(orders)
 
 	wg := sync.WaitGroup{}
-	resChan := make(chan string, numOrders)
-	orderChan := make(chan *domain.Order, numOrders)
+	resChan := make(chan giveClientResult, numOrders)
+	orderChan := make(chan dto.OrderDTO, numOrders)
 
 	for i := 0; i < numWorkers; i++ {
 		wg.Add(1)
-		go uc.giveClientWorker(ctx, &wg, orderChan, resChan)
+		go uc.giveClientWorker(ctx, op, &wg, orderChan, resChan)
 	}
 
 	go func() {
@@ -184,26 +479,75 @@ func (uc *OrderUseCase) giveClientPool(ctx context.Context, orders []*domain.Ord
 		close(resChan)
 	}()
 
+	var (
+		issued   []*domain.Order
+		firstErr error
+	)
 	for res := range resChan {
-		fmt.Println(res)
+		if res.err != nil {
+			if firstErr == nil {
+				firstErr = res.err
+			}
+			continue
+		}
+
+		issued = append(issued, res.order)
 	}
+
+	return issued, firstErr
 }
 
-func (uc *OrderUseCase) giveClientWorker(ctx context.Context, wg *sync.WaitGroup, orders <-chan *domain.Order, result chan<- string) {
+func (uc *OrderUseCase) giveClientWorker(
+	ctx context.Context,
+	op string,
+	wg *sync.WaitGroup,
+	orders <-chan dto.OrderDTO,
+	result chan<- giveClientResult,
+) {
 	defer wg.Done()
 
-	for order := range orders {
-		if err := uc.repo.UpdateOrder(ctx, *order.ToDTO()); err != nil {
-			result <- fmt.Sprintf("Order %d issue failed", order.GetOrderID())
+	for orderDTO := range orders {
+		order, err := uc.giveClientOrder(ctx, op, orderDTO)
+		if err != nil {
+			result <- giveClientResult{err: fmt.Errorf("order %d: %w", orderDTO.ID, err)}
 			continue
 		}
 
-		if err := uc.cache.Set(order.ToDTO(), time.Now()); err != nil {
-			result <- fmt.Sprintf("Order %d issue failed", order.GetOrderID())
+		result <- giveClientResult{order: order}
+	}
+}
+
+// giveClientOrder issues one order. On version conflict the order is read again
+// and is issued only if its status has not changed meanwhile
+func (uc *OrderUseCase) giveClientOrder(ctx context.Context, op string, loaded dto.OrderDTO) (*domain.Order, error) {
+	var order *domain.Order
+
+	before, attempt := &loaded, 0
+	err := retryOnConflict(func() (err error) {
+		if attempt++; attempt > 1 {
+			if before, err = uc.repo.GetOrderByID(ctx, loaded.ID); err != nil {
+				return err
+			}
+
+			if before.Status != loaded.Status {
+				return ErrOrderStatusChanged
+			}
 		}
 
-		result <- fmt.Sprintf("Order %d issued successfully", order.GetOrderID())
+		order = &domain.Order{}
+		order.FromDTO(*before)
+		order.SetStatus(domain.OrderStatusPickedUp)
+		order.SetPickUpTime(uc.clock.Now())
+		order.GiveOutAll()
+
+		return uc.updateOrder(ctx, op, *before, *order.ToDTO())
+	})
+	if err != nil {
+		return nil, err
 	}
+	order.IncrementVersion()
+
+	return order, nil
 }
 
 func (uc *OrderUseCase) OrderList(ctx context.Context, clientID int) (*dto.ListOrdersDTO, error) {
@@ -217,60 +561,247 @@ func (uc *OrderUseCase) OrderList(ctx context.Context, clientID int) (*dto.ListO
 	return listOrdersDTO, nil
 }
 
-func (uc *OrderUseCase) GetRefundFromСlient(ctx context.Context, clientID int, orderID int64) error {
+func (uc *OrderUseCase) GetRefundFromСlient(ctx context.Context, req dto.RefundOrder) (*dto.OrderDTO, error) {
+	var orderDTO *dto.OrderDTO
+
+	err := retryOnConflict(func() (err error) {
+		orderDTO, err = uc.getRefundFromClient(ctx, req)
+		return err
+	})
+
+	return orderDTO, err
+}
+
+func (uc *OrderUseCase) getRefundFromClient(ctx context.Context, req dto.RefundOrder) (*dto.OrderDTO, error) {
 	op := "OrderUseCase.GetRefundFromСlient"
-	var err error
 
-	orderDTO, ok := uc.cache.Get(orderID)
-	if !ok {
-		orderDTO, err = uc.repo.GetOrderByID(ctx, orderID)
-		if err != nil {
-			return fmt.Errorf("%s: %w", op, err)
-		}
+	orderDTO, err := uc.repo.GetOrderByID(ctx, req.OrderID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
 	var order domain.Order
 	order.FromDTO(*orderDTO)
 
-	if order.GetOrderClientID() != clientID {
-		return fmt.Errorf("%s: %w", op, ErrOrderClientMismatch)
+	if order.GetOrderClientID() != req.ClientID {
+		return nil, fmt.Errorf("%s: %w", op, ErrOrderClientMismatch)
 	}
 
-	if order.GetOrderStatus() != "pickedUp" {
-		return fmt.Errorf("%s: %w", op, ErrOrderIsNotRefundable)
+	// client may return the rest of partially refunded order within the same window
+	if status := order.GetOrderStatus(); status != "pickedUp" && status != "partiallyRefunded" {
+		return nil, fmt.Errorf("%s: %w", op, ErrOrderIsNotRefundable)
 	}
 
-	if time.Now().After(order.GetOrderPickUpTime().AddDate(0, 0, 2)) {
-		return fmt.Errorf("%s: %s", op, "refund time expired")
+	if uc.clock.Now().After(order.GetOrderPickUpTime().AddDate(0, 0, 2)) {
+		return nil, fmt.Errorf("%s: %s", op, "refund time expired")
 	}
 
-	order.SetStatus(domain.OrderStatusRefunded)
-	if err := uc.repo.UpdateOrder(ctx, *order.ToDTO()); err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+	err = order.SetRefundInspection(req.Reason, req.Comment, req.InspectionCondition, req.InspectionOutcome)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !order.RefundRejected() {
+		if err := order.RefundItems(req.SKUs); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	status, eventType := order.RefundStatus(), event.EventTypeRefund
+	switch {
+	case order.RefundRejected():
+		status, eventType = order.HeldStatus(), event.EventTypeRefundRejected
+	case uc.scorer != nil && uc.scorer.RequireApproval(req.ClientID, uc.clock.Now()):
+		status, eventType = domain.OrderStatusPendingApproval, event.EventTypeRefundPending
 	}
 
-	if err := uc.cache.Set(order.ToDTO(), time.Now()); err != nil {
+	if err := uc.changeRefundStatus(ctx, op, *orderDTO, &order, status, eventType); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return order.ToDTO(), nil
+}
+
+func (uc *OrderUseCase) ApproveRefund(ctx context.Context, orderID int64, reject bool) error {
+	return retryOnConflict(func() error {
+		return uc.approveRefund(ctx, orderID, reject)
+	})
+}
+
+func (uc *OrderUseCase) approveRefund(ctx context.Context, orderID int64, reject bool) error {
+	op := "OrderUseCase.ApproveRefund"
+
+	orderDTO, err := uc.repo.GetOrderByID(ctx, orderID)
+	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	if err := uc.prod.ProduceEvent(*order.ToDTO(), event.EventTypeRefund); err != nil {
+	var order domain.Order
+	order.FromDTO(*orderDTO)
+
+	if order.GetOrderStatus() != "pendingApproval" {
+		return fmt.Errorf("%s: %w", op, ErrOrderNotPendingApproval)
+	}
+
+	status, eventType := order.RefundStatus(), event.EventTypeRefund
+	if reject {
+		order.CancelItemsRefund()
+		status, eventType = order.HeldStatus(), event.EventTypeRefundRejected
+	}
+
+	if err := uc.changeRefundStatus(ctx, op, *orderDTO, &order, status, eventType); err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
 	return nil
 }
 
-func (uc *OrderUseCase) RefundList(ctx context.Context, limit, offset int) (*dto.ListOrdersDTO, error) {
-	op := "OrderUseCase.RefundList"
+func (uc *OrderUseCase) changeRefundStatus(
+	ctx context.Context,
+	op string,
+	before dto.OrderDTO,
+	order *domain.Order,
+	status domain.OrderStatus,
+	eventType event.EventType,
+) error {
+	// rejected refund may leave the order partially refunded by an earlier one
+	refund := eventType == event.EventTypeRefund
+
+	// items of earlier refunds of the order are neither announced nor scored again
+	var refunded []domain.OrderItem
+	if refund {
+		refunded = order.ConfirmItemsRefund()
+	}
+
+	order.SetStatus(status)
+	if err := uc.updateOrder(ctx, op, before, *order.ToDTO()); err != nil {
+		return err
+	}
+	order.IncrementVersion()
+
+	orderDTO := order.ToDTO()
+	if err := uc.prod.ProduceEvent(*orderDTO, eventType); err != nil {
+		return err
+	}
+
+	if !refund {
+		return nil
+	}
+
+	amount := 0
+	if !order.HasItems() {
+		amount = order.GetRefundAmount()
+	}
+
+	for _, item := range refunded {
+		err := uc.prod.ProduceItemEvent(*orderDTO, item.ToDTO(order.GetOrderID()), itemEventTypes[domain.OrderItemStatusRefunded])
+		if err != nil {
+			return err
+		}
+		amount += item.Amount()
+	}
+
+	if uc.scorer != nil {
+		uc.scorer.RecordRefund(order.GetOrderClientID(), amount, uc.clock.Now())
+	}
+
+	return nil
+}
+
+func (uc *OrderUseCase) ListFlaggedClients(ctx context.Context) ([]dto.ClientRefundScoreDTO, error) {
+	if uc.scorer == nil {
+		return []dto.ClientRefundScoreDTO{}, nil
+	}
+
+	return uc.scorer.FlaggedClients(uc.clock.Now()), nil
+}
+
+func (uc *OrderUseCase) ReturnRefundsToCourier(ctx context.Context, courier string, orderIDs []int64) (*dto.HandoverDTO, error) {
+	var handover *dto.HandoverDTO
+
+	err := retryOnConflict(func() (err error) {
+		handover, err = uc.returnRefundsToCourier(ctx, courier, orderIDs)
+		return err
+	})
+
+	return handover, err
+}
+
+func (uc *OrderUseCase) returnRefundsToCourier(ctx context.Context, courier string, orderIDs []int64) (*dto.HandoverDTO, error) {
+	op := "OrderUseCase.ReturnRefundsToCourier"
+
+	if len(orderIDs) == 0 {
+		return nil, fmt.Errorf("%s: %s", op, "no order IDs")
+	}
+
+	listOrdersDTO, err := uc.repo.GetOrdersByIDs(ctx, orderIDs)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
 
-	fmt.Println(limit, offset)
+	if len(listOrdersDTO.Orders) != len(orderIDs) {
+		return nil, fmt.Errorf("%s: %s", op, "some orders not found")
+	}
 
-	refundsDTO, err := uc.repo.GetRefundsList(ctx, limit, offset)
+	handover := dto.HandoverDTO{
+		Courier:      courier,
+		HandedOverAt: uc.clock.Now(),
+	}
+
+	var orders []*domain.Order
+	for i := 0; i < len(listOrdersDTO.Orders); i++ {
+		var order domain.Order
+		order.FromDTO(listOrdersDTO.Orders[i])
+
+		if status := order.GetOrderStatus(); status != "refunded" && status != "partiallyRefunded" {
+			return nil, fmt.Errorf("%s: order %d: %w", op, order.GetOrderID(), ErrOrderNotAwaitingReturn)
+		}
+
+		// items refused at pick up leave with the refunded ones, client keeps the rest
+		order.ReturnRefundedItems()
+
+		status := domain.OrderStatusReturnedToSeller
+		if order.KeepsItems() {
+			status = domain.OrderStatusPickedUp
+		}
+
+		order.SetStatus(status)
+		orders = append(orders, &order)
+		handover.Orders = append(handover.Orders, *order.ToDTO())
+	}
+
+	err = uc.audited(ctx, op, listOrdersDTO.Orders, handover.Orders, func(ctxTx context.Context) (err error) {
+		handover.ID, err = uc.repo.HandOverOrders(ctxTx, handover)
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	fmt.Println(refundsDTO)
+	for i, order := range orders {
+		order.IncrementVersion()
+		handover.Orders[i] = *order.ToDTO()
+
+		if err := uc.prod.ProduceEvent(*order.ToDTO(), event.EventTypeReturnToSeller); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	return &handover, nil
+}
+
+func (uc *OrderUseCase) RefundList(ctx context.Context, filter string, limit, offset int) (*dto.ListOrdersDTO, error) {
+	op := "OrderUseCase.RefundList"
+
+	statuses, ok := refundsFilterStatuses[filter]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidRefundsFilter)
+	}
+
+	refundsDTO, err := uc.repo.GetRefundsList(ctx, statuses, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
 
 	return refundsDTO, nil
 }
@@ -0,0 +1,212 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Na322Pr/route256/internal/cache"
+	"github.com/Na322Pr/route256/internal/clock"
+	"github.com/Na322Pr/route256/internal/domain"
+	"github.com/Na322Pr/route256/internal/dto"
+	"github.com/Na322Pr/route256/internal/kafka/event"
+	"github.com/Na322Pr/route256/internal/repository"
+	"github.com/Na322Pr/route256/internal/repository/memory"
+	"github.com/Na322Pr/route256/internal/usecase"
+	"github.com/Na322Pr/route256/internal/usecase/mock"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderUseCase_MemoryStorageRefundFlow(t *testing.T) {
+	ctx := context.Background()
+
+	ctrl := minimock.NewController(t)
+	prodMock := mock.NewEventLogProducerFacadeMock(ctrl)
+	prodMock.ProduceEventMock.Return(nil)
+	prodMock.ProduceItemEventMock.Return(nil)
+
+	repo := memory.NewFacade()
+	uc := usecase.NewOrderUseCase(repository.NewCachedFacade(repo, cache.NewOrderCache(time.Hour, 1, 0, 0)), prodMock)
+
+	err := uc.ReceiveOrderFromCourier(ctx, dto.AddOrder{
+		ID:         1,
+		ClientID:   10,
+		StoreUntil: time.Now().Add(24 * time.Hour),
+		Cost:       1000,
+		Weight:     5,
+		Items: []dto.AddOrderItem{
+			{SKU: "phone", Quantity: 1, UnitPrice: 700},
+			{SKU: "case", Quantity: 1, UnitPrice: 300},
+		},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, uc.GiveOrderToClient(ctx, []int64{1}))
+
+	refunded, err := uc.GetRefundFromСlient(ctx, dto.RefundOrder{
+		OrderID:             1,
+		ClientID:            10,
+		Reason:              "defect",
+		InspectionCondition: "damaged",
+		InspectionOutcome:   "accepted",
+		SKUs:                []string{"case"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 300, refunded.RefundAmount)
+
+	handover, err := uc.ReturnRefundsToCourier(ctx, "courier", []int64{1})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), handover.ID)
+
+	// client keeps the phone, only the case goes to courier
+	stored, err := repo.GetOrderByID(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, domain.OrderStatusMap[domain.OrderStatusPickedUp], stored.Status)
+	assert.Equal(t, int64(3), stored.Version)
+	assert.Equal(t, map[string]string{"phone": "pickedUp", "case": "refundReturned"}, itemStatuses(stored))
+
+	_, err = uc.ReturnRefundsToCourier(ctx, "courier", []int64{1})
+	assert.ErrorIs(t, err, usecase.ErrOrderNotAwaitingReturn)
+
+	refunded, err = uc.GetRefundFromСlient(ctx, dto.RefundOrder{
+		OrderID:             1,
+		ClientID:            10,
+		Reason:              "changedMind",
+		InspectionCondition: "intact",
+		InspectionOutcome:   "accepted",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, domain.OrderStatusMap[domain.OrderStatusRefunded], refunded.Status)
+	assert.Equal(t, 1000, refunded.RefundAmount)
+
+	_, err = uc.ReturnRefundsToCourier(ctx, "courier", []int64{1})
+	require.NoError(t, err)
+
+	stored, err = repo.GetOrderByID(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, domain.OrderStatusMap[domain.OrderStatusReturnedToSeller], stored.Status)
+	assert.Equal(t, map[string]string{"phone": "refundReturned", "case": "refundReturned"}, itemStatuses(stored))
+}
+
+func TestOrderUseCase_MemoryStorageSecondPartialRefund(t *testing.T) {
+	ctx := context.Background()
+
+	ctrl := minimock.NewController(t)
+	prodMock := mock.NewEventLogProducerFacadeMock(ctrl)
+	prodMock.ProduceEventMock.Return(nil)
+
+	var itemEvents []string
+	prodMock.ProduceItemEventMock.Set(func(_ dto.OrderDTO, item dto.OrderItemDTO, eventType event.EventType) error {
+		if eventType == event.EventTypeItemRefund {
+			itemEvents = append(itemEvents, item.SKU)
+		}
+		return nil
+	})
+
+	repo := memory.NewFacade()
+	uc := usecase.NewOrderUseCase(repo, prodMock)
+
+	err := uc.ReceiveOrderFromCourier(ctx, dto.AddOrder{
+		ID:         1,
+		ClientID:   10,
+		StoreUntil: time.Now().Add(24 * time.Hour),
+		Cost:       1000,
+		Weight:     5,
+		Items: []dto.AddOrderItem{
+			{SKU: "phone", Quantity: 1, UnitPrice: 600},
+			{SKU: "case", Quantity: 1, UnitPrice: 300},
+			{SKU: "cable", Quantity: 1, UnitPrice: 100},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, uc.GiveOrderToClient(ctx, []int64{1}))
+
+	for _, sku := range []string{"case", "cable"} {
+		refunded, err := uc.GetRefundFromСlient(ctx, dto.RefundOrder{
+			OrderID:             1,
+			ClientID:            10,
+			Reason:              "defect",
+			InspectionCondition: "damaged",
+			InspectionOutcome:   "accepted",
+			SKUs:                []string{sku},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, domain.OrderStatusMap[domain.OrderStatusPartiallyRefunded], refunded.Status)
+	}
+
+	stored, err := repo.GetOrderByID(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"phone": "pickedUp", "case": "refunded", "cable": "refunded"}, itemStatuses(stored))
+	// every item is announced once
+	assert.Equal(t, []string{"case", "cable"}, itemEvents)
+
+	// already refunded item is not refunded again
+	_, err = uc.GetRefundFromСlient(ctx, dto.RefundOrder{
+		OrderID:             1,
+		ClientID:            10,
+		Reason:              "defect",
+		InspectionCondition: "damaged",
+		InspectionOutcome:   "accepted",
+		SKUs:                []string{"case"},
+	})
+	assert.ErrorIs(t, err, domain.ErrItemStatusMismatch)
+}
+
+func TestOrderUseCase_MemoryStorageArchivedOrder(t *testing.T) {
+	ctx := context.Background()
+	clk := clock.NewFake(time.Date(2024, time.October, 1, 12, 0, 0, 0, time.UTC))
+
+	ctrl := minimock.NewController(t)
+	prodMock := mock.NewEventLogProducerFacadeMock(ctrl)
+	prodMock.ProduceEventMock.Return(nil)
+	prodMock.ProduceItemEventMock.Optional().Return(nil)
+
+	repo := memory.NewFacade()
+	cached := repository.NewCachedFacade(repo, cache.NewOrderCache(time.Hour, 1, 0, 0, cache.WithClock[int64, *dto.OrderDTO](clk)),
+		repository.WithClock(clk),
+	)
+	uc := usecase.NewOrderUseCase(cached, prodMock, usecase.WithClock(clk), usecase.WithArchivePolicy(time.Hour, 10))
+
+	addOrder := dto.AddOrder{ID: 1, ClientID: 10, StoreUntil: clk.Now().Add(24 * time.Hour), Cost: 1000, Weight: 5}
+	require.NoError(t, uc.ReceiveOrderFromCourier(ctx, addOrder))
+	require.NoError(t, uc.GiveOrderToClient(ctx, []int64{1}))
+
+	clk.Advance(2 * time.Hour)
+	archived, err := uc.ArchiveOrders(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, archived)
+
+	entries, err := uc.QueryAuditLog(ctx, dto.AuditLogFilterDTO{Method: "OrderUseCase.ArchiveOrders"})
+	require.NoError(t, err)
+	require.Len(t, entries.Entries, 1)
+	assert.Equal(t, []int64{1}, entries.Entries[0].OrderIDs)
+
+	// archived order is still within refund window, but can not be changed anymore
+	_, err = uc.GetRefundFromСlient(ctx, dto.RefundOrder{
+		OrderID:             1,
+		ClientID:            10,
+		Reason:              "defect",
+		InspectionCondition: "damaged",
+		InspectionOutcome:   "accepted",
+	})
+	assert.ErrorIs(t, err, usecase.ErrOrderArchived)
+
+	// nor its id is given to a new order
+	assert.ErrorIs(t, uc.ReceiveOrderFromCourier(ctx, addOrder), memory.ErrAlreadyExist)
+
+	stored, err := repo.GetOrderByID(ctx, 1)
+	require.NoError(t, err)
+	assert.True(t, stored.Archived)
+	assert.Equal(t, int64(1), stored.Version)
+}
+
+func itemStatuses(order *dto.OrderDTO) map[string]string {
+	statuses := make(map[string]string, len(order.Items))
+	for _, item := range order.Items {
+		statuses[item.SKU] = item.Status
+	}
+
+	return statuses
+}
@@ -3,9 +3,12 @@ package usecase_test
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"testing"
 	"time"
 
+	"github.com/Na322Pr/route256/internal/actor"
+	"github.com/Na322Pr/route256/internal/clock"
 	"github.com/Na322Pr/route256/internal/domain"
 	"github.com/Na322Pr/route256/internal/dto"
 	"github.com/Na322Pr/route256/internal/kafka/event"
@@ -16,6 +19,14 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+// allowAudit runs audited writes in place and accepts any audit log entry
+func allowAudit(repoMock *mock.OrderRepoFacadeMock) {
+	repoMock.WithinTxMock.Optional().Set(func(ctx context.Context, fn func(ctxTx context.Context) error) error {
+		return fn(ctx)
+	})
+	repoMock.AddAuditLogMock.Optional().Return(nil)
+}
+
 func TestOrderUseCase_ReceiveOrderFromCourier(t *testing.T) {
 	type args struct {
 		req dto.AddOrder
@@ -29,7 +40,6 @@ func TestOrderUseCase_ReceiveOrderFromCourier(t *testing.T) {
 		setup func(
 			*mock.OrderRepoFacadeMock,
 			*mock.EventLogProducerFacadeMock,
-			*mock.OrderCacheFacadeMock,
 		)
 		wantErr  bool
 		errValue error
@@ -49,7 +59,6 @@ func TestOrderUseCase_ReceiveOrderFromCourier(t *testing.T) {
 			setup: func(
 				repoMock *mock.OrderRepoFacadeMock,
 				prodMock *mock.EventLogProducerFacadeMock,
-				cacheMock *mock.OrderCacheFacadeMock,
 			) {
 				order := dto.OrderDTO{
 					ID:         1,
@@ -58,7 +67,6 @@ func TestOrderUseCase_ReceiveOrderFromCourier(t *testing.T) {
 					Status:     domain.OrderStatusMap[domain.OrderStatusReceived],
 					Cost:       1000,
 					Weight:     5,
-					PickUpTime: sql.NullTime{Valid: true},
 				}
 
 				repoMock.AddOrderMock.Expect(minimock.AnyContext, order).Return(nil)
@@ -82,7 +90,6 @@ func TestOrderUseCase_ReceiveOrderFromCourier(t *testing.T) {
 			setup: func(
 				repoMock *mock.OrderRepoFacadeMock,
 				prodMock *mock.EventLogProducerFacadeMock,
-				cacheMock *mock.OrderCacheFacadeMock,
 			) {
 				order := dto.OrderDTO{
 					ID:         1,
@@ -91,7 +98,6 @@ func TestOrderUseCase_ReceiveOrderFromCourier(t *testing.T) {
 					Status:     domain.OrderStatusMap[domain.OrderStatusReceived],
 					Cost:       1000,
 					Weight:     5,
-					PickUpTime: sql.NullTime{Valid: true},
 				}
 				repoMock.AddOrderMock.Expect(minimock.AnyContext, order).Return(postgres.ErrAlreadyExist)
 			},
@@ -105,12 +111,12 @@ func TestOrderUseCase_ReceiveOrderFromCourier(t *testing.T) {
 
 			ctrl := minimock.NewController(t)
 			repoMock := mock.NewOrderRepoFacadeMock(ctrl)
+			allowAudit(repoMock)
 			prodMock := mock.NewEventLogProducerFacadeMock(ctrl)
-			cacheMock := mock.NewOrderCacheFacadeMock(ctrl)
 
-			tt.setup(repoMock, prodMock, cacheMock)
+			tt.setup(repoMock, prodMock)
 
-			uc := usecase.NewOrderUseCase(repoMock, prodMock, cacheMock)
+			uc := usecase.NewOrderUseCase(repoMock, prodMock)
 
 			err := uc.ReceiveOrderFromCourier(context.Background(), tt.args.req)
 			if tt.wantErr {
@@ -131,7 +137,6 @@ func TestOrderUseCase_ReturnOrderToCourier(t *testing.T) {
 		args  args
 		setup func(
 			*mock.OrderRepoFacadeMock,
-			*mock.OrderCacheFacadeMock,
 		)
 		wantErr  bool
 		errValue error
@@ -141,7 +146,6 @@ func TestOrderUseCase_ReturnOrderToCourier(t *testing.T) {
 			args: args{orderID: 10},
 			setup: func(
 				repoMock *mock.OrderRepoFacadeMock,
-				cacheMock *mock.OrderCacheFacadeMock,
 			) {
 				successStoreTime := time.Now()
 
@@ -156,12 +160,62 @@ func TestOrderUseCase_ReturnOrderToCourier(t *testing.T) {
 					ClientID:   10,
 					StoreUntil: successStoreTime,
 					Status:     domain.OrderStatusMap[domain.OrderStatusDelete],
-					PickUpTime: sql.NullTime{Valid: true},
 				}
 				repoMock.UpdateOrderMock.Expect(minimock.AnyContext, updateOrder).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "SuccessRefusedAtPickup_ReturnOrderToCourier",
+			args: args{orderID: 10},
+			setup: func(
+				repoMock *mock.OrderRepoFacadeMock,
+			) {
+				order := dto.OrderDTO{
+					ClientID:   10,
+					StoreUntil: time.Now().Add(24 * time.Hour),
+					Status:     domain.OrderStatusMap[domain.OrderStatusRefusedAtPickup],
+					Cost:       1000,
+					Items: []dto.OrderItemDTO{
+						{SKU: "phone", Quantity: 1, UnitPrice: 700, Status: "refused"},
+						{SKU: "case", Quantity: 2, UnitPrice: 150, Status: "refused"},
+					},
+				}
+
+				repoMock.GetOrderByIDMock.Expect(minimock.AnyContext, 10).Return(&order, nil)
+				repoMock.UpdateOrderMock.Set(func(_ context.Context, orderDTO dto.OrderDTO) error {
+					assert.Equal(t, "deleted", orderDTO.Status)
+					for _, item := range orderDTO.Items {
+						assert.Equal(t, "returned", item.Status)
+					}
+					return nil
+				})
+			},
+			wantErr: false,
+		},
+		{
+			name: "SuccessRefusedItems_ReturnOrderToCourier",
+			args: args{orderID: 10},
+			setup: func(
+				repoMock *mock.OrderRepoFacadeMock,
+			) {
+				order := dto.OrderDTO{
+					ClientID:   10,
+					StoreUntil: time.Now(),
+					Status:     domain.OrderStatusMap[domain.OrderStatusPickedUp],
+					Cost:       1000,
+					Items: []dto.OrderItemDTO{
+						{SKU: "phone", Quantity: 1, UnitPrice: 700, Status: "pickedUp"},
+						{SKU: "case", Quantity: 2, UnitPrice: 150, Status: "refused"},
+					},
+				}
+				repoMock.GetOrderByIDMock.Expect(minimock.AnyContext, 10).Return(&order, nil)
 
-				cacheMock.GetMock.Expect(10).Return(&dto.OrderDTO{}, false)
-				cacheMock.SetMock.Return(nil)
+				repoMock.UpdateOrderMock.Set(func(_ context.Context, orderDTO dto.OrderDTO) error {
+					assert.Equal(t, "pickedUp", orderDTO.Status)
+					assert.Equal(t, "returned", orderDTO.Items[1].Status)
+					return nil
+				})
 			},
 			wantErr: false,
 		},
@@ -170,7 +224,6 @@ func TestOrderUseCase_ReturnOrderToCourier(t *testing.T) {
 			args: args{orderID: 10},
 			setup: func(
 				repoMock *mock.OrderRepoFacadeMock,
-				cacheMock *mock.OrderCacheFacadeMock,
 			) {
 				order := dto.OrderDTO{
 					ClientID:   10,
@@ -179,8 +232,6 @@ func TestOrderUseCase_ReturnOrderToCourier(t *testing.T) {
 				}
 
 				repoMock.GetOrderByIDMock.Expect(minimock.AnyContext, 10).Return(&order, nil)
-
-				cacheMock.GetMock.Expect(10).Return(&dto.OrderDTO{}, false)
 			},
 			wantErr:  true,
 			errValue: usecase.ErrOrderPickedUp,
@@ -190,7 +241,6 @@ func TestOrderUseCase_ReturnOrderToCourier(t *testing.T) {
 			args: args{orderID: 10},
 			setup: func(
 				repoMock *mock.OrderRepoFacadeMock,
-				cacheMock *mock.OrderCacheFacadeMock,
 			) {
 				order := dto.OrderDTO{
 					ClientID:   10,
@@ -199,8 +249,6 @@ func TestOrderUseCase_ReturnOrderToCourier(t *testing.T) {
 				}
 
 				repoMock.GetOrderByIDMock.Expect(minimock.AnyContext, 10).Return(&order, nil)
-
-				cacheMock.GetMock.Expect(10).Return(&dto.OrderDTO{}, false)
 			},
 			wantErr:  true,
 			errValue: usecase.ErrOrderDeleted,
@@ -210,7 +258,6 @@ func TestOrderUseCase_ReturnOrderToCourier(t *testing.T) {
 			args: args{orderID: 10},
 			setup: func(
 				repoMock *mock.OrderRepoFacadeMock,
-				cacheMock *mock.OrderCacheFacadeMock,
 			) {
 				order := dto.OrderDTO{
 					ClientID:   10,
@@ -219,11 +266,27 @@ func TestOrderUseCase_ReturnOrderToCourier(t *testing.T) {
 				}
 
 				repoMock.GetOrderByIDMock.Expect(minimock.AnyContext, 10).Return(&order, nil)
-				cacheMock.GetMock.Expect(10).Return(&dto.OrderDTO{}, false)
 			},
 			wantErr:  true,
 			errValue: usecase.ErrOrderStoreTimeNotExpired,
 		},
+		{
+			name: "ErrorOrderAwaitingReturn_ReturnOrderToCourier",
+			args: args{orderID: 10},
+			setup: func(
+				repoMock *mock.OrderRepoFacadeMock,
+			) {
+				order := dto.OrderDTO{
+					ClientID:   10,
+					StoreUntil: time.Now(),
+					Status:     domain.OrderStatusMap[domain.OrderStatusRefunded],
+				}
+
+				repoMock.GetOrderByIDMock.Expect(minimock.AnyContext, 10).Return(&order, nil)
+			},
+			wantErr:  true,
+			errValue: usecase.ErrOrderAwaitingReturn,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -231,11 +294,11 @@ func TestOrderUseCase_ReturnOrderToCourier(t *testing.T) {
 
 			ctrl := minimock.NewController(t)
 			repoMock := mock.NewOrderRepoFacadeMock(ctrl)
+			allowAudit(repoMock)
 			prodMock := mock.NewEventLogProducerFacadeMock(ctrl)
-			cacheMock := mock.NewOrderCacheFacadeMock(ctrl)
 
-			tt.setup(repoMock, cacheMock)
-			uc := usecase.NewOrderUseCase(repoMock, prodMock, cacheMock)
+			tt.setup(repoMock)
+			uc := usecase.NewOrderUseCase(repoMock, prodMock)
 
 			err := uc.ReturnOrderToCourier(context.Background(), int64(tt.args.orderID))
 			if tt.wantErr {
@@ -259,7 +322,6 @@ func TestOrderUseCase_GiveOrderToClient(t *testing.T) {
 		setup func(
 			*mock.OrderRepoFacadeMock,
 			*mock.EventLogProducerFacadeMock,
-			*mock.OrderCacheFacadeMock,
 		)
 		wantErr  bool
 		errValue error
@@ -270,7 +332,6 @@ func TestOrderUseCase_GiveOrderToClient(t *testing.T) {
 			setup: func(
 				repoMock *mock.OrderRepoFacadeMock,
 				prodMock *mock.EventLogProducerFacadeMock,
-				cacheMock *mock.OrderCacheFacadeMock,
 			) {
 
 				successStoreTime := time.Now().Add(24 * time.Hour)
@@ -290,26 +351,88 @@ func TestOrderUseCase_GiveOrderToClient(t *testing.T) {
 				repoMock.UpdateOrderMock.Return(nil)
 
 				prodMock.ProduceEventMock.Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "SuccessAfterConflict_GiveOrderToClient",
+			args: args{orderIDs: []int64{10}},
+			setup: func(
+				repoMock *mock.OrderRepoFacadeMock,
+				prodMock *mock.EventLogProducerFacadeMock,
+			) {
+				order := dto.OrderDTO{
+					ID:       10,
+					ClientID: 10,
+					Status:   domain.OrderStatusMap[domain.OrderStatusReceived],
+				}
+				fresh := order
+				fresh.Version = 1
+
+				repoMock.GetOrdersByIDsMock.Expect(minimock.AnyContext, []int64{10}).Return(&dto.ListOrdersDTO{
+					Orders: []dto.OrderDTO{order},
+				}, nil)
+				repoMock.GetOrderByIDMock.Expect(minimock.AnyContext, 10).Return(&fresh, nil)
+
+				updates := 0
+				repoMock.UpdateOrderMock.Set(func(_ context.Context, orderDTO dto.OrderDTO) error {
+					if updates++; updates == 1 {
+						return domain.ErrConcurrentModification
+					}
 
-				cacheMock.SetMock.Return(nil)
+					assert.Equal(t, int64(1), orderDTO.Version)
+					return nil
+				})
+
+				prodMock.ProduceEventMock.Return(nil)
 			},
 			wantErr: false,
 		},
+		{
+			name: "ErrorStatusChanged_GiveOrderToClient",
+			args: args{orderIDs: []int64{10}},
+			setup: func(
+				repoMock *mock.OrderRepoFacadeMock,
+				prodMock *mock.EventLogProducerFacadeMock,
+			) {
+				order := dto.OrderDTO{
+					ID:       10,
+					ClientID: 10,
+					Status:   domain.OrderStatusMap[domain.OrderStatusReceived],
+				}
+				fresh := order
+				fresh.Status = domain.OrderStatusMap[domain.OrderStatusDelete]
+
+				repoMock.GetOrdersByIDsMock.Expect(minimock.AnyContext, []int64{10}).Return(&dto.ListOrdersDTO{
+					Orders: []dto.OrderDTO{order},
+				}, nil)
+				repoMock.GetOrderByIDMock.Expect(minimock.AnyContext, 10).Return(&fresh, nil)
+				repoMock.UpdateOrderMock.Return(domain.ErrConcurrentModification)
+
+				// nothing is written, so no events are sent
+			},
+			wantErr:  true,
+			errValue: usecase.ErrOrderStatusChanged,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 
 			ctrl := minimock.NewController(t)
 			repoMock := mock.NewOrderRepoFacadeMock(ctrl)
+			allowAudit(repoMock)
 			prodMock := mock.NewEventLogProducerFacadeMock(ctrl)
-			cacheMock := mock.NewOrderCacheFacadeMock(ctrl)
 
-			tt.setup(repoMock, prodMock, cacheMock)
-			uc := usecase.NewOrderUseCase(repoMock, prodMock, cacheMock)
+			tt.setup(repoMock, prodMock)
+			uc := usecase.NewOrderUseCase(repoMock, prodMock)
 
-			if err := uc.GiveOrderToClient(context.Background(), tt.args.orderIDs); (err != nil) != tt.wantErr {
+			err := uc.GiveOrderToClient(context.Background(), tt.args.orderIDs)
+			if (err != nil) != tt.wantErr {
 				t.Errorf("OrderUseCase.GiveOrderToClient() error = %v, wantErr %v", err, tt.wantErr)
 			}
+			if tt.errValue != nil {
+				assert.ErrorIs(t, err, tt.errValue)
+			}
 		})
 	}
 }
@@ -385,11 +508,11 @@ func TestOrderUseCase_OrderList(t *testing.T) {
 
 			ctrl := minimock.NewController(t)
 			repoMock := mock.NewOrderRepoFacadeMock(ctrl)
+			allowAudit(repoMock)
 			prodMock := mock.NewEventLogProducerFacadeMock(ctrl)
-			cacheMock := mock.NewOrderCacheFacadeMock(ctrl)
 
 			tt.setup(repoMock)
-			uc := usecase.NewOrderUseCase(repoMock, prodMock, cacheMock)
+			uc := usecase.NewOrderUseCase(repoMock, prodMock)
 
 			got, err := uc.OrderList(context.Background(), tt.args.clientID)
 			if (err != nil) != tt.wantErr {
@@ -404,8 +527,7 @@ func TestOrderUseCase_OrderList(t *testing.T) {
 
 func TestOrderUseCase_GetRefundFromСlient(t *testing.T) {
 	type args struct {
-		clientID int
-		orderID  int64
+		req dto.RefundOrder
 	}
 
 	tests := []struct {
@@ -414,7 +536,6 @@ func TestOrderUseCase_GetRefundFromСlient(t *testing.T) {
 		setup func(
 			*mock.OrderRepoFacadeMock,
 			*mock.EventLogProducerFacadeMock,
-			*mock.OrderCacheFacadeMock,
 		)
 		wantErr  bool
 		errValue error
@@ -422,13 +543,17 @@ func TestOrderUseCase_GetRefundFromСlient(t *testing.T) {
 		{
 			name: "Success_GetRefundFromСlient",
 			args: args{
-				clientID: 10,
-				orderID:  11,
+				req: dto.RefundOrder{
+					OrderID:             11,
+					ClientID:            10,
+					Reason:              "defect",
+					InspectionCondition: "damaged",
+					InspectionOutcome:   "accepted",
+				},
 			},
 			setup: func(
 				repoMock *mock.OrderRepoFacadeMock,
 				prodMock *mock.EventLogProducerFacadeMock,
-				cacheMock *mock.OrderCacheFacadeMock,
 			) {
 				pickUpTime := time.Now()
 				order := dto.OrderDTO{
@@ -442,22 +567,63 @@ func TestOrderUseCase_GetRefundFromСlient(t *testing.T) {
 				repoMock.UpdateOrderMock.Return(nil)
 
 				prodMock.ProduceEventMock.Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "SuccessPartialRefund_GetRefundFromСlient",
+			args: args{
+				req: dto.RefundOrder{
+					OrderID:             11,
+					ClientID:            10,
+					Reason:              "defect",
+					InspectionCondition: "damaged",
+					InspectionOutcome:   "accepted",
+					SKUs:                []string{"case"},
+				},
+			},
+			setup: func(
+				repoMock *mock.OrderRepoFacadeMock,
+				prodMock *mock.EventLogProducerFacadeMock,
+			) {
+				order := dto.OrderDTO{
+					ID:         11,
+					ClientID:   10,
+					PickUpTime: sql.NullTime{Time: time.Now(), Valid: true},
+					Status:     domain.OrderStatusMap[domain.OrderStatusPickedUp],
+					Cost:       1000,
+					Items: []dto.OrderItemDTO{
+						{SKU: "phone", Quantity: 1, UnitPrice: 700, Status: "pickedUp"},
+						{SKU: "case", Quantity: 2, UnitPrice: 150, Status: "pickedUp"},
+					},
+				}
+
+				repoMock.GetOrderByIDMock.Expect(minimock.AnyContext, 11).Return(&order, nil)
+				repoMock.UpdateOrderMock.Set(func(_ context.Context, orderDTO dto.OrderDTO) error {
+					assert.Equal(t, "partiallyRefunded", orderDTO.Status)
+					assert.Equal(t, 300, orderDTO.RefundAmount)
+					return nil
+				})
 
-				cacheMock.GetMock.Expect(11).Return(&dto.OrderDTO{}, false)
-				cacheMock.SetMock.Return(nil)
+				prodMock.ProduceEventMock.Return(nil)
+				prodMock.ProduceItemEventMock.Return(nil)
 			},
 			wantErr: false,
 		},
 		{
 			name: "ErrorOrderClientMismatch_GetRefundFromСlient",
 			args: args{
-				clientID: 10,
-				orderID:  11,
+				req: dto.RefundOrder{
+					OrderID:             11,
+					ClientID:            10,
+					Reason:              "defect",
+					InspectionCondition: "damaged",
+					InspectionOutcome:   "accepted",
+				},
 			},
 			setup: func(
 				repoMock *mock.OrderRepoFacadeMock,
 				prodMock *mock.EventLogProducerFacadeMock,
-				cacheMock *mock.OrderCacheFacadeMock,
 			) {
 
 				pickUpTime := time.Now()
@@ -469,8 +635,6 @@ func TestOrderUseCase_GetRefundFromСlient(t *testing.T) {
 				}
 
 				repoMock.GetOrderByIDMock.Expect(minimock.AnyContext, 11).Return(&order, nil)
-
-				cacheMock.GetMock.Expect(11).Return(&dto.OrderDTO{}, false)
 			},
 			wantErr:  true,
 			errValue: usecase.ErrOrderClientMismatch,
@@ -478,13 +642,17 @@ func TestOrderUseCase_GetRefundFromСlient(t *testing.T) {
 		{
 			name: "ErrorOrderIsNotRefundable_GetRefundFromСlient",
 			args: args{
-				clientID: 10,
-				orderID:  11,
+				req: dto.RefundOrder{
+					OrderID:             11,
+					ClientID:            10,
+					Reason:              "defect",
+					InspectionCondition: "damaged",
+					InspectionOutcome:   "accepted",
+				},
 			},
 			setup: func(
 				repoMock *mock.OrderRepoFacadeMock,
 				prodMock *mock.EventLogProducerFacadeMock,
-				cacheMock *mock.OrderCacheFacadeMock,
 			) {
 				order := dto.OrderDTO{
 					ID:       11,
@@ -493,12 +661,76 @@ func TestOrderUseCase_GetRefundFromСlient(t *testing.T) {
 				}
 
 				repoMock.GetOrderByIDMock.Expect(minimock.AnyContext, 11).Return(&order, nil)
-
-				cacheMock.GetMock.Expect(11).Return(&dto.OrderDTO{}, false)
 			},
 			wantErr:  true,
 			errValue: usecase.ErrOrderIsNotRefundable,
 		},
+		{
+			name: "SuccessInspectionRejected_GetRefundFromСlient",
+			args: args{
+				req: dto.RefundOrder{
+					OrderID:             11,
+					ClientID:            10,
+					Reason:              "changedMind",
+					Comment:             "used",
+					InspectionCondition: "incomplete",
+					InspectionOutcome:   "rejected",
+				},
+			},
+			setup: func(
+				repoMock *mock.OrderRepoFacadeMock,
+				prodMock *mock.EventLogProducerFacadeMock,
+			) {
+				pickUpTime := time.Now()
+				order := dto.OrderDTO{
+					ID:         11,
+					ClientID:   10,
+					PickUpTime: sql.NullTime{Time: pickUpTime, Valid: true},
+					Status:     domain.OrderStatusMap[domain.OrderStatusPickedUp],
+				}
+
+				rejected := order
+				rejected.RefundReason = "changedMind"
+				rejected.RefundComment = "used"
+				rejected.InspectionCondition = "incomplete"
+				rejected.InspectionOutcome = "rejected"
+
+				repoMock.GetOrderByIDMock.Expect(minimock.AnyContext, 11).Return(&order, nil)
+				repoMock.UpdateOrderMock.Expect(minimock.AnyContext, rejected).Return(nil)
+
+				updated := rejected
+				updated.Version++
+				prodMock.ProduceEventMock.Expect(updated, event.EventTypeRefundRejected).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "ErrorInvalidRefundReason_GetRefundFromСlient",
+			args: args{
+				req: dto.RefundOrder{
+					OrderID:             11,
+					ClientID:            10,
+					InspectionCondition: "intact",
+					InspectionOutcome:   "accepted",
+				},
+			},
+			setup: func(
+				repoMock *mock.OrderRepoFacadeMock,
+				prodMock *mock.EventLogProducerFacadeMock,
+			) {
+				pickUpTime := time.Now()
+				order := dto.OrderDTO{
+					ID:         11,
+					ClientID:   10,
+					PickUpTime: sql.NullTime{Time: pickUpTime, Valid: true},
+					Status:     domain.OrderStatusMap[domain.OrderStatusPickedUp],
+				}
+
+				repoMock.GetOrderByIDMock.Expect(minimock.AnyContext, 11).Return(&order, nil)
+			},
+			wantErr:  true,
+			errValue: domain.ErrInvalidRefundReason,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -506,13 +738,13 @@ func TestOrderUseCase_GetRefundFromСlient(t *testing.T) {
 
 			ctrl := minimock.NewController(t)
 			repoMock := mock.NewOrderRepoFacadeMock(ctrl)
+			allowAudit(repoMock)
 			prodMock := mock.NewEventLogProducerFacadeMock(ctrl)
-			cacheMock := mock.NewOrderCacheFacadeMock(ctrl)
 
-			tt.setup(repoMock, prodMock, cacheMock)
-			uc := usecase.NewOrderUseCase(repoMock, prodMock, cacheMock)
+			tt.setup(repoMock, prodMock)
+			uc := usecase.NewOrderUseCase(repoMock, prodMock)
 
-			err := uc.GetRefundFromСlient(context.Background(), tt.args.clientID, tt.args.orderID)
+			_, err := uc.GetRefundFromСlient(context.Background(), tt.args.req)
 
 			if tt.wantErr {
 				assert.ErrorIs(t, err, tt.errValue)
@@ -524,85 +756,396 @@ func TestOrderUseCase_GetRefundFromСlient(t *testing.T) {
 	}
 }
 
-func TestOrderUseCase_RefundList(t *testing.T) {
+func TestOrderUseCase_GetRefundFromСlient_PendingApproval(t *testing.T) {
+	t.Parallel()
+
+	ctrl := minimock.NewController(t)
+	repoMock := mock.NewOrderRepoFacadeMock(ctrl)
+	allowAudit(repoMock)
+	prodMock := mock.NewEventLogProducerFacadeMock(ctrl)
+	scorerMock := mock.NewRefundScorerFacadeMock(ctrl)
+
+	pickUpTime := time.Now()
+	order := dto.OrderDTO{
+		ID:         11,
+		ClientID:   10,
+		PickUpTime: sql.NullTime{Time: pickUpTime, Valid: true},
+		Status:     domain.OrderStatusMap[domain.OrderStatusPickedUp],
+	}
+
+	pendingOrder := order
+	pendingOrder.Status = domain.OrderStatusMap[domain.OrderStatusPendingApproval]
+	pendingOrder.RefundReason = "wrongItem"
+	pendingOrder.InspectionCondition = "intact"
+	pendingOrder.InspectionOutcome = "accepted"
+
+	repoMock.GetOrderByIDMock.Expect(minimock.AnyContext, 11).Return(&order, nil)
+	repoMock.UpdateOrderMock.Expect(minimock.AnyContext, pendingOrder).Return(nil)
+	updated := pendingOrder
+	updated.Version++
+	prodMock.ProduceEventMock.Expect(updated, event.EventTypeRefundPending).Return(nil)
+	scorerMock.RequireApprovalMock.Return(true)
+
+	uc := usecase.NewOrderUseCase(repoMock, prodMock, usecase.WithRefundScorer(scorerMock))
+
+	got, err := uc.GetRefundFromСlient(context.Background(), dto.RefundOrder{
+		OrderID:             11,
+		ClientID:            10,
+		Reason:              "wrongItem",
+		InspectionCondition: "intact",
+		InspectionOutcome:   "accepted",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "pendingApproval", got.Status)
+}
+
+func TestOrderUseCase_ApproveRefund(t *testing.T) {
 	type args struct {
-		limit  int
-		offset int
+		orderID int64
+		reject  bool
 	}
 
-	successStoreTime := time.Now().Add(48 * time.Hour)
+	pickUpTime := time.Now()
 
 	tests := []struct {
-		name     string
-		args     args
-		setup    func(*mock.OrderRepoFacadeMock)
-		want     *dto.ListOrdersDTO
+		name  string
+		args  args
+		setup func(
+			*mock.OrderRepoFacadeMock,
+			*mock.EventLogProducerFacadeMock,
+			*mock.RefundScorerFacadeMock,
+		)
 		wantErr  bool
 		errValue error
 	}{
 		{
-			name: "SuccessRefundList",
-			args: args{
-				limit:  0,
-				offset: 0,
-			},
-			setup: func(repoMock *mock.OrderRepoFacadeMock) {
-				refunds := &dto.ListOrdersDTO{
-					Orders: []dto.OrderDTO{},
+			name: "SuccessApprove_ApproveRefund",
+			args: args{orderID: 11},
+			setup: func(
+				repoMock *mock.OrderRepoFacadeMock,
+				prodMock *mock.EventLogProducerFacadeMock,
+				scorerMock *mock.RefundScorerFacadeMock,
+			) {
+				order := dto.OrderDTO{
+					ID:         11,
+					ClientID:   10,
+					Cost:       1000,
+					PickUpTime: sql.NullTime{Time: pickUpTime, Valid: true},
+					Status:     domain.OrderStatusMap[domain.OrderStatusPendingApproval],
 				}
 
-				for i := 11; i <= 12; i++ {
-					refund := dto.OrderDTO{
-						ID:         int64(i),
-						ClientID:   10,
-						StoreUntil: successStoreTime,
-						Status:     domain.OrderStatusMap[domain.OrderStatusRefunded],
-						Cost:       100 * i,
-						Weight:     i,
-					}
+				refunded := order
+				refunded.Status = domain.OrderStatusMap[domain.OrderStatusRefunded]
+				refunded.PaidAmount = 1000
+				refunded.RefundAmount = 1000
 
-					refunds.Orders = append(refunds.Orders, refund)
+				repoMock.GetOrderByIDMock.Expect(minimock.AnyContext, 11).Return(&order, nil)
+				repoMock.UpdateOrderMock.Expect(minimock.AnyContext, refunded).Return(nil)
+				updated := refunded
+				updated.Version++
+				prodMock.ProduceEventMock.Expect(updated, event.EventTypeRefund).Return(nil)
+				scorerMock.RecordRefundMock.ExpectClientIDParam1(10).ExpectCostParam2(1000).Return()
+			},
+			wantErr: false,
+		},
+		{
+			name: "SuccessReject_ApproveRefund",
+			args: args{orderID: 11, reject: true},
+			setup: func(
+				repoMock *mock.OrderRepoFacadeMock,
+				prodMock *mock.EventLogProducerFacadeMock,
+				scorerMock *mock.RefundScorerFacadeMock,
+			) {
+				order := dto.OrderDTO{
+					ID:         11,
+					ClientID:   10,
+					PickUpTime: sql.NullTime{Time: pickUpTime, Valid: true},
+					Status:     domain.OrderStatusMap[domain.OrderStatusPendingApproval],
 				}
 
-				repoMock.GetRefundsListMock.Expect(minimock.AnyContext, 0, 0).Return(refunds, nil)
+				pickedUp := order
+				pickedUp.Status = domain.OrderStatusMap[domain.OrderStatusPickedUp]
 
+				repoMock.GetOrderByIDMock.Expect(minimock.AnyContext, 11).Return(&order, nil)
+				repoMock.UpdateOrderMock.Expect(minimock.AnyContext, pickedUp).Return(nil)
+				updated := pickedUp
+				updated.Version++
+				prodMock.ProduceEventMock.Expect(updated, event.EventTypeRefundRejected).Return(nil)
 			},
-			want: &dto.ListOrdersDTO{
-				Orders: []dto.OrderDTO{
-					{
-						ID:         11,
-						ClientID:   10,
-						StoreUntil: successStoreTime,
-						Status:     "refunded",
-						Cost:       1100,
-						Weight:     11,
-					},
-					{
-						ID:         12,
-						ClientID:   10,
-						StoreUntil: successStoreTime,
-						Status:     "refunded",
-						Cost:       1200,
-						Weight:     12,
+			wantErr: false,
+		},
+		{
+			name: "SuccessRejectSecondRefund_ApproveRefund",
+			args: args{orderID: 11, reject: true},
+			setup: func(
+				repoMock *mock.OrderRepoFacadeMock,
+				prodMock *mock.EventLogProducerFacadeMock,
+				scorerMock *mock.RefundScorerFacadeMock,
+			) {
+				order := dto.OrderDTO{
+					ID:         11,
+					ClientID:   10,
+					Cost:       1000,
+					PickUpTime: sql.NullTime{Time: pickUpTime, Valid: true},
+					Status:     domain.OrderStatusMap[domain.OrderStatusPendingApproval],
+					Items: []dto.OrderItemDTO{
+						{OrderID: 11, SKU: "phone", Quantity: 1, UnitPrice: 700, Status: "refundPending"},
+						{OrderID: 11, SKU: "case", Quantity: 1, UnitPrice: 300, Status: "refunded"},
 					},
-				},
+				}
+
+				repoMock.GetOrderByIDMock.Expect(minimock.AnyContext, 11).Return(&order, nil)
+				repoMock.UpdateOrderMock.Set(func(_ context.Context, orderDTO dto.OrderDTO) error {
+					// the earlier refund still waits for courier
+					assert.Equal(t, "partiallyRefunded", orderDTO.Status)
+					assert.Equal(t, "pickedUp", orderDTO.Items[0].Status)
+					assert.Equal(t, "refunded", orderDTO.Items[1].Status)
+					assert.Equal(t, 300, orderDTO.RefundAmount)
+					return nil
+				})
+				prodMock.ProduceEventMock.Return(nil)
 			},
 			wantErr: false,
 		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-
+		{
+			name: "ErrorOrderNotPendingApproval_ApproveRefund",
+			args: args{orderID: 11},
+			setup: func(
+				repoMock *mock.OrderRepoFacadeMock,
+				prodMock *mock.EventLogProducerFacadeMock,
+				scorerMock *mock.RefundScorerFacadeMock,
+			) {
+				order := dto.OrderDTO{
+					ID:       11,
+					ClientID: 10,
+					Status:   domain.OrderStatusMap[domain.OrderStatusRefunded],
+				}
+
+				repoMock.GetOrderByIDMock.Expect(minimock.AnyContext, 11).Return(&order, nil)
+			},
+			wantErr:  true,
+			errValue: usecase.ErrOrderNotPendingApproval,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
 			ctrl := minimock.NewController(t)
 			repoMock := mock.NewOrderRepoFacadeMock(ctrl)
+			allowAudit(repoMock)
+			prodMock := mock.NewEventLogProducerFacadeMock(ctrl)
+			scorerMock := mock.NewRefundScorerFacadeMock(ctrl)
+
+			tt.setup(repoMock, prodMock, scorerMock)
+			uc := usecase.NewOrderUseCase(repoMock, prodMock, usecase.WithRefundScorer(scorerMock))
+
+			err := uc.ApproveRefund(context.Background(), tt.args.orderID, tt.args.reject)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.errValue)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestOrderUseCase_ReturnRefundsToCourier(t *testing.T) {
+	type args struct {
+		courier  string
+		orderIDs []int64
+	}
+
+	tests := []struct {
+		name  string
+		args  args
+		setup func(
+			*mock.OrderRepoFacadeMock,
+			*mock.EventLogProducerFacadeMock,
+		)
+		wantErr  bool
+		errValue error
+	}{
+		{
+			name: "Success_ReturnRefundsToCourier",
+			args: args{courier: "courier-1", orderIDs: []int64{11, 12}},
+			setup: func(
+				repoMock *mock.OrderRepoFacadeMock,
+				prodMock *mock.EventLogProducerFacadeMock,
+			) {
+				orders := &dto.ListOrdersDTO{}
+				for i := 11; i <= 12; i++ {
+					orders.Orders = append(orders.Orders, dto.OrderDTO{
+						ID:       int64(i),
+						ClientID: 10,
+						Status:   domain.OrderStatusMap[domain.OrderStatusRefunded],
+					})
+				}
+
+				repoMock.GetOrdersByIDsMock.Expect(minimock.AnyContext, []int64{11, 12}).Return(orders, nil)
+				repoMock.HandOverOrdersMock.Inspect(func(ctx context.Context, handoverDTO dto.HandoverDTO) {
+					assert.Equal(t, "courier-1", handoverDTO.Courier)
+					assert.Len(t, handoverDTO.Orders, 2)
+					for _, order := range handoverDTO.Orders {
+						assert.Equal(t, "returnedToSeller", order.Status)
+					}
+				}).Return(1, nil)
+
+				prodMock.ProduceEventMock.Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "ErrorOrderNotAwaitingReturn_ReturnRefundsToCourier",
+			args: args{courier: "courier-1", orderIDs: []int64{11}},
+			setup: func(
+				repoMock *mock.OrderRepoFacadeMock,
+				prodMock *mock.EventLogProducerFacadeMock,
+			) {
+				orders := &dto.ListOrdersDTO{
+					Orders: []dto.OrderDTO{
+						{
+							ID:       11,
+							ClientID: 10,
+							Status:   domain.OrderStatusMap[domain.OrderStatusPickedUp],
+						},
+					},
+				}
+
+				repoMock.GetOrdersByIDsMock.Expect(minimock.AnyContext, []int64{11}).Return(orders, nil)
+			},
+			wantErr:  true,
+			errValue: usecase.ErrOrderNotAwaitingReturn,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := minimock.NewController(t)
+			repoMock := mock.NewOrderRepoFacadeMock(ctrl)
+			allowAudit(repoMock)
+			prodMock := mock.NewEventLogProducerFacadeMock(ctrl)
+
+			tt.setup(repoMock, prodMock)
+			uc := usecase.NewOrderUseCase(repoMock, prodMock)
+
+			handover, err := uc.ReturnRefundsToCourier(context.Background(), tt.args.courier, tt.args.orderIDs)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.errValue)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, int64(1), handover.ID)
+		})
+	}
+}
+
+func TestOrderUseCase_RefundList(t *testing.T) {
+	type args struct {
+		filter string
+		limit  int
+		offset int
+	}
+
+	successStoreTime := time.Now().Add(48 * time.Hour)
+
+	tests := []struct {
+		name     string
+		args     args
+		setup    func(*mock.OrderRepoFacadeMock)
+		want     *dto.ListOrdersDTO
+		wantErr  bool
+		errValue error
+	}{
+		{
+			name: "SuccessRefundList",
+			args: args{
+				limit:  0,
+				offset: 0,
+			},
+			setup: func(repoMock *mock.OrderRepoFacadeMock) {
+				refunds := &dto.ListOrdersDTO{
+					Orders: []dto.OrderDTO{},
+				}
+
+				for i := 11; i <= 12; i++ {
+					refund := dto.OrderDTO{
+						ID:         int64(i),
+						ClientID:   10,
+						StoreUntil: successStoreTime,
+						Status:     domain.OrderStatusMap[domain.OrderStatusRefunded],
+						Cost:       100 * i,
+						Weight:     i,
+					}
+
+					refunds.Orders = append(refunds.Orders, refund)
+				}
+
+				statuses := []string{"refunded", "partiallyRefunded", "returnedToSeller"}
+				repoMock.GetRefundsListMock.Expect(minimock.AnyContext, statuses, 0, 0).Return(refunds, nil)
+
+			},
+			want: &dto.ListOrdersDTO{
+				Orders: []dto.OrderDTO{
+					{
+						ID:         11,
+						ClientID:   10,
+						StoreUntil: successStoreTime,
+						Status:     "refunded",
+						Cost:       1100,
+						Weight:     11,
+					},
+					{
+						ID:         12,
+						ClientID:   10,
+						StoreUntil: successStoreTime,
+						Status:     "refunded",
+						Cost:       1200,
+						Weight:     12,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "SuccessAwaitingReturnRefundList",
+			args: args{
+				filter: usecase.RefundsFilterAwaitingReturn,
+				limit:  10,
+				offset: 0,
+			},
+			setup: func(repoMock *mock.OrderRepoFacadeMock) {
+				statuses := []string{"refunded", "partiallyRefunded"}
+				repoMock.GetRefundsListMock.Expect(minimock.AnyContext, statuses, 10, 0).Return(&dto.ListOrdersDTO{}, nil)
+			},
+			want:    &dto.ListOrdersDTO{},
+			wantErr: false,
+		},
+		{
+			name: "ErrorInvalidFilterRefundList",
+			args: args{
+				filter: "lost",
+			},
+			setup:   func(repoMock *mock.OrderRepoFacadeMock) {},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := minimock.NewController(t)
+			repoMock := mock.NewOrderRepoFacadeMock(ctrl)
+			allowAudit(repoMock)
 			prodMock := mock.NewEventLogProducerFacadeMock(ctrl)
-			cacheMock := mock.NewOrderCacheFacadeMock(ctrl)
 
 			tt.setup(repoMock)
-			uc := usecase.NewOrderUseCase(repoMock, prodMock, cacheMock)
+			uc := usecase.NewOrderUseCase(repoMock, prodMock)
 
-			got, err := uc.RefundList(context.Background(), tt.args.limit, tt.args.offset)
+			got, err := uc.RefundList(context.Background(), tt.args.filter, tt.args.limit, tt.args.offset)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("OrderUseCase.RefundList() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -612,3 +1155,611 @@ func TestOrderUseCase_RefundList(t *testing.T) {
 		})
 	}
 }
+
+func TestOrderUseCase_GiveOutOrderItems(t *testing.T) {
+	type args struct {
+		orderID int64
+		skus    []string
+	}
+
+	successStoreTime := time.Now().Add(24 * time.Hour)
+
+	newOrder := func(status domain.OrderStatus) dto.OrderDTO {
+		return dto.OrderDTO{
+			ID:         11,
+			ClientID:   10,
+			StoreUntil: successStoreTime,
+			Cost:       1000,
+			Status:     domain.OrderStatusMap[status],
+			Items: []dto.OrderItemDTO{
+				{OrderID: 11, SKU: "phone", Quantity: 1, UnitPrice: 700, Status: "received"},
+				{OrderID: 11, SKU: "case", Quantity: 2, UnitPrice: 150, Status: "received"},
+			},
+		}
+	}
+
+	tests := []struct {
+		name  string
+		args  args
+		setup func(
+			*mock.OrderRepoFacadeMock,
+			*mock.EventLogProducerFacadeMock,
+		)
+		wantAmount int
+		errValue   error
+	}{
+		{
+			name: "Success_GiveOutOrderItems",
+			args: args{orderID: 11, skus: []string{"phone"}},
+			setup: func(
+				repoMock *mock.OrderRepoFacadeMock,
+				prodMock *mock.EventLogProducerFacadeMock,
+			) {
+				order := newOrder(domain.OrderStatusReceived)
+
+				repoMock.GetOrderByIDMock.Expect(minimock.AnyContext, 11).Return(&order, nil)
+				repoMock.UpdateOrderMock.Return(nil)
+				prodMock.ProduceEventMock.Return(nil)
+				prodMock.ProduceItemEventMock.Times(2).Return(nil)
+			},
+			wantAmount: 700,
+		},
+		{
+			name: "ErrorOrderNotReceived_GiveOutOrderItems",
+			args: args{orderID: 11, skus: []string{"phone"}},
+			setup: func(
+				repoMock *mock.OrderRepoFacadeMock,
+				prodMock *mock.EventLogProducerFacadeMock,
+			) {
+				order := newOrder(domain.OrderStatusPickedUp)
+				repoMock.GetOrderByIDMock.Expect(minimock.AnyContext, 11).Return(&order, nil)
+			},
+			errValue: usecase.ErrOrderNotReceived,
+		},
+		{
+			name: "ErrorItemNotFound_GiveOutOrderItems",
+			args: args{orderID: 11, skus: []string{"charger"}},
+			setup: func(
+				repoMock *mock.OrderRepoFacadeMock,
+				prodMock *mock.EventLogProducerFacadeMock,
+			) {
+				order := newOrder(domain.OrderStatusReceived)
+				repoMock.GetOrderByIDMock.Expect(minimock.AnyContext, 11).Return(&order, nil)
+			},
+			errValue: domain.ErrItemNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := minimock.NewController(t)
+			repoMock := mock.NewOrderRepoFacadeMock(ctrl)
+			allowAudit(repoMock)
+			prodMock := mock.NewEventLogProducerFacadeMock(ctrl)
+
+			tt.setup(repoMock, prodMock)
+			uc := usecase.NewOrderUseCase(repoMock, prodMock)
+
+			got, err := uc.GiveOutOrderItems(context.Background(), tt.args.orderID, tt.args.skus)
+			if tt.errValue != nil {
+				assert.ErrorIs(t, err, tt.errValue)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, domain.OrderStatusMap[domain.OrderStatusPickedUp], got.Status)
+			assert.Equal(t, tt.wantAmount, got.PaidAmount)
+		})
+	}
+}
+
+func TestOrderUseCase_CheckoutClient(t *testing.T) {
+	type args struct {
+		keptIDs    []int64
+		refusedIDs []int64
+	}
+
+	successStoreTime := time.Now().Add(24 * time.Hour)
+
+	newOrder := func(id int64, clientID int, status domain.OrderStatus) dto.OrderDTO {
+		return dto.OrderDTO{
+			ID:         id,
+			ClientID:   clientID,
+			StoreUntil: successStoreTime,
+			Cost:       1000,
+			Status:     domain.OrderStatusMap[status],
+		}
+	}
+
+	tests := []struct {
+		name  string
+		args  args
+		setup func(
+			*mock.OrderRepoFacadeMock,
+			*mock.EventLogProducerFacadeMock,
+		)
+		wantStatuses map[int64]string
+		errValue     error
+	}{
+		{
+			name: "Success_CheckoutClient",
+			args: args{keptIDs: []int64{1}, refusedIDs: []int64{2}},
+			setup: func(
+				repoMock *mock.OrderRepoFacadeMock,
+				prodMock *mock.EventLogProducerFacadeMock,
+			) {
+				orders := &dto.ListOrdersDTO{
+					Orders: []dto.OrderDTO{
+						newOrder(1, 10, domain.OrderStatusReceived),
+						newOrder(2, 10, domain.OrderStatusReceived),
+					},
+				}
+
+				repoMock.GetOrdersByIDsMock.Expect(minimock.AnyContext, []int64{1, 2}).Return(orders, nil)
+				repoMock.UpdateOrdersMock.Inspect(func(ctx context.Context, ordersDTO []dto.OrderDTO) {
+					assert.Len(t, ordersDTO, 2)
+				}).Return(nil)
+				prodMock.ProduceEventMock.Set(func(order dto.OrderDTO, eventType event.EventType) error {
+					if order.ID == 1 {
+						assert.Equal(t, event.EventTypeGiveOut, eventType)
+					} else {
+						assert.Equal(t, event.EventTypeRefusedAtPickup, eventType)
+					}
+					return nil
+				})
+			},
+			wantStatuses: map[int64]string{
+				1: domain.OrderStatusMap[domain.OrderStatusPickedUp],
+				2: domain.OrderStatusMap[domain.OrderStatusRefusedAtPickup],
+			},
+		},
+		{
+			name: "ErrorOrdersOverlap_CheckoutClient",
+			args: args{keptIDs: []int64{1}, refusedIDs: []int64{1}},
+			setup: func(
+				repoMock *mock.OrderRepoFacadeMock,
+				prodMock *mock.EventLogProducerFacadeMock,
+			) {
+			},
+			errValue: usecase.ErrCheckoutOrdersOverlap,
+		},
+		{
+			name: "ErrorOrderNotReceived_CheckoutClient",
+			args: args{keptIDs: []int64{1}, refusedIDs: []int64{2}},
+			setup: func(
+				repoMock *mock.OrderRepoFacadeMock,
+				prodMock *mock.EventLogProducerFacadeMock,
+			) {
+				orders := &dto.ListOrdersDTO{
+					Orders: []dto.OrderDTO{
+						newOrder(1, 10, domain.OrderStatusReceived),
+						newOrder(2, 10, domain.OrderStatusPickedUp),
+					},
+				}
+
+				repoMock.GetOrdersByIDsMock.Return(orders, nil)
+			},
+			errValue: usecase.ErrOrderNotReceived,
+		},
+		{
+			name: "ErrorOrderClientMismatch_CheckoutClient",
+			args: args{keptIDs: []int64{1}, refusedIDs: []int64{2}},
+			setup: func(
+				repoMock *mock.OrderRepoFacadeMock,
+				prodMock *mock.EventLogProducerFacadeMock,
+			) {
+				orders := &dto.ListOrdersDTO{
+					Orders: []dto.OrderDTO{
+						newOrder(1, 10, domain.OrderStatusReceived),
+						newOrder(2, 11, domain.OrderStatusReceived),
+					},
+				}
+
+				repoMock.GetOrdersByIDsMock.Return(orders, nil)
+			},
+			errValue: usecase.ErrOrderClientMismatch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := minimock.NewController(t)
+			repoMock := mock.NewOrderRepoFacadeMock(ctrl)
+			allowAudit(repoMock)
+			prodMock := mock.NewEventLogProducerFacadeMock(ctrl)
+
+			tt.setup(repoMock, prodMock)
+			uc := usecase.NewOrderUseCase(repoMock, prodMock)
+
+			got, err := uc.CheckoutClient(context.Background(), tt.args.keptIDs, tt.args.refusedIDs)
+			if tt.errValue != nil {
+				assert.ErrorIs(t, err, tt.errValue)
+				return
+			}
+
+			assert.NoError(t, err)
+
+			statuses := make(map[int64]string)
+			for _, order := range got.Orders {
+				statuses[order.ID] = order.Status
+			}
+			assert.Equal(t, tt.wantStatuses, statuses)
+		})
+	}
+}
+
+func TestOrderUseCase_ApproveRefund_ConcurrentModification(t *testing.T) {
+	tests := []struct {
+		name      string
+		conflicts int
+		wantErr   error
+	}{
+		{
+			name:      "SuccessAfterRetry",
+			conflicts: 1,
+		},
+		{
+			name:      "ErrorConcurrentModification",
+			conflicts: 3,
+			wantErr:   domain.ErrConcurrentModification,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := minimock.NewController(t)
+			repoMock := mock.NewOrderRepoFacadeMock(ctrl)
+			allowAudit(repoMock)
+			prodMock := mock.NewEventLogProducerFacadeMock(ctrl)
+
+			stale := dto.OrderDTO{
+				ID:       11,
+				ClientID: 10,
+				Status:   domain.OrderStatusMap[domain.OrderStatusPendingApproval],
+			}
+
+			fresh := stale
+			fresh.Version = 1
+
+			updates := 0
+
+			// first read returns the stale copy, reads after a lost race see the fresh one
+			repoMock.GetOrderByIDMock.Set(func(ctx context.Context, id int64) (*dto.OrderDTO, error) {
+				if updates == 0 {
+					return &stale, nil
+				}
+
+				return &fresh, nil
+			})
+			repoMock.UpdateOrderMock.Set(func(ctx context.Context, orderDTO dto.OrderDTO) error {
+				updates++
+				if updates <= tt.conflicts {
+					return domain.ErrConcurrentModification
+				}
+
+				assert.Equal(t, fresh.Version, orderDTO.Version)
+				return nil
+			})
+			prodMock.ProduceEventMock.Optional().Return(nil)
+
+			uc := usecase.NewOrderUseCase(repoMock, prodMock)
+
+			err := uc.ApproveRefund(context.Background(), 11, true)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, tt.conflicts, updates)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.conflicts+1, updates)
+		})
+	}
+}
+
+func TestOrderUseCase_ArchiveOrders(t *testing.T) {
+	tests := []struct {
+		name        string
+		batches     [][]int64
+		batchErr    error
+		wantCount   int
+		wantCalls   int
+		wantEntries [][]int64
+		wantErr     error
+	}{
+		{
+			name:        "SuccessSeveralBatches",
+			batches:     [][]int64{{1, 2}, {3, 4}, {5}},
+			wantCount:   5,
+			wantCalls:   3,
+			wantEntries: [][]int64{{1, 2}, {3, 4}, {5}},
+		},
+		{
+			name:        "SuccessFullLastBatch",
+			batches:     [][]int64{{1, 2}, {3, 4}, nil},
+			wantCount:   4,
+			wantCalls:   3,
+			wantEntries: [][]int64{{1, 2}, {3, 4}},
+		},
+		{
+			name:        "ErrorRepository",
+			batches:     [][]int64{{1, 2}},
+			batchErr:    postgres.ErrTxRetriesExceeded,
+			wantCount:   2,
+			wantCalls:   2,
+			wantEntries: [][]int64{{1, 2}},
+			wantErr:     postgres.ErrTxRetriesExceeded,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := minimock.NewController(t)
+			repoMock := mock.NewOrderRepoFacadeMock(ctrl)
+			prodMock := mock.NewEventLogProducerFacadeMock(ctrl)
+
+			type txKey struct{}
+
+			repoMock.WithinTxMock.Set(func(ctx context.Context, fn func(ctxTx context.Context) error) error {
+				return fn(context.WithValue(ctx, txKey{}, true))
+			})
+
+			var entries [][]int64
+			repoMock.AddAuditLogMock.Set(func(ctx context.Context, e dto.AuditLogDTO) error {
+				assert.Equal(t, true, ctx.Value(txKey{}))
+				assert.Equal(t, "system", e.OperatorID)
+				assert.JSONEq(t, `[]`, string(e.Before))
+				entries = append(entries, e.OrderIDs)
+				return nil
+			})
+
+			calls := 0
+			repoMock.ArchiveOrdersMock.Set(func(ctx context.Context, archiveDTO dto.ArchiveOrdersDTO) ([]int64, error) {
+				assert.Equal(t, true, ctx.Value(txKey{}))
+				calls++
+
+				assert.Equal(t, 2, archiveDTO.Limit)
+				assert.WithinDuration(t, archiveDTO.ArchivedAt.Add(-72*time.Hour), archiveDTO.Before, time.Second)
+				assert.ElementsMatch(t, []string{
+					domain.OrderStatusMap[domain.OrderStatusPickedUp],
+					domain.OrderStatusMap[domain.OrderStatusDelete],
+					domain.OrderStatusMap[domain.OrderStatusReturnedToSeller],
+				}, archiveDTO.Statuses)
+
+				if calls > len(tt.batches) {
+					return nil, tt.batchErr
+				}
+
+				return tt.batches[calls-1], nil
+			})
+
+			uc := usecase.NewOrderUseCase(repoMock, prodMock,
+				usecase.WithArchivePolicy(72*time.Hour, 2),
+			)
+
+			archived, err := uc.ArchiveOrders(context.Background())
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.wantCount, archived)
+			assert.Equal(t, tt.wantCalls, calls)
+			assert.Equal(t, tt.wantEntries, entries)
+		})
+	}
+}
+
+func TestOrderUseCase_AuditLog(t *testing.T) {
+	pending := dto.OrderDTO{
+		ID:       11,
+		ClientID: 10,
+		Status:   domain.OrderStatusMap[domain.OrderStatusPendingApproval],
+	}
+
+	tests := []struct {
+		name     string
+		auditErr error
+		wantErr  error
+	}{
+		{
+			name: "SuccessRecorded",
+		},
+		{
+			name:     "ErrorAuditLog",
+			auditErr: postgres.ErrTxRetriesExceeded,
+			wantErr:  postgres.ErrTxRetriesExceeded,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := minimock.NewController(t)
+			repoMock := mock.NewOrderRepoFacadeMock(ctrl)
+			prodMock := mock.NewEventLogProducerFacadeMock(ctrl)
+
+			type txKey struct{}
+
+			repoMock.GetOrderByIDMock.Expect(minimock.AnyContext, 11).Return(&pending, nil)
+			repoMock.WithinTxMock.Set(func(ctx context.Context, fn func(ctxTx context.Context) error) error {
+				return fn(context.WithValue(ctx, txKey{}, true))
+			})
+			repoMock.UpdateOrderMock.Set(func(ctx context.Context, orderDTO dto.OrderDTO) error {
+				assert.Equal(t, true, ctx.Value(txKey{}))
+				return nil
+			})
+
+			var entry dto.AuditLogDTO
+			repoMock.AddAuditLogMock.Set(func(ctx context.Context, e dto.AuditLogDTO) error {
+				assert.Equal(t, true, ctx.Value(txKey{}))
+				entry = e
+				return tt.auditErr
+			})
+
+			if tt.wantErr == nil {
+				prodMock.ProduceEventMock.Return(nil)
+			}
+
+			uc := usecase.NewOrderUseCase(repoMock, prodMock)
+
+			ctx := actor.NewContext(context.Background(), actor.Actor{
+				OperatorID: "operator-1",
+				Method:     "ApproveRefund",
+				ClientIP:   "10.0.0.7",
+			})
+
+			err := uc.ApproveRefund(ctx, 11, true)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, "operator-1", entry.OperatorID)
+			assert.Equal(t, "ApproveRefund", entry.Method)
+			assert.Equal(t, "10.0.0.7", entry.ClientIP)
+			assert.Equal(t, []int64{11}, entry.OrderIDs)
+
+			var before, after []dto.OrderDTO
+			assert.NoError(t, json.Unmarshal(entry.Before, &before))
+			assert.NoError(t, json.Unmarshal(entry.After, &after))
+			assert.Equal(t, "pendingApproval", before[0].Status)
+			assert.Equal(t, "pickedUp", after[0].Status)
+		})
+	}
+}
+
+func TestOrderUseCase_AuditLog_SystemOperator(t *testing.T) {
+	ctrl := minimock.NewController(t)
+	repoMock := mock.NewOrderRepoFacadeMock(ctrl)
+	repoMock.WithinTxMock.Set(func(ctx context.Context, fn func(ctxTx context.Context) error) error {
+		return fn(ctx)
+	})
+	prodMock := mock.NewEventLogProducerFacadeMock(ctrl)
+
+	var entry dto.AuditLogDTO
+	repoMock.AddAuditLogMock.Set(func(ctx context.Context, e dto.AuditLogDTO) error {
+		entry = e
+		return nil
+	})
+	repoMock.AddOrderMock.Return(nil)
+	prodMock.ProduceEventMock.Return(nil)
+
+	uc := usecase.NewOrderUseCase(repoMock, prodMock)
+
+	err := uc.ReceiveOrderFromCourier(context.Background(), dto.AddOrder{
+		ID:         1,
+		ClientID:   1,
+		StoreUntil: time.Now().Add(time.Hour),
+		Cost:       100,
+		Weight:     1,
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "system", entry.OperatorID)
+	assert.Equal(t, "OrderUseCase.ReceiveOrderFromCourier", entry.Method)
+	assert.Equal(t, []int64{1}, entry.OrderIDs)
+	assert.JSONEq(t, `[]`, string(entry.Before))
+}
+
+func TestOrderUseCase_QueryAuditLog(t *testing.T) {
+	ctrl := minimock.NewController(t)
+	repoMock := mock.NewOrderRepoFacadeMock(ctrl)
+	prodMock := mock.NewEventLogProducerFacadeMock(ctrl)
+
+	list := &dto.ListAuditLogDTO{Entries: []dto.AuditLogDTO{{ID: 1, OperatorID: "operator-1"}}}
+	repoMock.QueryAuditLogMock.
+		Expect(minimock.AnyContext, dto.AuditLogFilterDTO{OperatorID: "operator-1", Limit: 100}).
+		Return(list, nil)
+
+	uc := usecase.NewOrderUseCase(repoMock, prodMock)
+
+	got, err := uc.QueryAuditLog(context.Background(), dto.AuditLogFilterDTO{OperatorID: "operator-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, list, got)
+}
+
+func TestOrderUseCase_StoreTimeWithClock(t *testing.T) {
+	now := time.Date(2024, time.October, 1, 12, 0, 0, 0, time.UTC)
+	clk := clock.NewFake(now)
+
+	ctrl := minimock.NewController(t)
+	repoMock := mock.NewOrderRepoFacadeMock(ctrl)
+	allowAudit(repoMock)
+	prodMock := mock.NewEventLogProducerFacadeMock(ctrl)
+
+	uc := usecase.NewOrderUseCase(repoMock, prodMock, usecase.WithClock(clk))
+
+	// order stored until the current moment is still accepted
+	repoMock.AddOrderMock.Return(nil)
+	prodMock.ProduceEventMock.Return(nil)
+	err := uc.ReceiveOrderFromCourier(context.Background(), dto.AddOrder{ID: 10, ClientID: 10, StoreUntil: now, Cost: 1000, Weight: 5})
+	assert.NoError(t, err)
+
+	clk.Advance(time.Second)
+	err = uc.ReceiveOrderFromCourier(context.Background(), dto.AddOrder{ID: 11, ClientID: 10, StoreUntil: now, Cost: 1000, Weight: 5})
+	assert.ErrorIs(t, err, domain.ErrStoreTimeExpired)
+
+	order := dto.OrderDTO{
+		ID:         10,
+		ClientID:   10,
+		StoreUntil: now.Add(24 * time.Hour),
+		Status:     domain.OrderStatusMap[domain.OrderStatusReceived],
+	}
+	repoMock.GetOrderByIDMock.Expect(minimock.AnyContext, 10).Return(&order, nil)
+	repoMock.UpdateOrderMock.Return(nil)
+
+	err = uc.ReturnOrderToCourier(context.Background(), 10)
+	assert.ErrorIs(t, err, usecase.ErrOrderStoreTimeNotExpired)
+
+	clk.Advance(25 * time.Hour)
+	err = uc.ReturnOrderToCourier(context.Background(), 10)
+	assert.NoError(t, err)
+}
+
+func TestOrderUseCase_RefundWindowWithClock(t *testing.T) {
+	pickUpTime := time.Date(2024, time.October, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		elapsed time.Duration
+		wantErr bool
+	}{
+		{name: "WithinWindow", elapsed: 47 * time.Hour},
+		{name: "WindowEnd", elapsed: 48 * time.Hour},
+		{name: "WindowExpired", elapsed: 48*time.Hour + time.Second, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := minimock.NewController(t)
+			repoMock := mock.NewOrderRepoFacadeMock(ctrl)
+			allowAudit(repoMock)
+			prodMock := mock.NewEventLogProducerFacadeMock(ctrl)
+
+			order := dto.OrderDTO{
+				ID:         11,
+				ClientID:   10,
+				PickUpTime: sql.NullTime{Time: pickUpTime, Valid: true},
+				Status:     domain.OrderStatusMap[domain.OrderStatusPickedUp],
+			}
+			repoMock.GetOrderByIDMock.Expect(minimock.AnyContext, 11).Return(&order, nil)
+			repoMock.UpdateOrderMock.Optional().Return(nil)
+			prodMock.ProduceEventMock.Optional().Return(nil)
+
+			clk := clock.NewFake(pickUpTime.Add(tt.elapsed))
+			uc := usecase.NewOrderUseCase(repoMock, prodMock, usecase.WithClock(clk))
+
+			_, err := uc.GetRefundFromСlient(context.Background(), dto.RefundOrder{
+				OrderID:             11,
+				ClientID:            10,
+				Reason:              "defect",
+				InspectionCondition: "damaged",
+				InspectionOutcome:   "accepted",
+			})
+			if tt.wantErr {
+				assert.ErrorContains(t, err, "refund time expired")
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
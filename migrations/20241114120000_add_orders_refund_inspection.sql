@@ -0,0 +1,13 @@
+-- +goose Up
+alter table orders
+    add column refund_reason varchar(50) not null default '',
+    add column refund_comment text not null default '',
+    add column inspection_condition varchar(50) not null default '',
+    add column inspection_outcome varchar(50) not null default '';
+
+-- +goose Down
+alter table orders
+    drop column if exists refund_reason,
+    drop column if exists refund_comment,
+    drop column if exists inspection_condition,
+    drop column if exists inspection_outcome;
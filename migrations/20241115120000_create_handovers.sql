@@ -0,0 +1,16 @@
+-- +goose Up
+create table handovers (
+    handover_id bigserial primary key,
+    courier varchar(100) not null,
+    handed_over_at timestamptz not null
+);
+
+create table handover_orders (
+    handover_id bigint not null references handovers(handover_id),
+    order_id bigint not null references orders(order_id),
+    primary key (handover_id, order_id)
+);
+
+-- +goose Down
+drop table if exists handover_orders;
+drop table if exists handovers;
@@ -0,0 +1,7 @@
+-- +goose Up
+alter table orders
+    add column version bigint not null default 0;
+
+-- +goose Down
+alter table orders
+    drop column if exists version;
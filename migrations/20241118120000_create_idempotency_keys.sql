@@ -0,0 +1,13 @@
+-- +goose Up
+create table idempotency_keys (
+    idempotency_key varchar(255) primary key,
+    request_hash varchar(64) not null,
+    response bytea,
+    created_at timestamptz not null,
+    expires_at timestamptz not null
+);
+
+create index idempotency_keys_expires_at_idx on idempotency_keys(expires_at);
+
+-- +goose Down
+drop table if exists idempotency_keys;
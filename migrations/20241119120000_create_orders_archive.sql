@@ -0,0 +1,41 @@
+-- +goose Up
+-- archived orders keep their handovers, so handover_orders no longer points to live orders only
+alter table handover_orders drop constraint if exists handover_orders_order_id_fkey;
+
+-- monthly partitions are created by the archiver before the first move of a month
+create table orders_archive (
+    order_id bigint not null,
+    client_id integer not null,
+    store_until timestamptz not null,
+    status varchar(50) not null,
+    cost integer not null,
+    weight integer not null,
+    packages varchar[],
+    pick_up_time timestamptz,
+    refund_reason varchar(50) not null default '',
+    refund_comment text not null default '',
+    inspection_condition varchar(50) not null default '',
+    inspection_outcome varchar(50) not null default '',
+    version bigint not null default 0,
+    archived_at timestamptz not null,
+    primary key (order_id, archived_at)
+) partition by range (archived_at);
+
+create index orders_archive_order_id_idx on orders_archive(order_id);
+
+create table order_items_archive (
+    order_id bigint not null,
+    sku varchar(64) not null,
+    name varchar(255) not null,
+    quantity integer not null,
+    unit_price integer not null,
+    status varchar(50) not null,
+    primary key (order_id, sku)
+);
+
+-- +goose Down
+drop table if exists order_items_archive;
+drop table if exists orders_archive;
+
+alter table handover_orders
+    add constraint handover_orders_order_id_fkey foreign key (order_id) references orders(order_id);
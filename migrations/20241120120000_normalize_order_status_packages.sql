@@ -0,0 +1,91 @@
+-- +goose Up
+create table order_statuses (
+    status varchar(50) primary key
+);
+
+insert into order_statuses(status) values
+    ('received'),
+    ('pickedUp'),
+    ('refunded'),
+    ('deleted'),
+    ('pendingApproval'),
+    ('returnedToSeller'),
+    ('refusedAtPickup');
+
+alter table orders
+    add constraint orders_status_fkey foreign key (status) references order_statuses(status);
+
+alter table orders_archive
+    add constraint orders_archive_status_fkey foreign key (status) references order_statuses(status);
+
+create table package_types (
+    package_type varchar(20) primary key
+);
+
+insert into package_types(package_type) values ('bag'), ('box'), ('tape');
+
+create table order_packages (
+    order_id bigint not null references orders(order_id) on delete cascade,
+    position smallint not null check (position > 0),
+    package_type varchar(20) not null references package_types(package_type),
+    primary key (order_id, position)
+);
+
+-- "unknown" is a placeholder for no package, it is not moved
+insert into order_packages(order_id, position, package_type)
+select o.order_id, row_number() over (partition by o.order_id order by p.ord), p.package_type
+from orders o, unnest(o.packages) with ordinality as p(package_type, ord)
+where p.package_type <> 'unknown';
+
+alter table orders drop column packages;
+
+alter table orders
+    add constraint orders_cost_check check (cost >= 0),
+    add constraint orders_weight_check check (weight >= 0),
+    add constraint orders_version_check check (version >= 0),
+    add constraint orders_refund_reason_check check (refund_reason in (
+        '', 'defect', 'wrongItem', 'changedMind', 'notAsDescribed', 'damagedInTransit', 'other'
+    )),
+    add constraint orders_inspection_condition_check check (inspection_condition in (
+        '', 'intact', 'damaged', 'incomplete'
+    )),
+    add constraint orders_inspection_outcome_check check (inspection_outcome in (
+        '', 'accepted', 'rejected'
+    ));
+
+alter table order_items
+    add constraint order_items_quantity_check check (quantity > 0),
+    add constraint order_items_unit_price_check check (unit_price >= 0),
+    add constraint order_items_status_check check (status in (
+        'received', 'pickedUp', 'refused', 'refunded'
+    ));
+
+-- +goose Down
+alter table order_items
+    drop constraint if exists order_items_status_check,
+    drop constraint if exists order_items_unit_price_check,
+    drop constraint if exists order_items_quantity_check;
+
+alter table orders
+    drop constraint if exists orders_inspection_outcome_check,
+    drop constraint if exists orders_inspection_condition_check,
+    drop constraint if exists orders_refund_reason_check,
+    drop constraint if exists orders_version_check,
+    drop constraint if exists orders_weight_check,
+    drop constraint if exists orders_cost_check;
+
+alter table orders add column packages varchar[];
+
+update orders set packages = (
+    select array_agg(p.package_type order by p.position)
+    from order_packages p
+    where p.order_id = orders.order_id
+);
+
+drop table if exists order_packages;
+drop table if exists package_types;
+
+alter table orders_archive drop constraint if exists orders_archive_status_fkey;
+alter table orders drop constraint if exists orders_status_fkey;
+
+drop table if exists order_statuses;
@@ -0,0 +1,18 @@
+-- +goose Up
+create table audit_log (
+    id bigserial primary key,
+    operator_id varchar(255) not null,
+    method varchar(255) not null,
+    order_ids bigint[] not null,
+    before jsonb not null,
+    after jsonb not null,
+    client_ip varchar(64) not null default '',
+    created_at timestamptz not null
+);
+
+create index audit_log_created_at_idx on audit_log(created_at);
+create index audit_log_operator_id_idx on audit_log(operator_id, created_at);
+create index audit_log_order_ids_idx on audit_log using gin(order_ids);
+
+-- +goose Down
+drop table if exists audit_log;
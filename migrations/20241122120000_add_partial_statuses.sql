@@ -0,0 +1,21 @@
+-- +goose Up
+insert into order_statuses(status) values ('partiallyRefunded');
+
+alter table order_items drop constraint if exists order_items_status_check;
+alter table order_items
+    add constraint order_items_status_check check (status in (
+        'received', 'pickedUp', 'refused', 'refunded', 'returned'
+    ));
+
+-- +goose Down
+update order_items set status = 'refused' where status = 'returned';
+
+alter table order_items drop constraint if exists order_items_status_check;
+alter table order_items
+    add constraint order_items_status_check check (status in (
+        'received', 'pickedUp', 'refused', 'refunded'
+    ));
+
+update orders set status = 'refunded' where status = 'partiallyRefunded';
+update orders_archive set status = 'refunded' where status = 'partiallyRefunded';
+delete from order_statuses where status = 'partiallyRefunded';
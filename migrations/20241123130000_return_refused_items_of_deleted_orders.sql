@@ -0,0 +1,10 @@
+-- +goose Up
+-- orders refused at pick up and returned to courier keep their items as returned
+update order_items set status = 'returned'
+where status = 'refused' and order_id in (select order_id from orders where status = 'deleted');
+
+update order_items_archive set status = 'returned'
+where status = 'refused' and order_id in (select order_id from orders_archive where status = 'deleted');
+
+-- +goose Down
+-- items returned with deleted orders can't be told apart from others, they are left as they are
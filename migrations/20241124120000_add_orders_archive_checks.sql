@@ -0,0 +1,37 @@
+-- +goose Up
+-- archive keeps orders as they were in live tables, so it is checked the same way.
+-- Constraints of partitioned orders_archive apply to all its partitions
+alter table orders_archive
+    add constraint orders_archive_cost_check check (cost >= 0),
+    add constraint orders_archive_weight_check check (weight >= 0),
+    add constraint orders_archive_version_check check (version >= 0),
+    add constraint orders_archive_refund_reason_check check (refund_reason in (
+        '', 'defect', 'wrongItem', 'changedMind', 'notAsDescribed', 'damagedInTransit', 'other'
+    )),
+    add constraint orders_archive_inspection_condition_check check (inspection_condition in (
+        '', 'intact', 'damaged', 'incomplete'
+    )),
+    add constraint orders_archive_inspection_outcome_check check (inspection_outcome in (
+        '', 'accepted', 'rejected'
+    ));
+
+alter table order_items_archive
+    add constraint order_items_archive_quantity_check check (quantity > 0),
+    add constraint order_items_archive_unit_price_check check (unit_price >= 0),
+    add constraint order_items_archive_status_check check (status in (
+        'received', 'pickedUp', 'refused', 'refunded', 'returned', 'refundPending', 'refundReturned'
+    ));
+
+-- +goose Down
+alter table order_items_archive
+    drop constraint if exists order_items_archive_status_check,
+    drop constraint if exists order_items_archive_unit_price_check,
+    drop constraint if exists order_items_archive_quantity_check;
+
+alter table orders_archive
+    drop constraint if exists orders_archive_inspection_outcome_check,
+    drop constraint if exists orders_archive_inspection_condition_check,
+    drop constraint if exists orders_archive_refund_reason_check,
+    drop constraint if exists orders_archive_version_check,
+    drop constraint if exists orders_archive_weight_check,
+    drop constraint if exists orders_archive_cost_check;
@@ -0,0 +1,13 @@
+// Package migrations keeps goose migrations for every storage dialect.
+// Each dialect has a file with the same version and name for every schema change.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var Postgres embed.FS
+
+//go:embed sqlite/*.sql
+var SQLite embed.FS
+
+const SQLiteDir = "sqlite"
@@ -0,0 +1,29 @@
+package migrations_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func migrationNames(t *testing.T, dir string) []string {
+	t.Helper()
+
+	names, err := filepath.Glob(filepath.Join(dir, "*.sql"))
+	require.NoError(t, err)
+
+	for i, name := range names {
+		names[i] = filepath.Base(name)
+	}
+
+	return names
+}
+
+func TestDialectsInSync(t *testing.T) {
+	postgres := migrationNames(t, ".")
+	require.NotEmpty(t, postgres)
+
+	assert.Equal(t, postgres, migrationNames(t, "sqlite"), "every postgres migration needs a sqlite counterpart")
+}
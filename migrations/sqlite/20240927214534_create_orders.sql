@@ -0,0 +1,14 @@
+-- +goose Up
+create table orders (
+    order_id integer primary key,
+    client_id integer not null,
+    store_until timestamp not null,
+    status varchar(50) not null,
+    cost integer not null,
+    weight integer not null,
+    packages text,
+    pick_up_time timestamp
+);
+
+-- +goose Down
+drop table if exists orders;
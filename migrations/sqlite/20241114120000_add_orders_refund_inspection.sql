@@ -0,0 +1,11 @@
+-- +goose Up
+alter table orders add column refund_reason varchar(50) not null default '';
+alter table orders add column refund_comment text not null default '';
+alter table orders add column inspection_condition varchar(50) not null default '';
+alter table orders add column inspection_outcome varchar(50) not null default '';
+
+-- +goose Down
+alter table orders drop column inspection_outcome;
+alter table orders drop column inspection_condition;
+alter table orders drop column refund_comment;
+alter table orders drop column refund_reason;
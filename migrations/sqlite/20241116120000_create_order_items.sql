@@ -0,0 +1,13 @@
+-- +goose Up
+create table order_items (
+    order_id integer not null references orders(order_id),
+    sku varchar(64) not null,
+    name varchar(255) not null,
+    quantity integer not null,
+    unit_price integer not null,
+    status varchar(50) not null,
+    primary key (order_id, sku)
+);
+
+-- +goose Down
+drop table if exists order_items;
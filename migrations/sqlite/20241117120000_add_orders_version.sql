@@ -0,0 +1,5 @@
+-- +goose Up
+alter table orders add column version integer not null default 0;
+
+-- +goose Down
+alter table orders drop column version;
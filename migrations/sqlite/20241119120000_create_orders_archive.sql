@@ -0,0 +1,54 @@
+-- +goose Up
+-- archived orders keep their handovers, sqlite can't drop a constraint so the table is rebuilt
+create table handover_orders_new (
+    handover_id integer not null references handovers(handover_id),
+    order_id integer not null,
+    primary key (handover_id, order_id)
+);
+
+insert into handover_orders_new(handover_id, order_id) select handover_id, order_id from handover_orders;
+drop table handover_orders;
+alter table handover_orders_new rename to handover_orders;
+
+create table orders_archive (
+    order_id integer primary key,
+    client_id integer not null,
+    store_until timestamp not null,
+    status varchar(50) not null,
+    cost integer not null,
+    weight integer not null,
+    packages text,
+    pick_up_time timestamp,
+    refund_reason varchar(50) not null default '',
+    refund_comment text not null default '',
+    inspection_condition varchar(50) not null default '',
+    inspection_outcome varchar(50) not null default '',
+    version integer not null default 0,
+    archived_at timestamp not null
+);
+
+create index orders_archive_archived_at_idx on orders_archive(archived_at);
+
+create table order_items_archive (
+    order_id integer not null,
+    sku varchar(64) not null,
+    name varchar(255) not null,
+    quantity integer not null,
+    unit_price integer not null,
+    status varchar(50) not null,
+    primary key (order_id, sku)
+);
+
+-- +goose Down
+drop table if exists order_items_archive;
+drop table if exists orders_archive;
+
+create table handover_orders_old (
+    handover_id integer not null references handovers(handover_id),
+    order_id integer not null references orders(order_id),
+    primary key (handover_id, order_id)
+);
+
+insert into handover_orders_old(handover_id, order_id) select handover_id, order_id from handover_orders;
+drop table handover_orders;
+alter table handover_orders_old rename to handover_orders;
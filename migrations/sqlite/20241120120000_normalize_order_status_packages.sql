@@ -0,0 +1,5 @@
+-- +goose Up
+-- statuses and packages are normalized in postgres only, sqlite storage is
+-- written by the service alone and keeps them as they are
+
+-- +goose Down
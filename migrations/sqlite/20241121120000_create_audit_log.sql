@@ -0,0 +1,17 @@
+-- +goose Up
+create table audit_log (
+    id integer primary key autoincrement,
+    operator_id varchar(255) not null,
+    method varchar(255) not null,
+    order_ids text not null,
+    before text not null,
+    after text not null,
+    client_ip varchar(64) not null default '',
+    created_at timestamp not null
+);
+
+create index audit_log_created_at_idx on audit_log(created_at);
+create index audit_log_operator_id_idx on audit_log(operator_id, created_at);
+
+-- +goose Down
+drop table if exists audit_log;
@@ -0,0 +1,4 @@
+-- +goose Up
+-- statuses are checked in postgres only, sqlite storage takes new ones as they are
+
+-- +goose Down
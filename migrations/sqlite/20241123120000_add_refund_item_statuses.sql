@@ -0,0 +1,14 @@
+-- +goose Up
+-- refunds waiting for approval and refunds handed over to courier get their own item statuses
+update order_items set status = 'refundPending'
+where status = 'refunded' and order_id in (select order_id from orders where status = 'pendingApproval');
+
+update order_items set status = 'refundReturned'
+where status = 'refunded' and order_id in (select order_id from orders where status = 'returnedToSeller');
+
+update order_items_archive set status = 'refundReturned'
+where status = 'refunded' and order_id in (select order_id from orders_archive where status = 'returnedToSeller');
+
+-- +goose Down
+update order_items set status = 'refunded' where status in ('refundPending', 'refundReturned');
+update order_items_archive set status = 'refunded' where status in ('refundPending', 'refundReturned');
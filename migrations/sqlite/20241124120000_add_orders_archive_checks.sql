@@ -0,0 +1,4 @@
+-- +goose Up
+-- like the live tables, archive tables are checked in postgres only
+
+-- +goose Down
@@ -30,14 +30,21 @@ type Order struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id         int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	ClientId   int32                  `protobuf:"varint,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	StoreUntil *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=store_until,json=storeUntil,proto3" json:"store_until,omitempty"`
-	Status     string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	Cost       int32                  `protobuf:"varint,5,opt,name=cost,proto3" json:"cost,omitempty"`
-	Weight     int32                  `protobuf:"varint,6,opt,name=weight,proto3" json:"weight,omitempty"`
-	Packages   []string               `protobuf:"bytes,7,rep,name=packages,proto3" json:"packages,omitempty"`
-	PickUpTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=pick_up_time,json=pickUpTime,proto3" json:"pick_up_time,omitempty"`
+	Id                  int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClientId            int32                  `protobuf:"varint,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	StoreUntil          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=store_until,json=storeUntil,proto3" json:"store_until,omitempty"`
+	Status              string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Cost                int32                  `protobuf:"varint,5,opt,name=cost,proto3" json:"cost,omitempty"`
+	Weight              int32                  `protobuf:"varint,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	Packages            []string               `protobuf:"bytes,7,rep,name=packages,proto3" json:"packages,omitempty"`
+	PickUpTime          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=pick_up_time,json=pickUpTime,proto3" json:"pick_up_time,omitempty"`
+	RefundReason        string                 `protobuf:"bytes,9,opt,name=refund_reason,json=refundReason,proto3" json:"refund_reason,omitempty"`
+	RefundComment       string                 `protobuf:"bytes,10,opt,name=refund_comment,json=refundComment,proto3" json:"refund_comment,omitempty"`
+	InspectionCondition string                 `protobuf:"bytes,11,opt,name=inspection_condition,json=inspectionCondition,proto3" json:"inspection_condition,omitempty"`
+	InspectionOutcome   string                 `protobuf:"bytes,12,opt,name=inspection_outcome,json=inspectionOutcome,proto3" json:"inspection_outcome,omitempty"`
+	Items               []*OrderItem           `protobuf:"bytes,13,rep,name=items,proto3" json:"items,omitempty"`
+	PaidAmount          int32                  `protobuf:"varint,14,opt,name=paid_amount,json=paidAmount,proto3" json:"paid_amount,omitempty"`
+	RefundAmount        int32                  `protobuf:"varint,15,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`
 }
 
 func (x *Order) Reset() {
@@ -126,6 +133,201 @@ func (x *Order) GetPickUpTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Order) GetRefundReason() string {
+	if x != nil {
+		return x.RefundReason
+	}
+	return ""
+}
+
+func (x *Order) GetRefundComment() string {
+	if x != nil {
+		return x.RefundComment
+	}
+	return ""
+}
+
+func (x *Order) GetInspectionCondition() string {
+	if x != nil {
+		return x.InspectionCondition
+	}
+	return ""
+}
+
+func (x *Order) GetInspectionOutcome() string {
+	if x != nil {
+		return x.InspectionOutcome
+	}
+	return ""
+}
+
+func (x *Order) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Order) GetPaidAmount() int32 {
+	if x != nil {
+		return x.PaidAmount
+	}
+	return 0
+}
+
+func (x *Order) GetRefundAmount() int32 {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return 0
+}
+
+type OrderItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sku       string `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Quantity  int32  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice int32  `protobuf:"varint,4,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	Status    string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *OrderItem) Reset() {
+	*x = OrderItem{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderItem) ProtoMessage() {}
+
+func (x *OrderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
+func (*OrderItem) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OrderItem) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *OrderItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OrderItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderItem) GetUnitPrice() int32 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *OrderItem) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type NewOrderItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sku       string `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Quantity  int32  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice int32  `protobuf:"varint,4,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+}
+
+func (x *NewOrderItem) Reset() {
+	*x = NewOrderItem{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewOrderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewOrderItem) ProtoMessage() {}
+
+func (x *NewOrderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewOrderItem.ProtoReflect.Descriptor instead.
+func (*NewOrderItem) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NewOrderItem) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *NewOrderItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NewOrderItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *NewOrderItem) GetUnitPrice() int32 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
 type ReceiveCourierRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -137,11 +339,12 @@ type ReceiveCourierRequest struct {
 	Cost       int32                  `protobuf:"varint,4,opt,name=cost,proto3" json:"cost,omitempty"`
 	Weight     int32                  `protobuf:"varint,5,opt,name=weight,proto3" json:"weight,omitempty"`
 	Packages   []string               `protobuf:"bytes,6,rep,name=packages,proto3" json:"packages,omitempty"`
+	Items      []*NewOrderItem        `protobuf:"bytes,7,rep,name=items,proto3" json:"items,omitempty"`
 }
 
 func (x *ReceiveCourierRequest) Reset() {
 	*x = ReceiveCourierRequest{}
-	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[1]
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -153,7 +356,7 @@ func (x *ReceiveCourierRequest) String() string {
 func (*ReceiveCourierRequest) ProtoMessage() {}
 
 func (x *ReceiveCourierRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[1]
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -166,7 +369,7 @@ func (x *ReceiveCourierRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReceiveCourierRequest.ProtoReflect.Descriptor instead.
 func (*ReceiveCourierRequest) Descriptor() ([]byte, []int) {
-	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{1}
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ReceiveCourierRequest) GetOrderId() int64 {
@@ -211,6 +414,13 @@ func (x *ReceiveCourierRequest) GetPackages() []string {
 	return nil
 }
 
+func (x *ReceiveCourierRequest) GetItems() []*NewOrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
 type ReceiveCourierResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -219,7 +429,7 @@ type ReceiveCourierResponse struct {
 
 func (x *ReceiveCourierResponse) Reset() {
 	*x = ReceiveCourierResponse{}
-	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[2]
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -231,7 +441,7 @@ func (x *ReceiveCourierResponse) String() string {
 func (*ReceiveCourierResponse) ProtoMessage() {}
 
 func (x *ReceiveCourierResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[2]
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -244,7 +454,7 @@ func (x *ReceiveCourierResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReceiveCourierResponse.ProtoReflect.Descriptor instead.
 func (*ReceiveCourierResponse) Descriptor() ([]byte, []int) {
-	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{2}
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{4}
 }
 
 type ReturnCourierRequest struct {
@@ -257,7 +467,7 @@ type ReturnCourierRequest struct {
 
 func (x *ReturnCourierRequest) Reset() {
 	*x = ReturnCourierRequest{}
-	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[3]
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -269,7 +479,7 @@ func (x *ReturnCourierRequest) String() string {
 func (*ReturnCourierRequest) ProtoMessage() {}
 
 func (x *ReturnCourierRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[3]
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -282,7 +492,7 @@ func (x *ReturnCourierRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReturnCourierRequest.ProtoReflect.Descriptor instead.
 func (*ReturnCourierRequest) Descriptor() ([]byte, []int) {
-	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{3}
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ReturnCourierRequest) GetOrderId() int64 {
@@ -300,7 +510,7 @@ type ReturnCourierResponse struct {
 
 func (x *ReturnCourierResponse) Reset() {
 	*x = ReturnCourierResponse{}
-	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[4]
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -312,7 +522,7 @@ func (x *ReturnCourierResponse) String() string {
 func (*ReturnCourierResponse) ProtoMessage() {}
 
 func (x *ReturnCourierResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[4]
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -325,7 +535,7 @@ func (x *ReturnCourierResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReturnCourierResponse.ProtoReflect.Descriptor instead.
 func (*ReturnCourierResponse) Descriptor() ([]byte, []int) {
-	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{4}
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{6}
 }
 
 type GiveOutClientRequest struct {
@@ -338,7 +548,7 @@ type GiveOutClientRequest struct {
 
 func (x *GiveOutClientRequest) Reset() {
 	*x = GiveOutClientRequest{}
-	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[5]
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -350,7 +560,7 @@ func (x *GiveOutClientRequest) String() string {
 func (*GiveOutClientRequest) ProtoMessage() {}
 
 func (x *GiveOutClientRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[5]
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -363,7 +573,7 @@ func (x *GiveOutClientRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GiveOutClientRequest.ProtoReflect.Descriptor instead.
 func (*GiveOutClientRequest) Descriptor() ([]byte, []int) {
-	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{5}
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GiveOutClientRequest) GetOrdersIds() []int64 {
@@ -381,7 +591,7 @@ type GiveOutClientResponse struct {
 
 func (x *GiveOutClientResponse) Reset() {
 	*x = GiveOutClientResponse{}
-	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[6]
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -393,7 +603,7 @@ func (x *GiveOutClientResponse) String() string {
 func (*GiveOutClientResponse) ProtoMessage() {}
 
 func (x *GiveOutClientResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[6]
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -406,33 +616,33 @@ func (x *GiveOutClientResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GiveOutClientResponse.ProtoReflect.Descriptor instead.
 func (*GiveOutClientResponse) Descriptor() ([]byte, []int) {
-	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{6}
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{8}
 }
 
-type RefundClientRequest struct {
+type CheckoutClientRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderId  int64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	ClientId int32 `protobuf:"varint,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	KeptOrdersIds    []int64 `protobuf:"varint,1,rep,packed,name=kept_orders_ids,json=keptOrdersIds,proto3" json:"kept_orders_ids,omitempty"`
+	RefusedOrdersIds []int64 `protobuf:"varint,2,rep,packed,name=refused_orders_ids,json=refusedOrdersIds,proto3" json:"refused_orders_ids,omitempty"`
 }
 
-func (x *RefundClientRequest) Reset() {
-	*x = RefundClientRequest{}
-	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[7]
+func (x *CheckoutClientRequest) Reset() {
+	*x = CheckoutClientRequest{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RefundClientRequest) String() string {
+func (x *CheckoutClientRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefundClientRequest) ProtoMessage() {}
+func (*CheckoutClientRequest) ProtoMessage() {}
 
-func (x *RefundClientRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[7]
+func (x *CheckoutClientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -443,46 +653,48 @@ func (x *RefundClientRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefundClientRequest.ProtoReflect.Descriptor instead.
-func (*RefundClientRequest) Descriptor() ([]byte, []int) {
-	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use CheckoutClientRequest.ProtoReflect.Descriptor instead.
+func (*CheckoutClientRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *RefundClientRequest) GetOrderId() int64 {
+func (x *CheckoutClientRequest) GetKeptOrdersIds() []int64 {
 	if x != nil {
-		return x.OrderId
+		return x.KeptOrdersIds
 	}
-	return 0
+	return nil
 }
 
-func (x *RefundClientRequest) GetClientId() int32 {
+func (x *CheckoutClientRequest) GetRefusedOrdersIds() []int64 {
 	if x != nil {
-		return x.ClientId
+		return x.RefusedOrdersIds
 	}
-	return 0
+	return nil
 }
 
-type RefundClientResponse struct {
+type CheckoutClientResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Orders []*Order `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
 }
 
-func (x *RefundClientResponse) Reset() {
-	*x = RefundClientResponse{}
-	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[8]
+func (x *CheckoutClientResponse) Reset() {
+	*x = CheckoutClientResponse{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RefundClientResponse) String() string {
+func (x *CheckoutClientResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefundClientResponse) ProtoMessage() {}
+func (*CheckoutClientResponse) ProtoMessage() {}
 
-func (x *RefundClientResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[8]
+func (x *CheckoutClientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -493,36 +705,42 @@ func (x *RefundClientResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefundClientResponse.ProtoReflect.Descriptor instead.
-func (*RefundClientResponse) Descriptor() ([]byte, []int) {
-	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use CheckoutClientResponse.ProtoReflect.Descriptor instead.
+func (*CheckoutClientResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{10}
 }
 
-type OrderListRequest struct {
+func (x *CheckoutClientResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type GiveOutItemsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClientId int32  `protobuf:"varint,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	Limit    *int32 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
-	Offset   int64  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	OrderId int64    `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Skus    []string `protobuf:"bytes,2,rep,name=skus,proto3" json:"skus,omitempty"`
 }
 
-func (x *OrderListRequest) Reset() {
-	*x = OrderListRequest{}
-	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[9]
+func (x *GiveOutItemsRequest) Reset() {
+	*x = GiveOutItemsRequest{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderListRequest) String() string {
+func (x *GiveOutItemsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderListRequest) ProtoMessage() {}
+func (*GiveOutItemsRequest) ProtoMessage() {}
 
-func (x *OrderListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[9]
+func (x *GiveOutItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -533,55 +751,775 @@ func (x *OrderListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderListRequest.ProtoReflect.Descriptor instead.
-func (*OrderListRequest) Descriptor() ([]byte, []int) {
-	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GiveOutItemsRequest.ProtoReflect.Descriptor instead.
+func (*GiveOutItemsRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *OrderListRequest) GetClientId() int32 {
+func (x *GiveOutItemsRequest) GetOrderId() int64 {
 	if x != nil {
-		return x.ClientId
+		return x.OrderId
 	}
 	return 0
 }
 
-func (x *OrderListRequest) GetLimit() int32 {
-	if x != nil && x.Limit != nil {
-		return *x.Limit
+func (x *GiveOutItemsRequest) GetSkus() []string {
+	if x != nil {
+		return x.Skus
 	}
-	return 0
+	return nil
 }
 
-func (x *OrderListRequest) GetOffset() int64 {
-	if x != nil {
-		return x.Offset
+type GiveOutItemsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Order *Order `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+}
+
+func (x *GiveOutItemsResponse) Reset() {
+	*x = GiveOutItemsResponse{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GiveOutItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GiveOutItemsResponse) ProtoMessage() {}
+
+func (x *GiveOutItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GiveOutItemsResponse.ProtoReflect.Descriptor instead.
+func (*GiveOutItemsResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GiveOutItemsResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type RefundClientRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId             int64    `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ClientId            int32    `protobuf:"varint,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Reason              string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Comment             string   `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	InspectionCondition string   `protobuf:"bytes,5,opt,name=inspection_condition,json=inspectionCondition,proto3" json:"inspection_condition,omitempty"`
+	InspectionOutcome   string   `protobuf:"bytes,6,opt,name=inspection_outcome,json=inspectionOutcome,proto3" json:"inspection_outcome,omitempty"`
+	Skus                []string `protobuf:"bytes,7,rep,name=skus,proto3" json:"skus,omitempty"`
+}
+
+func (x *RefundClientRequest) Reset() {
+	*x = RefundClientRequest{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundClientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundClientRequest) ProtoMessage() {}
+
+func (x *RefundClientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundClientRequest.ProtoReflect.Descriptor instead.
+func (*RefundClientRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RefundClientRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *RefundClientRequest) GetClientId() int32 {
+	if x != nil {
+		return x.ClientId
+	}
+	return 0
+}
+
+func (x *RefundClientRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RefundClientRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *RefundClientRequest) GetInspectionCondition() string {
+	if x != nil {
+		return x.InspectionCondition
+	}
+	return ""
+}
+
+func (x *RefundClientRequest) GetInspectionOutcome() string {
+	if x != nil {
+		return x.InspectionOutcome
+	}
+	return ""
+}
+
+func (x *RefundClientRequest) GetSkus() []string {
+	if x != nil {
+		return x.Skus
+	}
+	return nil
+}
+
+type RefundClientResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status       string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	RefundAmount int32  `protobuf:"varint,2,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`
+}
+
+func (x *RefundClientResponse) Reset() {
+	*x = RefundClientResponse{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundClientResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundClientResponse) ProtoMessage() {}
+
+func (x *RefundClientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundClientResponse.ProtoReflect.Descriptor instead.
+func (*RefundClientResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RefundClientResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RefundClientResponse) GetRefundAmount() int32 {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return 0
+}
+
+type OrderListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientId int32  `protobuf:"varint,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Limit    *int32 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Offset   int64  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *OrderListRequest) Reset() {
+	*x = OrderListRequest{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderListRequest) ProtoMessage() {}
+
+func (x *OrderListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderListRequest.ProtoReflect.Descriptor instead.
+func (*OrderListRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *OrderListRequest) GetClientId() int32 {
+	if x != nil {
+		return x.ClientId
+	}
+	return 0
+}
+
+func (x *OrderListRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *OrderListRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type OrderListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Orders []*Order `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+}
+
+func (x *OrderListResponse) Reset() {
+	*x = OrderListResponse{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderListResponse) ProtoMessage() {}
+
+func (x *OrderListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderListResponse.ProtoReflect.Descriptor instead.
+func (*OrderListResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *OrderListResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type RefundListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit  *int32 `protobuf:"varint,1,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Offset *int64 `protobuf:"varint,2,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *RefundListRequest) Reset() {
+	*x = RefundListRequest{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundListRequest) ProtoMessage() {}
+
+func (x *RefundListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundListRequest.ProtoReflect.Descriptor instead.
+func (*RefundListRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RefundListRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *RefundListRequest) GetOffset() int64 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+func (x *RefundListRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+type RefundListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Orders []*Order `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+}
+
+func (x *RefundListResponse) Reset() {
+	*x = RefundListResponse{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundListResponse) ProtoMessage() {}
+
+func (x *RefundListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundListResponse.ProtoReflect.Descriptor instead.
+func (*RefundListResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RefundListResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type ReturnRefundsToCourierRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Courier   string  `protobuf:"bytes,1,opt,name=courier,proto3" json:"courier,omitempty"`
+	OrdersIds []int64 `protobuf:"varint,2,rep,packed,name=orders_ids,json=ordersIds,proto3" json:"orders_ids,omitempty"`
+}
+
+func (x *ReturnRefundsToCourierRequest) Reset() {
+	*x = ReturnRefundsToCourierRequest{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnRefundsToCourierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnRefundsToCourierRequest) ProtoMessage() {}
+
+func (x *ReturnRefundsToCourierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnRefundsToCourierRequest.ProtoReflect.Descriptor instead.
+func (*ReturnRefundsToCourierRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReturnRefundsToCourierRequest) GetCourier() string {
+	if x != nil {
+		return x.Courier
+	}
+	return ""
+}
+
+func (x *ReturnRefundsToCourierRequest) GetOrdersIds() []int64 {
+	if x != nil {
+		return x.OrdersIds
+	}
+	return nil
+}
+
+type ReturnRefundsToCourierResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HandoverId   int64                  `protobuf:"varint,1,opt,name=handover_id,json=handoverId,proto3" json:"handover_id,omitempty"`
+	HandedOverAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=handed_over_at,json=handedOverAt,proto3" json:"handed_over_at,omitempty"`
+	OrdersIds    []int64                `protobuf:"varint,3,rep,packed,name=orders_ids,json=ordersIds,proto3" json:"orders_ids,omitempty"`
+}
+
+func (x *ReturnRefundsToCourierResponse) Reset() {
+	*x = ReturnRefundsToCourierResponse{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnRefundsToCourierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnRefundsToCourierResponse) ProtoMessage() {}
+
+func (x *ReturnRefundsToCourierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnRefundsToCourierResponse.ProtoReflect.Descriptor instead.
+func (*ReturnRefundsToCourierResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReturnRefundsToCourierResponse) GetHandoverId() int64 {
+	if x != nil {
+		return x.HandoverId
+	}
+	return 0
+}
+
+func (x *ReturnRefundsToCourierResponse) GetHandedOverAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.HandedOverAt
+	}
+	return nil
+}
+
+func (x *ReturnRefundsToCourierResponse) GetOrdersIds() []int64 {
+	if x != nil {
+		return x.OrdersIds
+	}
+	return nil
+}
+
+type ApproveRefundRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId int64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Reject  bool  `protobuf:"varint,2,opt,name=reject,proto3" json:"reject,omitempty"`
+}
+
+func (x *ApproveRefundRequest) Reset() {
+	*x = ApproveRefundRequest{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveRefundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveRefundRequest) ProtoMessage() {}
+
+func (x *ApproveRefundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveRefundRequest.ProtoReflect.Descriptor instead.
+func (*ApproveRefundRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ApproveRefundRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *ApproveRefundRequest) GetReject() bool {
+	if x != nil {
+		return x.Reject
+	}
+	return false
+}
+
+type ApproveRefundResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ApproveRefundResponse) Reset() {
+	*x = ApproveRefundResponse{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveRefundResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveRefundResponse) ProtoMessage() {}
+
+func (x *ApproveRefundResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveRefundResponse.ProtoReflect.Descriptor instead.
+func (*ApproveRefundResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{22}
+}
+
+type FlaggedClient struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientId         int32    `protobuf:"varint,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Issued           int32    `protobuf:"varint,2,opt,name=issued,proto3" json:"issued,omitempty"`
+	Refunds          int32    `protobuf:"varint,3,opt,name=refunds,proto3" json:"refunds,omitempty"`
+	HighValueRefunds int32    `protobuf:"varint,4,opt,name=high_value_refunds,json=highValueRefunds,proto3" json:"high_value_refunds,omitempty"`
+	RefundRatio      float64  `protobuf:"fixed64,5,opt,name=refund_ratio,json=refundRatio,proto3" json:"refund_ratio,omitempty"`
+	Reasons          []string `protobuf:"bytes,6,rep,name=reasons,proto3" json:"reasons,omitempty"`
+}
+
+func (x *FlaggedClient) Reset() {
+	*x = FlaggedClient{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlaggedClient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlaggedClient) ProtoMessage() {}
+
+func (x *FlaggedClient) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlaggedClient.ProtoReflect.Descriptor instead.
+func (*FlaggedClient) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *FlaggedClient) GetClientId() int32 {
+	if x != nil {
+		return x.ClientId
 	}
 	return 0
 }
 
-type OrderListResponse struct {
+func (x *FlaggedClient) GetIssued() int32 {
+	if x != nil {
+		return x.Issued
+	}
+	return 0
+}
+
+func (x *FlaggedClient) GetRefunds() int32 {
+	if x != nil {
+		return x.Refunds
+	}
+	return 0
+}
+
+func (x *FlaggedClient) GetHighValueRefunds() int32 {
+	if x != nil {
+		return x.HighValueRefunds
+	}
+	return 0
+}
+
+func (x *FlaggedClient) GetRefundRatio() float64 {
+	if x != nil {
+		return x.RefundRatio
+	}
+	return 0
+}
+
+func (x *FlaggedClient) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+type ListFlaggedClientsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	Orders []*Order `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+func (x *ListFlaggedClientsRequest) Reset() {
+	*x = ListFlaggedClientsRequest{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderListResponse) Reset() {
-	*x = OrderListResponse{}
-	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[10]
+func (x *ListFlaggedClientsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFlaggedClientsRequest) ProtoMessage() {}
+
+func (x *ListFlaggedClientsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFlaggedClientsRequest.ProtoReflect.Descriptor instead.
+func (*ListFlaggedClientsRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{24}
+}
+
+type ListFlaggedClientsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Clients []*FlaggedClient `protobuf:"bytes,1,rep,name=clients,proto3" json:"clients,omitempty"`
+}
+
+func (x *ListFlaggedClientsResponse) Reset() {
+	*x = ListFlaggedClientsResponse{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderListResponse) String() string {
+func (x *ListFlaggedClientsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderListResponse) ProtoMessage() {}
+func (*ListFlaggedClientsResponse) ProtoMessage() {}
 
-func (x *OrderListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[10]
+func (x *ListFlaggedClientsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -592,42 +1530,77 @@ func (x *OrderListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderListResponse.ProtoReflect.Descriptor instead.
-func (*OrderListResponse) Descriptor() ([]byte, []int) {
-	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ListFlaggedClientsResponse.ProtoReflect.Descriptor instead.
+func (*ListFlaggedClientsResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *OrderListResponse) GetOrders() []*Order {
+func (x *ListFlaggedClientsResponse) GetClients() []*FlaggedClient {
 	if x != nil {
-		return x.Orders
+		return x.Clients
 	}
 	return nil
 }
 
-type RefundListRequest struct {
+type ArchiveOrdersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	Limit  *int32 `protobuf:"varint,1,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
-	Offset *int64 `protobuf:"varint,2,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+func (x *ArchiveOrdersRequest) Reset() {
+	*x = ArchiveOrdersRequest{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *RefundListRequest) Reset() {
-	*x = RefundListRequest{}
-	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[11]
+func (x *ArchiveOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveOrdersRequest) ProtoMessage() {}
+
+func (x *ArchiveOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{26}
+}
+
+type ArchiveOrdersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Archived int64 `protobuf:"varint,1,opt,name=archived,proto3" json:"archived,omitempty"`
+}
+
+func (x *ArchiveOrdersResponse) Reset() {
+	*x = ArchiveOrdersResponse{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RefundListRequest) String() string {
+func (x *ArchiveOrdersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefundListRequest) ProtoMessage() {}
+func (*ArchiveOrdersResponse) ProtoMessage() {}
 
-func (x *RefundListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[11]
+func (x *ArchiveOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -638,48 +1611,235 @@ func (x *RefundListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefundListRequest.ProtoReflect.Descriptor instead.
-func (*RefundListRequest) Descriptor() ([]byte, []int) {
-	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ArchiveOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *RefundListRequest) GetLimit() int32 {
+func (x *ArchiveOrdersResponse) GetArchived() int64 {
+	if x != nil {
+		return x.Archived
+	}
+	return 0
+}
+
+type AuditLogEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OperatorId string                 `protobuf:"bytes,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	Method     string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	OrderIds   []int64                `protobuf:"varint,4,rep,packed,name=order_ids,json=orderIds,proto3" json:"order_ids,omitempty"`
+	Before     string                 `protobuf:"bytes,5,opt,name=before,proto3" json:"before,omitempty"`
+	After      string                 `protobuf:"bytes,6,opt,name=after,proto3" json:"after,omitempty"`
+	ClientIp   string                 `protobuf:"bytes,7,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AuditLogEntry) Reset() {
+	*x = AuditLogEntry{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogEntry) ProtoMessage() {}
+
+func (x *AuditLogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogEntry.ProtoReflect.Descriptor instead.
+func (*AuditLogEntry) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AuditLogEntry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditLogEntry) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetOrderIds() []int64 {
+	if x != nil {
+		return x.OrderIds
+	}
+	return nil
+}
+
+func (x *AuditLogEntry) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type QueryAuditLogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OperatorId string                 `protobuf:"bytes,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	Method     string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	OrderId    int64                  `protobuf:"varint,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	From       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	Limit      *int32                 `protobuf:"varint,6,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Offset     *int64                 `protobuf:"varint,7,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+}
+
+func (x *QueryAuditLogRequest) Reset() {
+	*x = QueryAuditLogRequest{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryAuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAuditLogRequest) ProtoMessage() {}
+
+func (x *QueryAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*QueryAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *QueryAuditLogRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *QueryAuditLogRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *QueryAuditLogRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *QueryAuditLogRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *QueryAuditLogRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *QueryAuditLogRequest) GetLimit() int32 {
 	if x != nil && x.Limit != nil {
 		return *x.Limit
 	}
 	return 0
 }
 
-func (x *RefundListRequest) GetOffset() int64 {
+func (x *QueryAuditLogRequest) GetOffset() int64 {
 	if x != nil && x.Offset != nil {
 		return *x.Offset
 	}
 	return 0
 }
 
-type RefundListResponse struct {
+type QueryAuditLogResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Orders []*Order `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	Entries []*AuditLogEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
 }
 
-func (x *RefundListResponse) Reset() {
-	*x = RefundListResponse{}
-	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[12]
+func (x *QueryAuditLogResponse) Reset() {
+	*x = QueryAuditLogResponse{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RefundListResponse) String() string {
+func (x *QueryAuditLogResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefundListResponse) ProtoMessage() {}
+func (*QueryAuditLogResponse) ProtoMessage() {}
 
-func (x *RefundListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[12]
+func (x *QueryAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -690,14 +1850,14 @@ func (x *RefundListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefundListResponse.ProtoReflect.Descriptor instead.
-func (*RefundListResponse) Descriptor() ([]byte, []int) {
-	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use QueryAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*QueryAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *RefundListResponse) GetOrders() []*Order {
+func (x *QueryAuditLogResponse) GetEntries() []*AuditLogEntry {
 	if x != nil {
-		return x.Orders
+		return x.Entries
 	}
 	return nil
 }
@@ -720,7 +1880,7 @@ var file_pvz_service_v1_pvz_service_proto_rawDesc = []byte{
 	0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65,
 	0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61,
 	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x8f, 0x02, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x22, 0xa9, 0x04, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c,
 	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63,
 	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x65,
@@ -737,186 +1897,545 @@ var file_pvz_service_v1_pvz_service_proto_rawDesc = []byte{
 	0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
 	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x70, 0x69, 0x63, 0x6b, 0x55, 0x70, 0x54, 0x69,
-	0x6d, 0x65, 0x22, 0x89, 0x02, 0x0a, 0x15, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x43, 0x6f,
-	0x75, 0x72, 0x69, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x08,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a,
-	0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02,
-	0x20, 0x00, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x0b,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x03, 0xe0,
-	0x41, 0x02, 0x52, 0x0a, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x1e,
-	0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41,
-	0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x22,
-	0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a,
-	0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x18, 0x06,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x22, 0x18,
-	0x0a, 0x16, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x0a, 0x14, 0x52, 0x65, 0x74, 0x75,
-	0x72, 0x6e, 0x43, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x25, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x07,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x74, 0x75, 0x72,
-	0x6e, 0x43, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x44, 0x0a, 0x14, 0x47, 0x69, 0x76, 0x65, 0x4f, 0x75, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x42, 0x0d, 0xe0, 0x41,
-	0x02, 0xfa, 0x42, 0x07, 0x92, 0x01, 0x04, 0x08, 0x01, 0x18, 0x01, 0x52, 0x09, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x73, 0x49, 0x64, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x69, 0x76, 0x65, 0x4f, 0x75,
-	0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x65, 0x0a, 0x13, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04,
-	0x22, 0x02, 0x20, 0x00, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x27, 0x0a,
-	0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x08, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa2,
-	0x01, 0x0a, 0x10, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02,
-	0x20, 0x00, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x05,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x13, 0xe0, 0x41, 0x01,
-	0xfa, 0x42, 0x0d, 0x1a, 0x0b, 0x20, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01,
-	0x48, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x06,
-	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x13, 0xe0, 0x41,
-	0x01, 0xfa, 0x42, 0x0d, 0x22, 0x0b, 0x20, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
-	0x01, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x22, 0x37, 0x0a, 0x11, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
+	0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x31,
+	0x0a, 0x14, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x69, 0x6e,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69,
+	0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
+	0x12, 0x24, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x61, 0x69,
+	0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x84, 0x01, 0x0a,
+	0x09, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x6b,
+	0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x6b, 0x75, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a,
+	0x75, 0x6e, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x75, 0x6e, 0x69, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0xa2, 0x01, 0x0a, 0x0c, 0x4e, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x74, 0x65, 0x6d, 0x12, 0x1e, 0x0a, 0x03, 0x73, 0x6b, 0x75, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0c, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52,
+	0x03, 0x73, 0x6b, 0x75, 0x12, 0x1f, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0b, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xff, 0x01, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a,
+	0x02, 0x20, 0x00, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x29, 0x0a,
+	0x0a, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x09, 0x75,
+	0x6e, 0x69, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0xb7, 0x02, 0x0a, 0x15, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x25, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00,
+	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x09, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41,
+	0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x40, 0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x75, 0x6e, 0x74, 0x69,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0a, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55,
+	0x6e, 0x74, 0x69, 0x6c, 0x12, 0x1e, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x04,
+	0x63, 0x6f, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00,
+	0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x4e, 0x65, 0x77, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x03, 0xe0, 0x41, 0x01, 0x52, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x75,
+	0x72, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x0a, 0x14,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x22, 0x02,
+	0x20, 0x00, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x0a, 0x14, 0x47, 0x69, 0x76, 0x65, 0x4f, 0x75, 0x74, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x0a,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03,
+	0x42, 0x0d, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x07, 0x92, 0x01, 0x04, 0x08, 0x01, 0x18, 0x01, 0x52,
+	0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x49, 0x64, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x69,
+	0x76, 0x65, 0x4f, 0x75, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x15, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a,
+	0x0f, 0x6b, 0x65, 0x70, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x42, 0x11, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x0b, 0x92, 0x01,
+	0x08, 0x18, 0x01, 0x22, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x0d, 0x6b, 0x65, 0x70, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x49, 0x64, 0x73, 0x12, 0x3f, 0x0a, 0x12, 0x72, 0x65, 0x66, 0x75,
+	0x73, 0x65, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x03, 0x42, 0x11, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x0b, 0x92, 0x01, 0x08, 0x18,
+	0x01, 0x22, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x10, 0x72, 0x65, 0x66, 0x75, 0x73, 0x65, 0x64,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x49, 0x64, 0x73, 0x22, 0x3c, 0x0a, 0x16, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x6f, 0x75, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22, 0x5f, 0x0a, 0x13, 0x47, 0x69, 0x76, 0x65, 0x4f,
+	0x75, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25,
+	0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x07, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x04, 0x73, 0x6b, 0x75, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x0d, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x07, 0x92, 0x01, 0x04, 0x08, 0x01,
+	0x18, 0x01, 0x52, 0x04, 0x73, 0x6b, 0x75, 0x73, 0x22, 0x38, 0x0a, 0x14, 0x47, 0x69, 0x76, 0x65,
+	0x4f, 0x75, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x20, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x22, 0xbf, 0x03, 0x0a, 0x13, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x08, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xe0, 0x41,
+	0x02, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x27, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00,
+	0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x69, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x51, 0xe0, 0x41, 0x02, 0xfa,
+	0x42, 0x4b, 0x72, 0x49, 0x52, 0x06, 0x64, 0x65, 0x66, 0x65, 0x63, 0x74, 0x52, 0x09, 0x77, 0x72,
+	0x6f, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x4d, 0x69, 0x6e, 0x64, 0x52, 0x0e, 0x6e, 0x6f, 0x74, 0x41, 0x73, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x64, 0x52, 0x10, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x64, 0x49, 0x6e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x52, 0x05, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x05, 0x72, 0x03,
+	0x18, 0xe8, 0x07, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x58, 0x0a, 0x14,
+	0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x25, 0xe0, 0x41, 0x02, 0xfa,
+	0x42, 0x1f, 0x72, 0x1d, 0x52, 0x06, 0x69, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x07, 0x64, 0x61,
+	0x6d, 0x61, 0x67, 0x65, 0x64, 0x52, 0x0a, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x13, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4b, 0x0a, 0x12, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1c, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x16, 0x72, 0x14, 0x52, 0x08, 0x61, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x52, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x52, 0x11, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63,
+	0x6f, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x73, 0x6b, 0x75, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x0b, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x18, 0x01, 0x52, 0x04,
+	0x73, 0x6b, 0x75, 0x73, 0x22, 0x53, 0x0a, 0x14, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa2, 0x01, 0x0a, 0x10, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27,
+	0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x08, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x13, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x0d, 0x1a, 0x0b,
+	0x20, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x48, 0x00, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x13, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x0d, 0x22,
+	0x0b, 0x20, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x52, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x37,
+	0x0a, 0x11, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22, 0xc8, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x13, 0xe0, 0x41,
+	0x01, 0xfa, 0x42, 0x0d, 0x1a, 0x0b, 0x20, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0x01, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x13, 0xe0,
+	0x41, 0x01, 0xfa, 0x42, 0x0d, 0x22, 0x0b, 0x20, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0x01, 0x48, 0x01, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x3c, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x24, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x1e, 0x72, 0x1c, 0x52, 0x00, 0x52, 0x0e, 0x61, 0x77, 0x61,
+	0x69, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x65, 0x64, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x22, 0x38, 0x0a, 0x12, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65,
 	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22, 0x8a, 0x01, 0x0a,
-	0x11, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x42, 0x13, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x0d, 0x1a, 0x0b, 0x20, 0xff, 0xff, 0xff, 0xff,
-	0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88,
-	0x01, 0x01, 0x12, 0x30, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x42, 0x13, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x0d, 0x22, 0x0b, 0x20, 0xff, 0xff, 0xff,
-	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x48, 0x01, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x09,
-	0x0a, 0x07, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x38, 0x0a, 0x12, 0x52, 0x65, 0x66,
-	0x75, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x22, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0a, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x73, 0x32, 0xac, 0x0c, 0x0a, 0x0a, 0x50, 0x56, 0x5a, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0xa4, 0x03, 0x0a, 0x0e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x43, 0x6f,
-	0x75, 0x72, 0x69, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x52, 0x65, 0x63, 0x65,
-	0x69, 0x76, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x43,
-	0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd8,
-	0x02, 0x92, 0x41, 0xba, 0x02, 0x12, 0x55, 0xd0, 0x9f, 0xd0, 0xbe, 0xd0, 0xbb, 0xd1, 0x83, 0xd1,
-	0x87, 0xd0, 0xb5, 0xd0, 0xbd, 0xd0, 0xb8, 0xd0, 0xb5, 0x2f, 0xd0, 0xb4, 0xd0, 0xbe, 0xd0, 0xb1,
-	0xd0, 0xb0, 0xd0, 0xb2, 0xd0, 0xbb, 0xd0, 0xb5, 0xd0, 0xbd, 0xd0, 0xb8, 0xd0, 0xb5, 0x20, 0xd0,
-	0xbd, 0xd0, 0xbe, 0xd0, 0xb2, 0xd0, 0xbe, 0xd0, 0xb3, 0xd0, 0xbe, 0x20, 0xd0, 0xb7, 0xd0, 0xb0,
-	0xd0, 0xba, 0xd0, 0xb0, 0xd0, 0xb7, 0xd0, 0xb0, 0x20, 0xd0, 0xbe, 0xd1, 0x82, 0x20, 0xd0, 0xba,
-	0xd1, 0x83, 0xd1, 0x80, 0xd1, 0x8c, 0xd0, 0xb5, 0xd1, 0x80, 0xd0, 0xb0, 0x1a, 0xe0, 0x01, 0xd0,
-	0x9f, 0xd0, 0xbe, 0xd0, 0xbb, 0xd1, 0x83, 0xd1, 0x87, 0xd0, 0xb0, 0xd0, 0xb5, 0xd1, 0x82, 0x20,
-	0xd0, 0xb8, 0xd0, 0xb4, 0xd0, 0xb5, 0xd0, 0xbd, 0xd1, 0x82, 0xd0, 0xb8, 0xd1, 0x84, 0xd0, 0xb8,
-	0xd0, 0xba, 0xd0, 0xb0, 0xd1, 0x82, 0xd0, 0xbe, 0xd1, 0x80, 0x20, 0xd0, 0xb7, 0xd0, 0xb0, 0xd0,
-	0xba, 0xd0, 0xb0, 0xd0, 0xb7, 0xd0, 0xb0, 0x2c, 0x20, 0xd0, 0xb8, 0xd0, 0xb4, 0xd0, 0xb5, 0xd0,
-	0xbd, 0xd1, 0x82, 0xd0, 0xb8, 0xd1, 0x84, 0xd0, 0xb8, 0xd0, 0xba, 0xd0, 0xb0, 0xd1, 0x82, 0xd0,
-	0xbe, 0xd1, 0x80, 0x20, 0xd0, 0xba, 0xd0, 0xbb, 0xd0, 0xb8, 0xd0, 0xb5, 0xd0, 0xbd, 0xd1, 0x82,
-	0xd0, 0xb0, 0x2c, 0x20, 0xd0, 0xb8, 0xd0, 0xb4, 0xd0, 0xb5, 0xd0, 0xbd, 0xd1, 0x82, 0xd0, 0xb8,
-	0xd1, 0x84, 0xd0, 0xb8, 0xd0, 0xba, 0xd0, 0xb0, 0xd1, 0x82, 0xd0, 0xbe, 0xd1, 0x80, 0x20, 0xd0,
-	0xb7, 0xd0, 0xb0, 0xd0, 0xba, 0xd0, 0xb0, 0xd0, 0xb7, 0xd0, 0xb0, 0x2c, 0x20, 0xd0, 0xb2, 0xd1,
-	0x80, 0xd0, 0xb5, 0xd0, 0xbc, 0xd1, 0x8f, 0x20, 0xd1, 0x85, 0xd1, 0x80, 0xd0, 0xb0, 0xd0, 0xbd,
-	0xd0, 0xb5, 0xd0, 0xbd, 0xd0, 0xb8, 0xd1, 0x8f, 0x2c, 0x20, 0xd0, 0xb2, 0xd0, 0xb5, 0xd1, 0x81,
-	0x2c, 0x20, 0xd1, 0x81, 0xd1, 0x82, 0xd0, 0xbe, 0xd0, 0xb8, 0xd0, 0xbc, 0xd0, 0xbe, 0xd1, 0x81,
-	0xd1, 0x82, 0xd1, 0x8c, 0x2c, 0x20, 0xd1, 0x82, 0xd0, 0xb8, 0xd0, 0xbf, 0xd1, 0x8b, 0x20, 0xd1,
-	0x83, 0xd0, 0xbf, 0xd0, 0xb0, 0xd0, 0xba, 0xd0, 0xbe, 0xd0, 0xb2, 0xd0, 0xba, 0xd0, 0xb8, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x52, 0x65, 0x63, 0x65, 0x69,
-	0x76, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x12, 0xcd, 0x01, 0x0a, 0x0d, 0x52, 0x65,
-	0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x70, 0x76,
-	0x7a, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x52, 0x65, 0x74,
-	0x75, 0x72, 0x6e, 0x43, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x84, 0x01, 0x92, 0x41, 0x68, 0x12, 0x2a, 0xd0, 0x92, 0xd0, 0xbe, 0xd0, 0xb7,
-	0xd0, 0xb2, 0xd1, 0x80, 0xd0, 0xb0, 0xd1, 0x82, 0x20, 0xd0, 0xb7, 0xd0, 0xb0, 0xd0, 0xba, 0xd0,
-	0xb0, 0xd0, 0xb7, 0xd0, 0xb0, 0x20, 0xd0, 0xba, 0xd1, 0x83, 0xd1, 0x80, 0xd1, 0x8c, 0xd0, 0xb5,
-	0xd1, 0x80, 0xd1, 0x83, 0x1a, 0x3a, 0xd0, 0x9f, 0xd1, 0x80, 0xd0, 0xb8, 0xd0, 0xbd, 0xd0, 0xb8,
-	0xd0, 0xbc, 0xd0, 0xb0, 0xd0, 0xb5, 0xd1, 0x82, 0x20, 0xd0, 0xb8, 0xd0, 0xb4, 0xd0, 0xb5, 0xd0,
-	0xbd, 0xd1, 0x82, 0xd0, 0xb8, 0xd1, 0x84, 0xd0, 0xb8, 0xd0, 0xba, 0xd0, 0xb0, 0xd1, 0x82, 0xd0,
-	0xbe, 0xd1, 0x80, 0x20, 0xd0, 0xb7, 0xd0, 0xb0, 0xd0, 0xba, 0xd0, 0xb0, 0xd0, 0xb7, 0xd0, 0xb0,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x52, 0x65, 0x74, 0x75,
-	0x72, 0x6e, 0x43, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x12, 0xcf, 0x01, 0x0a, 0x0d, 0x47, 0x69,
-	0x76, 0x65, 0x4f, 0x75, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x76,
-	0x7a, 0x2e, 0x47, 0x69, 0x76, 0x65, 0x4f, 0x75, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x47, 0x69, 0x76,
-	0x65, 0x4f, 0x75, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x86, 0x01, 0x92, 0x41, 0x6a, 0x12, 0x28, 0xd0, 0x92, 0xd1, 0x8b, 0xd0, 0xb4,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22, 0x75, 0x0a, 0x1d,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x54, 0x6f, 0x43,
+	0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
+	0x07, 0x63, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
+	0xe0, 0x41, 0x02, 0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x64, 0x52, 0x07, 0x63, 0x6f,
+	0x75, 0x72, 0x69, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x42, 0x0d, 0xe0, 0x41, 0x02, 0xfa, 0x42,
+	0x07, 0x92, 0x01, 0x04, 0x08, 0x01, 0x18, 0x01, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x49, 0x64, 0x73, 0x22, 0xa2, 0x01, 0x0a, 0x1e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x73, 0x54, 0x6f, 0x43, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x61, 0x6e, 0x64, 0x6f, 0x76,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x68, 0x61, 0x6e,
+	0x64, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x0e, 0x68, 0x61, 0x6e, 0x64, 0x65,
+	0x64, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x68, 0x61, 0x6e,
+	0x64, 0x65, 0x64, 0x4f, 0x76, 0x65, 0x72, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x09, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x49, 0x64, 0x73, 0x22, 0x5a, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x25, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x07,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x06, 0x72, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x03, 0xe0, 0x41, 0x01, 0x52, 0x06, 0x72, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc9, 0x01,
+	0x0a, 0x0d, 0x46, 0x6c, 0x61, 0x67, 0x67, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x73,
+	0x73, 0x75, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x2c,
+	0x0a, 0x12, 0x68, 0x69, 0x67, 0x68, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x72, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x68, 0x69, 0x67, 0x68,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0b, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x22, 0x1b, 0x0a, 0x19, 0x4c, 0x69, 0x73,
+	0x74, 0x46, 0x6c, 0x61, 0x67, 0x67, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4a, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6c,
+	0x61, 0x67, 0x67, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x46, 0x6c, 0x61, 0x67,
+	0x67, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x33, 0x0a, 0x15, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x22,
+	0xfb, 0x01, 0x0a, 0x0d, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x03, 0x52, 0x08, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x70, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xf6, 0x02,
+	0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xe0, 0x41, 0x01,
+	0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xff, 0x01, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xff,
+	0x01, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x2e, 0x0a, 0x08, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x13, 0xe0, 0x41, 0x01,
+	0xfa, 0x42, 0x0d, 0x22, 0x0b, 0x20, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01,
+	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x01, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2f,
+	0x0a, 0x02, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x01, 0x52, 0x02, 0x74, 0x6f, 0x12,
+	0x2e, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x13,
+	0xe0, 0x41, 0x01, 0xfa, 0x42, 0x0d, 0x1a, 0x0b, 0x20, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0x01, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x30, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x13, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x0d, 0x22, 0x0b, 0x20, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0x01, 0x48, 0x01, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x88, 0x01,
+	0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x45, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2c, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x32, 0x9e, 0x22,
+	0x0a, 0x0a, 0x50, 0x56, 0x5a, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xa4, 0x03, 0x0a,
+	0x0e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x12,
+	0x1a, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x75,
+	0x72, 0x69, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x76,
+	0x7a, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd8, 0x02, 0x92, 0x41, 0xba, 0x02, 0x12,
+	0x55, 0xd0, 0x9f, 0xd0, 0xbe, 0xd0, 0xbb, 0xd1, 0x83, 0xd1, 0x87, 0xd0, 0xb5, 0xd0, 0xbd, 0xd0,
+	0xb8, 0xd0, 0xb5, 0x2f, 0xd0, 0xb4, 0xd0, 0xbe, 0xd0, 0xb1, 0xd0, 0xb0, 0xd0, 0xb2, 0xd0, 0xbb,
+	0xd0, 0xb5, 0xd0, 0xbd, 0xd0, 0xb8, 0xd0, 0xb5, 0x20, 0xd0, 0xbd, 0xd0, 0xbe, 0xd0, 0xb2, 0xd0,
+	0xbe, 0xd0, 0xb3, 0xd0, 0xbe, 0x20, 0xd0, 0xb7, 0xd0, 0xb0, 0xd0, 0xba, 0xd0, 0xb0, 0xd0, 0xb7,
+	0xd0, 0xb0, 0x20, 0xd0, 0xbe, 0xd1, 0x82, 0x20, 0xd0, 0xba, 0xd1, 0x83, 0xd1, 0x80, 0xd1, 0x8c,
+	0xd0, 0xb5, 0xd1, 0x80, 0xd0, 0xb0, 0x1a, 0xe0, 0x01, 0xd0, 0x9f, 0xd0, 0xbe, 0xd0, 0xbb, 0xd1,
+	0x83, 0xd1, 0x87, 0xd0, 0xb0, 0xd0, 0xb5, 0xd1, 0x82, 0x20, 0xd0, 0xb8, 0xd0, 0xb4, 0xd0, 0xb5,
+	0xd0, 0xbd, 0xd1, 0x82, 0xd0, 0xb8, 0xd1, 0x84, 0xd0, 0xb8, 0xd0, 0xba, 0xd0, 0xb0, 0xd1, 0x82,
+	0xd0, 0xbe, 0xd1, 0x80, 0x20, 0xd0, 0xb7, 0xd0, 0xb0, 0xd0, 0xba, 0xd0, 0xb0, 0xd0, 0xb7, 0xd0,
+	0xb0, 0x2c, 0x20, 0xd0, 0xb8, 0xd0, 0xb4, 0xd0, 0xb5, 0xd0, 0xbd, 0xd1, 0x82, 0xd0, 0xb8, 0xd1,
+	0x84, 0xd0, 0xb8, 0xd0, 0xba, 0xd0, 0xb0, 0xd1, 0x82, 0xd0, 0xbe, 0xd1, 0x80, 0x20, 0xd0, 0xba,
+	0xd0, 0xbb, 0xd0, 0xb8, 0xd0, 0xb5, 0xd0, 0xbd, 0xd1, 0x82, 0xd0, 0xb0, 0x2c, 0x20, 0xd0, 0xb8,
+	0xd0, 0xb4, 0xd0, 0xb5, 0xd0, 0xbd, 0xd1, 0x82, 0xd0, 0xb8, 0xd1, 0x84, 0xd0, 0xb8, 0xd0, 0xba,
+	0xd0, 0xb0, 0xd1, 0x82, 0xd0, 0xbe, 0xd1, 0x80, 0x20, 0xd0, 0xb7, 0xd0, 0xb0, 0xd0, 0xba, 0xd0,
+	0xb0, 0xd0, 0xb7, 0xd0, 0xb0, 0x2c, 0x20, 0xd0, 0xb2, 0xd1, 0x80, 0xd0, 0xb5, 0xd0, 0xbc, 0xd1,
+	0x8f, 0x20, 0xd1, 0x85, 0xd1, 0x80, 0xd0, 0xb0, 0xd0, 0xbd, 0xd0, 0xb5, 0xd0, 0xbd, 0xd0, 0xb8,
+	0xd1, 0x8f, 0x2c, 0x20, 0xd0, 0xb2, 0xd0, 0xb5, 0xd1, 0x81, 0x2c, 0x20, 0xd1, 0x81, 0xd1, 0x82,
+	0xd0, 0xbe, 0xd0, 0xb8, 0xd0, 0xbc, 0xd0, 0xbe, 0xd1, 0x81, 0xd1, 0x82, 0xd1, 0x8c, 0x2c, 0x20,
+	0xd1, 0x82, 0xd0, 0xb8, 0xd0, 0xbf, 0xd1, 0x8b, 0x20, 0xd1, 0x83, 0xd0, 0xbf, 0xd0, 0xb0, 0xd0,
+	0xba, 0xd0, 0xbe, 0xd0, 0xb2, 0xd0, 0xba, 0xd0, 0xb8, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a,
+	0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x75, 0x72,
+	0x69, 0x65, 0x72, 0x12, 0xcd, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f,
+	0x75, 0x72, 0x69, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x52, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x43, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x75,
+	0x72, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x84, 0x01, 0x92,
+	0x41, 0x68, 0x12, 0x2a, 0xd0, 0x92, 0xd0, 0xbe, 0xd0, 0xb7, 0xd0, 0xb2, 0xd1, 0x80, 0xd0, 0xb0,
+	0xd1, 0x82, 0x20, 0xd0, 0xb7, 0xd0, 0xb0, 0xd0, 0xba, 0xd0, 0xb0, 0xd0, 0xb7, 0xd0, 0xb0, 0x20,
+	0xd0, 0xba, 0xd1, 0x83, 0xd1, 0x80, 0xd1, 0x8c, 0xd0, 0xb5, 0xd1, 0x80, 0xd1, 0x83, 0x1a, 0x3a,
+	0xd0, 0x9f, 0xd1, 0x80, 0xd0, 0xb8, 0xd0, 0xbd, 0xd0, 0xb8, 0xd0, 0xbc, 0xd0, 0xb0, 0xd0, 0xb5,
+	0xd1, 0x82, 0x20, 0xd0, 0xb8, 0xd0, 0xb4, 0xd0, 0xb5, 0xd0, 0xbd, 0xd1, 0x82, 0xd0, 0xb8, 0xd1,
+	0x84, 0xd0, 0xb8, 0xd0, 0xba, 0xd0, 0xb0, 0xd1, 0x82, 0xd0, 0xbe, 0xd1, 0x80, 0x20, 0xd0, 0xb7,
+	0xd0, 0xb0, 0xd0, 0xba, 0xd0, 0xb0, 0xd0, 0xb7, 0xd0, 0xb0, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13,
+	0x3a, 0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x75, 0x72,
+	0x69, 0x65, 0x72, 0x12, 0xcf, 0x01, 0x0a, 0x0d, 0x47, 0x69, 0x76, 0x65, 0x4f, 0x75, 0x74, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x47, 0x69, 0x76, 0x65,
+	0x4f, 0x75, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x47, 0x69, 0x76, 0x65, 0x4f, 0x75, 0x74, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x86, 0x01, 0x92,
+	0x41, 0x6a, 0x12, 0x28, 0xd0, 0x92, 0xd1, 0x8b, 0xd0, 0xb4, 0xd0, 0xb0, 0xd1, 0x87, 0xd0, 0xb0,
+	0x20, 0xd0, 0xb7, 0xd0, 0xb0, 0xd0, 0xba, 0xd0, 0xb0, 0xd0, 0xb7, 0xd0, 0xb0, 0x20, 0xd0, 0xba,
+	0xd0, 0xbb, 0xd0, 0xb8, 0xd0, 0xb5, 0xd0, 0xbd, 0xd1, 0x82, 0xd1, 0x83, 0x1a, 0x3e, 0xd0, 0x9f,
+	0xd1, 0x80, 0xd0, 0xb8, 0xd0, 0xbd, 0xd0, 0xb8, 0xd0, 0xbc, 0xd0, 0xb0, 0xd0, 0xb5, 0xd1, 0x82,
+	0x20, 0xd0, 0xb8, 0xd0, 0xb4, 0xd0, 0xb5, 0xd0, 0xbd, 0xd1, 0x82, 0xd0, 0xb8, 0xd1, 0x84, 0xd0,
+	0xb8, 0xd0, 0xba, 0xd0, 0xb0, 0xd1, 0x82, 0xd0, 0xbe, 0xd1, 0x80, 0xd1, 0x8b, 0x20, 0xd0, 0xb7,
+	0xd0, 0xb0, 0xd0, 0xba, 0xd0, 0xb0, 0xd0, 0xb7, 0xd0, 0xbe, 0xd0, 0xb2, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x47, 0x69, 0x76, 0x65, 0x4f, 0x75, 0x74, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x96, 0x03, 0x0a, 0x0e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f,
+	0x75, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x6f, 0x75, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0xca, 0x02, 0x92, 0x41, 0xac, 0x02, 0x12, 0x5b, 0xd0, 0x92, 0xd1, 0x8b, 0xd0, 0xb4,
 	0xd0, 0xb0, 0xd1, 0x87, 0xd0, 0xb0, 0x20, 0xd0, 0xb7, 0xd0, 0xb0, 0xd0, 0xba, 0xd0, 0xb0, 0xd0,
-	0xb7, 0xd0, 0xb0, 0x20, 0xd0, 0xba, 0xd0, 0xbb, 0xd0, 0xb8, 0xd0, 0xb5, 0xd0, 0xbd, 0xd1, 0x82,
-	0xd1, 0x83, 0x1a, 0x3e, 0xd0, 0x9f, 0xd1, 0x80, 0xd0, 0xb8, 0xd0, 0xbd, 0xd0, 0xb8, 0xd0, 0xbc,
-	0xd0, 0xb0, 0xd0, 0xb5, 0xd1, 0x82, 0x20, 0xd0, 0xb8, 0xd0, 0xb4, 0xd0, 0xb5, 0xd0, 0xbd, 0xd1,
+	0xb7, 0xd0, 0xbe, 0xd0, 0xb2, 0x20, 0xd0, 0xba, 0xd0, 0xbb, 0xd0, 0xb8, 0xd0, 0xb5, 0xd0, 0xbd,
+	0xd1, 0x82, 0xd1, 0x83, 0x20, 0xd1, 0x81, 0x20, 0xd0, 0xbe, 0xd1, 0x82, 0xd0, 0xba, 0xd0, 0xb0,
+	0xd0, 0xb7, 0xd0, 0xbe, 0xd0, 0xbc, 0x20, 0xd0, 0xbe, 0xd1, 0x82, 0x20, 0xd1, 0x87, 0xd0, 0xb0,
+	0xd1, 0x81, 0xd1, 0x82, 0xd0, 0xb8, 0x20, 0xd0, 0xb7, 0xd0, 0xb0, 0xd0, 0xba, 0xd0, 0xb0, 0xd0,
+	0xb7, 0xd0, 0xbe, 0xd0, 0xb2, 0x1a, 0xcc, 0x01, 0xd0, 0x9f, 0xd1, 0x80, 0xd0, 0xb8, 0xd0, 0xbd,
+	0xd0, 0xb8, 0xd0, 0xbc, 0xd0, 0xb0, 0xd0, 0xb5, 0xd1, 0x82, 0x20, 0xd0, 0xb8, 0xd0, 0xb4, 0xd0,
+	0xb5, 0xd0, 0xbd, 0xd1, 0x82, 0xd0, 0xb8, 0xd1, 0x84, 0xd0, 0xb8, 0xd0, 0xba, 0xd0, 0xb0, 0xd1,
+	0x82, 0xd0, 0xbe, 0xd1, 0x80, 0xd1, 0x8b, 0x20, 0xd0, 0xb7, 0xd0, 0xb0, 0xd0, 0xb1, 0xd0, 0xb8,
+	0xd1, 0x80, 0xd0, 0xb0, 0xd0, 0xb5, 0xd0, 0xbc, 0xd1, 0x8b, 0xd1, 0x85, 0x20, 0xd0, 0xb8, 0x20,
+	0xd0, 0xbe, 0xd1, 0x82, 0xd0, 0xba, 0xd0, 0xb0, 0xd0, 0xb7, 0xd0, 0xbd, 0xd1, 0x8b, 0xd1, 0x85,
+	0x20, 0xd0, 0xb7, 0xd0, 0xb0, 0xd0, 0xba, 0xd0, 0xb0, 0xd0, 0xb7, 0xd0, 0xbe, 0xd0, 0xb2, 0x2c,
+	0x20, 0xd0, 0xbe, 0xd1, 0x82, 0xd0, 0xba, 0xd0, 0xb0, 0xd0, 0xb7, 0xd0, 0xbd, 0xd1, 0x8b, 0xd0,
+	0xb5, 0x20, 0xd0, 0xb7, 0xd0, 0xb0, 0xd0, 0xba, 0xd0, 0xb0, 0xd0, 0xb7, 0xd1, 0x8b, 0x20, 0xd1,
+	0x81, 0xd1, 0x82, 0xd0, 0xb0, 0xd0, 0xb2, 0xd1, 0x8f, 0xd1, 0x82, 0xd1, 0x81, 0xd1, 0x8f, 0x20,
+	0xd0, 0xb2, 0x20, 0xd0, 0xbe, 0xd1, 0x87, 0xd0, 0xb5, 0xd1, 0x80, 0xd0, 0xb5, 0xd0, 0xb4, 0xd1,
+	0x8c, 0x20, 0xd0, 0xbd, 0xd0, 0xb0, 0x20, 0xd0, 0xb2, 0xd0, 0xbe, 0xd0, 0xb7, 0xd0, 0xb2, 0xd1,
+	0x80, 0xd0, 0xb0, 0xd1, 0x82, 0x20, 0xd0, 0xba, 0xd1, 0x83, 0xd1, 0x80, 0xd1, 0x8c, 0xd0, 0xb5,
+	0xd1, 0x80, 0xd1, 0x83, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f, 0x2f,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0xf7,
+	0x02, 0x0a, 0x0c, 0x47, 0x69, 0x76, 0x65, 0x4f, 0x75, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12,
+	0x18, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x47, 0x69, 0x76, 0x65, 0x4f, 0x75, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x76, 0x7a, 0x2e,
+	0x47, 0x69, 0x76, 0x65, 0x4f, 0x75, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb1, 0x02, 0x92, 0x41, 0x95, 0x02, 0x12, 0x4a, 0xd0, 0xa7, 0xd0,
+	0xb0, 0xd1, 0x81, 0xd1, 0x82, 0xd0, 0xb8, 0xd1, 0x87, 0xd0, 0xbd, 0xd0, 0xb0, 0xd1, 0x8f, 0x20,
+	0xd0, 0xb2, 0xd1, 0x8b, 0xd0, 0xb4, 0xd0, 0xb0, 0xd1, 0x87, 0xd0, 0xb0, 0x20, 0xd1, 0x82, 0xd0,
+	0xbe, 0xd0, 0xb2, 0xd0, 0xb0, 0xd1, 0x80, 0xd0, 0xbe, 0xd0, 0xb2, 0x20, 0xd0, 0xb7, 0xd0, 0xb0,
+	0xd0, 0xba, 0xd0, 0xb0, 0xd0, 0xb7, 0xd0, 0xb0, 0x20, 0xd0, 0xba, 0xd0, 0xbb, 0xd0, 0xb8, 0xd0,
+	0xb5, 0xd0, 0xbd, 0xd1, 0x82, 0xd1, 0x83, 0x1a, 0xc6, 0x01, 0xd0, 0x9f, 0xd1, 0x80, 0xd0, 0xb8,
+	0xd0, 0xbd, 0xd0, 0xb8, 0xd0, 0xbc, 0xd0, 0xb0, 0xd0, 0xb5, 0xd1, 0x82, 0x20, 0xd0, 0xb8, 0xd0,
+	0xb4, 0xd0, 0xb5, 0xd0, 0xbd, 0xd1, 0x82, 0xd0, 0xb8, 0xd1, 0x84, 0xd0, 0xb8, 0xd0, 0xba, 0xd0,
+	0xb0, 0xd1, 0x82, 0xd0, 0xbe, 0xd1, 0x80, 0x20, 0xd0, 0xb7, 0xd0, 0xb0, 0xd0, 0xba, 0xd0, 0xb0,
+	0xd0, 0xb7, 0xd0, 0xb0, 0x20, 0xd0, 0xb8, 0x20, 0xd0, 0xb0, 0xd1, 0x80, 0xd1, 0x82, 0xd0, 0xb8,
+	0xd0, 0xba, 0xd1, 0x83, 0xd0, 0xbb, 0xd1, 0x8b, 0x20, 0xd0, 0xb2, 0xd1, 0x8b, 0xd0, 0xb4, 0xd0,
+	0xb0, 0xd0, 0xb2, 0xd0, 0xb0, 0xd0, 0xb5, 0xd0, 0xbc, 0xd1, 0x8b, 0xd1, 0x85, 0x20, 0xd1, 0x82,
+	0xd0, 0xbe, 0xd0, 0xb2, 0xd0, 0xb0, 0xd1, 0x80, 0xd0, 0xbe, 0xd0, 0xb2, 0x2c, 0x20, 0xd0, 0xbe,
+	0xd1, 0x81, 0xd1, 0x82, 0xd0, 0xb0, 0xd0, 0xbb, 0xd1, 0x8c, 0xd0, 0xbd, 0xd1, 0x8b, 0xd0, 0xb5,
+	0x20, 0xd1, 0x82, 0xd0, 0xbe, 0xd0, 0xb2, 0xd0, 0xb0, 0xd1, 0x80, 0xd1, 0x8b, 0x20, 0xd0, 0xb7,
+	0xd0, 0xb0, 0xd0, 0xba, 0xd0, 0xb0, 0xd0, 0xb7, 0xd0, 0xb0, 0x20, 0xd1, 0x81, 0xd1, 0x87, 0xd0,
+	0xb8, 0xd1, 0x82, 0xd0, 0xb0, 0xd1, 0x8e, 0xd1, 0x82, 0xd1, 0x81, 0xd1, 0x8f, 0x20, 0xd0, 0xbe,
+	0xd1, 0x82, 0xd0, 0xba, 0xd0, 0xb0, 0xd0, 0xb7, 0xd0, 0xbd, 0xd1, 0x8b, 0xd0, 0xbc, 0xd0, 0xb8,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a, 0x22, 0x0d, 0x2f, 0x47, 0x69, 0x76, 0x65,
+	0x4f, 0x75, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0xb0, 0x03, 0x0a, 0x0c, 0x52, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x76, 0x7a, 0x2e,
+	0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xea,
+	0x02, 0x92, 0x41, 0xce, 0x02, 0x12, 0x35, 0xd0, 0x9f, 0xd1, 0x80, 0xd0, 0xb8, 0xd0, 0xbd, 0xd1,
+	0x8f, 0xd1, 0x82, 0xd0, 0xb8, 0xd0, 0xb5, 0x20, 0xd0, 0xb2, 0xd0, 0xbe, 0xd0, 0xb7, 0xd0, 0xb2,
+	0xd1, 0x80, 0xd0, 0xb0, 0xd1, 0x82, 0xd0, 0xb0, 0x20, 0xd0, 0xbe, 0xd1, 0x82, 0x20, 0xd0, 0xba,
+	0xd0, 0xbb, 0xd0, 0xb8, 0xd0, 0xb5, 0xd0, 0xbd, 0xd1, 0x82, 0xd0, 0xb0, 0x1a, 0x94, 0x02, 0xd0,
+	0x9f, 0xd1, 0x80, 0xd0, 0xb8, 0xd0, 0xbd, 0xd0, 0xb8, 0xd0, 0xbc, 0xd0, 0xb0, 0xd0, 0xb5, 0xd1,
+	0x82, 0x20, 0xd0, 0xb8, 0xd0, 0xb4, 0xd0, 0xb5, 0xd0, 0xbd, 0xd1, 0x82, 0xd0, 0xb8, 0xd1, 0x84,
+	0xd0, 0xb8, 0xd0, 0xba, 0xd0, 0xb0, 0xd1, 0x82, 0xd0, 0xbe, 0xd1, 0x80, 0x20, 0xd0, 0xbf, 0xd0,
+	0xbe, 0xd0, 0xbb, 0xd1, 0x8c, 0xd0, 0xb7, 0xd0, 0xbe, 0xd0, 0xb2, 0xd0, 0xb0, 0xd1, 0x82, 0xd0,
+	0xb5, 0xd0, 0xbb, 0xd1, 0x8f, 0x2c, 0x20, 0xd0, 0xb8, 0xd0, 0xb4, 0xd0, 0xb5, 0xd0, 0xbd, 0xd1,
 	0x82, 0xd0, 0xb8, 0xd1, 0x84, 0xd0, 0xb8, 0xd0, 0xba, 0xd0, 0xb0, 0xd1, 0x82, 0xd0, 0xbe, 0xd1,
-	0x80, 0xd1, 0x8b, 0x20, 0xd0, 0xb7, 0xd0, 0xb0, 0xd0, 0xba, 0xd0, 0xb0, 0xd0, 0xb7, 0xd0, 0xbe,
-	0xd0, 0xb2, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x47, 0x69,
-	0x76, 0x65, 0x4f, 0x75, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x8a, 0x02, 0x0a, 0x0c,
-	0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x70,
-	0x76, 0x7a, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x52, 0x65, 0x66,
-	0x75, 0x6e, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0xc4, 0x01, 0x92, 0x41, 0xa8, 0x01, 0x12, 0x35, 0xd0, 0x9f, 0xd1, 0x80, 0xd0, 0xb8,
-	0xd0, 0xbd, 0xd1, 0x8f, 0xd1, 0x82, 0xd0, 0xb8, 0xd0, 0xb5, 0x20, 0xd0, 0xb2, 0xd0, 0xbe, 0xd0,
-	0xb7, 0xd0, 0xb2, 0xd1, 0x80, 0xd0, 0xb0, 0xd1, 0x82, 0xd0, 0xb0, 0x20, 0xd0, 0xbe, 0xd1, 0x82,
-	0x20, 0xd0, 0xba, 0xd0, 0xbb, 0xd0, 0xb8, 0xd0, 0xb5, 0xd0, 0xbd, 0xd1, 0x82, 0xd0, 0xb0, 0x1a,
-	0x6f, 0xd0, 0x9f, 0xd1, 0x80, 0xd0, 0xb8, 0xd0, 0xbd, 0xd0, 0xb8, 0xd0, 0xbc, 0xd0, 0xb0, 0xd0,
-	0xb5, 0xd1, 0x82, 0x20, 0xd0, 0xb8, 0xd0, 0xb4, 0xd0, 0xb5, 0xd0, 0xbd, 0xd1, 0x82, 0xd0, 0xb8,
-	0xd1, 0x84, 0xd0, 0xb8, 0xd0, 0xba, 0xd0, 0xb0, 0xd1, 0x82, 0xd0, 0xbe, 0xd1, 0x80, 0x20, 0xd0,
-	0xbf, 0xd0, 0xbe, 0xd0, 0xbb, 0xd1, 0x8c, 0xd0, 0xb7, 0xd0, 0xbe, 0xd0, 0xb2, 0xd0, 0xb0, 0xd1,
-	0x82, 0xd0, 0xb5, 0xd0, 0xbb, 0xd1, 0x8f, 0x2c, 0x20, 0xd0, 0xb8, 0xd0, 0xb4, 0xd0, 0xb5, 0xd0,
-	0xbd, 0xd1, 0x82, 0xd0, 0xb8, 0xd1, 0x84, 0xd0, 0xb8, 0xd0, 0xba, 0xd0, 0xb0, 0xd1, 0x82, 0xd0,
-	0xbe, 0xd1, 0x80, 0x20, 0xd0, 0xb7, 0xd0, 0xb0, 0xd0, 0xba, 0xd0, 0xb0, 0xd0, 0xb7, 0xd0, 0xb0,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a, 0x22, 0x0d, 0x2f, 0x52, 0x65, 0x66, 0x75,
-	0x6e, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0xea, 0x01, 0x0a, 0x09, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x70, 0x76, 0x7a, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xad, 0x01, 0x92, 0x41, 0x97, 0x01, 0x12, 0x4d, 0xd0, 0xa1,
-	0xd0, 0xbf, 0xd0, 0xb8, 0xd1, 0x81, 0xd0, 0xbe, 0xd0, 0xba, 0x20, 0xd0, 0xb7, 0xd0, 0xb0, 0xd0,
-	0xba, 0xd0, 0xb0, 0xd0, 0xb7, 0xd0, 0xbe, 0xd0, 0xb2, 0x20, 0xd0, 0xbd, 0xd0, 0xb0, 0x20, 0xd0,
-	0xb2, 0xd1, 0x8b, 0xd0, 0xb4, 0xd0, 0xb0, 0xd1, 0x87, 0xd1, 0x83, 0x20, 0xd0, 0xb4, 0xd0, 0xbb,
-	0xd1, 0x8f, 0x20, 0xd0, 0xbf, 0xd0, 0xbe, 0xd0, 0xbb, 0xd1, 0x8c, 0xd0, 0xb7, 0xd0, 0xbe, 0xd0,
-	0xb2, 0xd0, 0xb0, 0xd1, 0x82, 0xd0, 0xb5, 0xd0, 0xbb, 0xd1, 0x8f, 0x1a, 0x46, 0xd0, 0x9f, 0xd1,
-	0x80, 0xd0, 0xb8, 0xd0, 0xbd, 0xd0, 0xb8, 0xd0, 0xbc, 0xd0, 0xb0, 0xd0, 0xb5, 0xd1, 0x82, 0x20,
-	0xd0, 0xb8, 0xd0, 0xb4, 0xd0, 0xb5, 0xd0, 0xbd, 0xd1, 0x82, 0xd0, 0xb8, 0xd1, 0x84, 0xd0, 0xb8,
-	0xd0, 0xba, 0xd0, 0xb0, 0xd1, 0x82, 0xd0, 0xbe, 0xd1, 0x80, 0x20, 0xd0, 0xbf, 0xd0, 0xbe, 0xd0,
-	0xbb, 0xd1, 0x8c, 0xd0, 0xb7, 0xd0, 0xbe, 0xd0, 0xb2, 0xd0, 0xb0, 0xd1, 0x82, 0xd0, 0xb5, 0xd0,
-	0xbb, 0xd1, 0x8f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a, 0x2f, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0xda, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e,
-	0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70,
-	0x76, 0x7a, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9a, 0x01, 0x92, 0x41, 0x83, 0x01, 0x12, 0x48, 0xd0, 0xa1,
-	0xd0, 0xbf, 0xd0, 0xb8, 0xd1, 0x81, 0xd0, 0xbe, 0xd0, 0xba, 0x20, 0xd0, 0xb7, 0xd0, 0xb0, 0xd0,
-	0xba, 0xd0, 0xb0, 0xd0, 0xb7, 0xd0, 0xbe, 0xd0, 0xb2, 0x2c, 0x20, 0xd0, 0xb2, 0xd0, 0xbe, 0xd0,
-	0xb7, 0xd0, 0xb2, 0xd1, 0x80, 0xd0, 0xb0, 0xd1, 0x89, 0xd0, 0xb5, 0xd0, 0xbd, 0xd0, 0xbd, 0xd1,
+	0x80, 0x20, 0xd0, 0xb7, 0xd0, 0xb0, 0xd0, 0xba, 0xd0, 0xb0, 0xd0, 0xb7, 0xd0, 0xb0, 0x2c, 0x20,
+	0xd0, 0xbf, 0xd1, 0x80, 0xd0, 0xb8, 0xd1, 0x87, 0xd0, 0xb8, 0xd0, 0xbd, 0xd1, 0x83, 0x20, 0xd0,
+	0xb2, 0xd0, 0xbe, 0xd0, 0xb7, 0xd0, 0xb2, 0xd1, 0x80, 0xd0, 0xb0, 0xd1, 0x82, 0xd0, 0xb0, 0x2c,
+	0x20, 0xd0, 0xba, 0xd0, 0xbe, 0xd0, 0xbc, 0xd0, 0xbc, 0xd0, 0xb5, 0xd0, 0xbd, 0xd1, 0x82, 0xd0,
+	0xb0, 0xd1, 0x80, 0xd0, 0xb8, 0xd0, 0xb9, 0x2c, 0x20, 0xd1, 0x80, 0xd0, 0xb5, 0xd0, 0xb7, 0xd1,
+	0x83, 0xd0, 0xbb, 0xd1, 0x8c, 0xd1, 0x82, 0xd0, 0xb0, 0xd1, 0x82, 0x20, 0xd0, 0xbe, 0xd1, 0x81,
+	0xd0, 0xbc, 0xd0, 0xbe, 0xd1, 0x82, 0xd1, 0x80, 0xd0, 0xb0, 0x20, 0xd0, 0xb7, 0xd0, 0xb0, 0xd0,
+	0xba, 0xd0, 0xb0, 0xd0, 0xb7, 0xd0, 0xb0, 0x20, 0xd0, 0xb8, 0x20, 0xd0, 0xb0, 0xd1, 0x80, 0xd1,
+	0x82, 0xd0, 0xb8, 0xd0, 0xba, 0xd1, 0x83, 0xd0, 0xbb, 0xd1, 0x8b, 0x20, 0xd0, 0xb2, 0xd0, 0xbe,
+	0xd0, 0xb7, 0xd0, 0xb2, 0xd1, 0x80, 0xd0, 0xb0, 0xd1, 0x89, 0xd0, 0xb0, 0xd0, 0xb5, 0xd0, 0xbc,
+	0xd1, 0x8b, 0xd1, 0x85, 0x20, 0xd1, 0x82, 0xd0, 0xbe, 0xd0, 0xb2, 0xd0, 0xb0, 0xd1, 0x80, 0xd0,
+	0xbe, 0xd0, 0xb2, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a, 0x22, 0x0d, 0x2f, 0x52,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0xea, 0x01, 0x0a, 0x09,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x70, 0x76, 0x7a, 0x2e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xad, 0x01, 0x92, 0x41, 0x97, 0x01, 0x12,
+	0x4d, 0xd0, 0xa1, 0xd0, 0xbf, 0xd0, 0xb8, 0xd1, 0x81, 0xd0, 0xbe, 0xd0, 0xba, 0x20, 0xd0, 0xb7,
+	0xd0, 0xb0, 0xd0, 0xba, 0xd0, 0xb0, 0xd0, 0xb7, 0xd0, 0xbe, 0xd0, 0xb2, 0x20, 0xd0, 0xbd, 0xd0,
+	0xb0, 0x20, 0xd0, 0xb2, 0xd1, 0x8b, 0xd0, 0xb4, 0xd0, 0xb0, 0xd1, 0x87, 0xd1, 0x83, 0x20, 0xd0,
+	0xb4, 0xd0, 0xbb, 0xd1, 0x8f, 0x20, 0xd0, 0xbf, 0xd0, 0xbe, 0xd0, 0xbb, 0xd1, 0x8c, 0xd0, 0xb7,
+	0xd0, 0xbe, 0xd0, 0xb2, 0xd0, 0xb0, 0xd1, 0x82, 0xd0, 0xb5, 0xd0, 0xbb, 0xd1, 0x8f, 0x1a, 0x46,
+	0xd0, 0x9f, 0xd1, 0x80, 0xd0, 0xb8, 0xd0, 0xbd, 0xd0, 0xb8, 0xd0, 0xbc, 0xd0, 0xb0, 0xd0, 0xb5,
+	0xd1, 0x82, 0x20, 0xd0, 0xb8, 0xd0, 0xb4, 0xd0, 0xb5, 0xd0, 0xbd, 0xd1, 0x82, 0xd0, 0xb8, 0xd1,
+	0x84, 0xd0, 0xb8, 0xd0, 0xba, 0xd0, 0xb0, 0xd1, 0x82, 0xd0, 0xbe, 0xd1, 0x80, 0x20, 0xd0, 0xbf,
+	0xd0, 0xbe, 0xd0, 0xbb, 0xd1, 0x8c, 0xd0, 0xb7, 0xd0, 0xbe, 0xd0, 0xb2, 0xd0, 0xb0, 0xd1, 0x82,
+	0xd0, 0xb5, 0xd0, 0xbb, 0xd1, 0x8f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a, 0x2f, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0xde, 0x02, 0x0a, 0x0a, 0x52, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x52, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9e, 0x02, 0x92, 0x41, 0x87, 0x02, 0x12,
+	0x48, 0xd0, 0xa1, 0xd0, 0xbf, 0xd0, 0xb8, 0xd1, 0x81, 0xd0, 0xbe, 0xd0, 0xba, 0x20, 0xd0, 0xb7,
+	0xd0, 0xb0, 0xd0, 0xba, 0xd0, 0xb0, 0xd0, 0xb7, 0xd0, 0xbe, 0xd0, 0xb2, 0x2c, 0x20, 0xd0, 0xb2,
+	0xd0, 0xbe, 0xd0, 0xb7, 0xd0, 0xb2, 0xd1, 0x80, 0xd0, 0xb0, 0xd1, 0x89, 0xd0, 0xb5, 0xd0, 0xbd,
+	0xd0, 0xbd, 0xd1, 0x8b, 0xd1, 0x85, 0x20, 0xd0, 0xba, 0xd0, 0xbb, 0xd0, 0xb8, 0xd0, 0xb5, 0xd0,
+	0xbd, 0xd1, 0x82, 0xd0, 0xb0, 0xd0, 0xbc, 0xd0, 0xb8, 0x1a, 0xba, 0x01, 0xd0, 0x9f, 0xd1, 0x80,
+	0xd0, 0xb8, 0xd0, 0xbd, 0xd0, 0xb8, 0xd0, 0xbc, 0xd0, 0xb0, 0xd0, 0xb5, 0xd1, 0x82, 0x20, 0xd0,
+	0xba, 0xd0, 0xbe, 0xd0, 0xbb, 0xd0, 0xb8, 0xd1, 0x87, 0xd0, 0xb5, 0xd1, 0x81, 0xd1, 0x82, 0xd0,
+	0xb2, 0xd0, 0xbe, 0x2c, 0x20, 0xd0, 0xbe, 0xd1, 0x82, 0xd1, 0x81, 0xd1, 0x82, 0xd1, 0x83, 0xd0,
+	0xbf, 0x20, 0xd0, 0xb8, 0x20, 0xd1, 0x84, 0xd0, 0xb8, 0xd0, 0xbb, 0xd1, 0x8c, 0xd1, 0x82, 0xd1,
+	0x80, 0x3a, 0x20, 0x61, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x20, 0x2d, 0x20, 0xd0, 0xbe, 0xd0, 0xb6, 0xd0, 0xb8, 0xd0, 0xb4, 0xd0, 0xb0, 0xd1, 0x8e,
+	0xd1, 0x82, 0x20, 0xd0, 0xb2, 0xd0, 0xbe, 0xd0, 0xb7, 0xd0, 0xb2, 0xd1, 0x80, 0xd0, 0xb0, 0xd1,
+	0x82, 0xd0, 0xb0, 0x20, 0xd0, 0xbf, 0xd1, 0x80, 0xd0, 0xbe, 0xd0, 0xb4, 0xd0, 0xb0, 0xd0, 0xb2,
+	0xd1, 0x86, 0xd1, 0x83, 0x2c, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x2d,
+	0x20, 0xd0, 0xb2, 0xd0, 0xbe, 0xd0, 0xb7, 0xd0, 0xb2, 0xd1, 0x80, 0xd0, 0xb0, 0xd1, 0x89, 0xd0,
+	0xb5, 0xd0, 0xbd, 0xd1, 0x8b, 0x20, 0xd0, 0xbf, 0xd1, 0x80, 0xd0, 0xbe, 0xd0, 0xb4, 0xd0, 0xb0,
+	0xd0, 0xb2, 0xd1, 0x86, 0xd1, 0x83, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x12, 0x0b, 0x2f, 0x52,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0xe7, 0x02, 0x0a, 0x16, 0x52, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x54, 0x6f, 0x43, 0x6f, 0x75,
+	0x72, 0x69, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x54, 0x6f, 0x43, 0x6f, 0x75, 0x72, 0x69, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x54, 0x6f, 0x43, 0x6f,
+	0x75, 0x72, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x83, 0x02,
+	0x92, 0x41, 0xdd, 0x01, 0x12, 0x70, 0xd0, 0x9f, 0xd0, 0xb5, 0xd1, 0x80, 0xd0, 0xb5, 0xd0, 0xb4,
+	0xd0, 0xb0, 0xd1, 0x87, 0xd0, 0xb0, 0x20, 0xd0, 0xb2, 0xd0, 0xbe, 0xd0, 0xb7, 0xd0, 0xb2, 0xd1,
+	0x80, 0xd0, 0xb0, 0xd1, 0x89, 0xd0, 0xb5, 0xd0, 0xbd, 0xd0, 0xbd, 0xd1, 0x8b, 0xd1, 0x85, 0x20,
+	0xd0, 0xb7, 0xd0, 0xb0, 0xd0, 0xba, 0xd0, 0xb0, 0xd0, 0xb7, 0xd0, 0xbe, 0xd0, 0xb2, 0x20, 0xd0,
+	0xba, 0xd1, 0x83, 0xd1, 0x80, 0xd1, 0x8c, 0xd0, 0xb5, 0xd1, 0x80, 0xd1, 0x83, 0x20, 0xd0, 0xb4,
+	0xd0, 0xbb, 0xd1, 0x8f, 0x20, 0xd0, 0xb2, 0xd0, 0xbe, 0xd0, 0xb7, 0xd0, 0xb2, 0xd1, 0x80, 0xd0,
+	0xb0, 0xd1, 0x82, 0xd0, 0xb0, 0x20, 0xd0, 0xbf, 0xd1, 0x80, 0xd0, 0xbe, 0xd0, 0xb4, 0xd0, 0xb0,
+	0xd0, 0xb2, 0xd1, 0x86, 0xd1, 0x83, 0x1a, 0x69, 0xd0, 0x9f, 0xd1, 0x80, 0xd0, 0xb8, 0xd0, 0xbd,
+	0xd0, 0xb8, 0xd0, 0xbc, 0xd0, 0xb0, 0xd0, 0xb5, 0xd1, 0x82, 0x20, 0xd0, 0xba, 0xd1, 0x83, 0xd1,
+	0x80, 0xd1, 0x8c, 0xd0, 0xb5, 0xd1, 0x80, 0xd0, 0xb0, 0x20, 0xd0, 0xb8, 0x20, 0xd0, 0xb8, 0xd0,
+	0xb4, 0xd0, 0xb5, 0xd0, 0xbd, 0xd1, 0x82, 0xd0, 0xb8, 0xd1, 0x84, 0xd0, 0xb8, 0xd0, 0xba, 0xd0,
+	0xb0, 0xd1, 0x82, 0xd0, 0xbe, 0xd1, 0x80, 0xd1, 0x8b, 0x20, 0xd0, 0xb2, 0xd0, 0xbe, 0xd0, 0xb7,
+	0xd0, 0xb2, 0xd1, 0x80, 0xd0, 0xb0, 0xd1, 0x89, 0xd0, 0xb5, 0xd0, 0xbd, 0xd0, 0xbd, 0xd1, 0x8b,
+	0xd1, 0x85, 0x20, 0xd0, 0xb7, 0xd0, 0xb0, 0xd0, 0xba, 0xd0, 0xb0, 0xd0, 0xb7, 0xd0, 0xbe, 0xd0,
+	0xb2, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x22, 0x17, 0x2f, 0x52, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x54, 0x6f, 0x43, 0x6f, 0x75, 0x72,
+	0x69, 0x65, 0x72, 0x12, 0xd3, 0x02, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x19, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8a, 0x02, 0x92,
+	0x41, 0xed, 0x01, 0x12, 0x44, 0xd0, 0x9f, 0xd0, 0xbe, 0xd0, 0xb4, 0xd1, 0x82, 0xd0, 0xb2, 0xd0,
+	0xb5, 0xd1, 0x80, 0xd0, 0xb6, 0xd0, 0xb4, 0xd0, 0xb5, 0xd0, 0xbd, 0xd0, 0xb8, 0xd0, 0xb5, 0x20,
+	0xd0, 0xb2, 0xd0, 0xbe, 0xd0, 0xb7, 0xd0, 0xb2, 0xd1, 0x80, 0xd0, 0xb0, 0xd1, 0x82, 0xd0, 0xb0,
+	0x20, 0xd1, 0x81, 0xd1, 0x83, 0xd0, 0xbf, 0xd0, 0xb5, 0xd1, 0x80, 0xd0, 0xb2, 0xd0, 0xb8, 0xd0,
+	0xb7, 0xd0, 0xbe, 0xd1, 0x80, 0xd0, 0xbe, 0xd0, 0xbc, 0x1a, 0xa4, 0x01, 0xd0, 0x9f, 0xd1, 0x80,
+	0xd0, 0xb8, 0xd0, 0xbd, 0xd0, 0xb8, 0xd0, 0xbc, 0xd0, 0xb0, 0xd0, 0xb5, 0xd1, 0x82, 0x20, 0xd0,
+	0xb8, 0xd0, 0xb4, 0xd0, 0xb5, 0xd0, 0xbd, 0xd1, 0x82, 0xd0, 0xb8, 0xd1, 0x84, 0xd0, 0xb8, 0xd0,
+	0xba, 0xd0, 0xb0, 0xd1, 0x82, 0xd0, 0xbe, 0xd1, 0x80, 0x20, 0xd0, 0xb7, 0xd0, 0xb0, 0xd0, 0xba,
+	0xd0, 0xb0, 0xd0, 0xb7, 0xd0, 0xb0, 0x2c, 0x20, 0xd0, 0xbe, 0xd0, 0xb6, 0xd0, 0xb8, 0xd0, 0xb4,
+	0xd0, 0xb0, 0xd1, 0x8e, 0xd1, 0x89, 0xd0, 0xb5, 0xd0, 0xb3, 0xd0, 0xbe, 0x20, 0xd0, 0xbf, 0xd0,
+	0xbe, 0xd0, 0xb4, 0xd1, 0x82, 0xd0, 0xb2, 0xd0, 0xb5, 0xd1, 0x80, 0xd0, 0xb6, 0xd0, 0xb4, 0xd0,
+	0xb5, 0xd0, 0xbd, 0xd0, 0xb8, 0xd1, 0x8f, 0x20, 0xd0, 0xb2, 0xd0, 0xbe, 0xd0, 0xb7, 0xd0, 0xb2,
+	0xd1, 0x80, 0xd0, 0xb0, 0xd1, 0x82, 0xd0, 0xb0, 0x2c, 0x20, 0xd0, 0xb8, 0x20, 0xd0, 0xbf, 0xd1,
+	0x80, 0xd0, 0xb8, 0xd0, 0xb7, 0xd0, 0xbd, 0xd0, 0xb0, 0xd0, 0xba, 0x20, 0xd0, 0xbe, 0xd1, 0x82,
+	0xd0, 0xba, 0xd0, 0xbb, 0xd0, 0xbe, 0xd0, 0xbd, 0xd0, 0xb5, 0xd0, 0xbd, 0xd0, 0xb8, 0xd1, 0x8f,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x97, 0x02, 0x0a, 0x12, 0x4c, 0x69,
+	0x73, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x67, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x1e, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x67,
+	0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x67,
+	0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0xbf, 0x01, 0x92, 0x41, 0xa0, 0x01, 0x12, 0x3a, 0xd0, 0xa1, 0xd0, 0xbf, 0xd0, 0xb8,
+	0xd1, 0x81, 0xd0, 0xbe, 0xd0, 0xba, 0x20, 0xd0, 0xbf, 0xd0, 0xbe, 0xd0, 0xb4, 0xd0, 0xbe, 0xd0,
+	0xb7, 0xd1, 0x80, 0xd0, 0xb8, 0xd1, 0x82, 0xd0, 0xb5, 0xd0, 0xbb, 0xd1, 0x8c, 0xd0, 0xbd, 0xd1,
 	0x8b, 0xd1, 0x85, 0x20, 0xd0, 0xba, 0xd0, 0xbb, 0xd0, 0xb8, 0xd0, 0xb5, 0xd0, 0xbd, 0xd1, 0x82,
-	0xd0, 0xb0, 0xd0, 0xbc, 0xd0, 0xb8, 0x1a, 0x37, 0xd0, 0x9f, 0xd1, 0x80, 0xd0, 0xb8, 0xd0, 0xbd,
-	0xd0, 0xb8, 0xd0, 0xbc, 0xd0, 0xb0, 0xd0, 0xb5, 0xd1, 0x82, 0x20, 0xd0, 0xba, 0xd0, 0xbe, 0xd0,
-	0xbb, 0xd0, 0xb8, 0xd1, 0x87, 0xd0, 0xb5, 0xd1, 0x81, 0xd1, 0x82, 0xd0, 0xb2, 0xd0, 0xbe, 0x20,
-	0xd0, 0xb8, 0x20, 0xd0, 0xbe, 0xd1, 0x82, 0xd1, 0x81, 0xd1, 0x82, 0xd1, 0x83, 0xd0, 0xbf, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x12, 0x0b, 0x2f, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4c, 0x69,
-	0x73, 0x74, 0x42, 0xf5, 0x01, 0x92, 0x41, 0xb8, 0x01, 0x12, 0x7f, 0x0a, 0x26, 0xd0, 0x9f, 0xd1,
-	0x83, 0xd0, 0xbd, 0xd0, 0xba, 0xd1, 0x82, 0x20, 0xd0, 0xb2, 0xd1, 0x8b, 0xd0, 0xb4, 0xd0, 0xb0,
-	0xd1, 0x87, 0xd0, 0xb8, 0x20, 0xd0, 0xb7, 0xd0, 0xb0, 0xd0, 0xba, 0xd0, 0xb0, 0xd0, 0xb7, 0xd0,
-	0xbe, 0xd0, 0xb2, 0x12, 0x4e, 0xd0, 0xa1, 0xd0, 0xb5, 0xd1, 0x80, 0xd0, 0xb2, 0xd0, 0xb8, 0xd1,
-	0x81, 0x20, 0xd0, 0xb4, 0xd0, 0xbb, 0xd1, 0x8f, 0x20, 0xd1, 0x83, 0xd1, 0x87, 0xd0, 0xb5, 0xd1,
-	0x82, 0xd0, 0xb0, 0x20, 0xd0, 0xb7, 0xd0, 0xb0, 0xd0, 0xba, 0xd0, 0xb0, 0xd0, 0xb7, 0xd0, 0xbe,
-	0xd0, 0xb2, 0x20, 0xd0, 0xbd, 0xd0, 0xb0, 0x20, 0xd0, 0xbf, 0xd1, 0x83, 0xd0, 0xbd, 0xd0, 0xba,
-	0xd1, 0x82, 0xd0, 0xb0, 0xd1, 0x85, 0x20, 0xd0, 0xb2, 0xd1, 0x8b, 0xd0, 0xb4, 0xd0, 0xb0, 0xd1,
-	0x87, 0xd0, 0xb8, 0x32, 0x05, 0x31, 0x2e, 0x30, 0x2e, 0x30, 0x1a, 0x0e, 0x6c, 0x6f, 0x63, 0x61,
-	0x6c, 0x68, 0x6f, 0x73, 0x74, 0x3a, 0x37, 0x30, 0x30, 0x30, 0x2a, 0x01, 0x01, 0x32, 0x10, 0x61,
-	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
-	0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f,
-	0x6e, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x61,
-	0x33, 0x32, 0x32, 0x50, 0x72, 0x2f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x32, 0x35, 0x36, 0x2f, 0x70,
-	0x6b, 0x67, 0x2f, 0x70, 0x76, 0x7a, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x3b, 0x70,
-	0x76, 0x7a, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0xd0, 0xbe, 0xd0, 0xb2, 0x1a, 0x62, 0xd0, 0x92, 0xd0, 0xbe, 0xd0, 0xb7, 0xd0, 0xb2, 0xd1, 0x80,
+	0xd0, 0xb0, 0xd1, 0x89, 0xd0, 0xb0, 0xd0, 0xb5, 0xd1, 0x82, 0x20, 0xd0, 0xba, 0xd0, 0xbb, 0xd0,
+	0xb8, 0xd0, 0xb5, 0xd0, 0xbd, 0xd1, 0x82, 0xd0, 0xbe, 0xd0, 0xb2, 0x2c, 0x20, 0xd0, 0xbf, 0xd1,
+	0x80, 0xd0, 0xb5, 0xd0, 0xb2, 0xd1, 0x8b, 0xd1, 0x81, 0xd0, 0xb8, 0xd0, 0xb2, 0xd1, 0x88, 0xd0,
+	0xb8, 0xd1, 0x85, 0x20, 0xd0, 0xbf, 0xd0, 0xbe, 0xd1, 0x80, 0xd0, 0xbe, 0xd0, 0xb3, 0xd0, 0xb8,
+	0x20, 0xd0, 0xbf, 0xd0, 0xbe, 0x20, 0xd0, 0xb2, 0xd0, 0xbe, 0xd0, 0xb7, 0xd0, 0xb2, 0xd1, 0x80,
+	0xd0, 0xb0, 0xd1, 0x82, 0xd0, 0xb0, 0xd0, 0xbc, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13,
+	0x2f, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x67, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0xeb, 0x02, 0x0a, 0x0d, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa2, 0x02, 0x92,
+	0x41, 0x85, 0x02, 0x12, 0x38, 0xd0, 0x90, 0xd1, 0x80, 0xd1, 0x85, 0xd0, 0xb8, 0xd0, 0xb2, 0xd0,
+	0xb0, 0xd1, 0x86, 0xd0, 0xb8, 0xd1, 0x8f, 0x20, 0xd0, 0xb7, 0xd0, 0xb0, 0xd0, 0xb2, 0xd0, 0xb5,
+	0xd1, 0x80, 0xd1, 0x88, 0xd0, 0xb5, 0xd0, 0xbd, 0xd0, 0xbd, 0xd1, 0x8b, 0xd1, 0x85, 0x20, 0xd0,
+	0xb7, 0xd0, 0xb0, 0xd0, 0xba, 0xd0, 0xb0, 0xd0, 0xb7, 0xd0, 0xbe, 0xd0, 0xb2, 0x1a, 0xc8, 0x01,
+	0xd0, 0x9f, 0xd0, 0xb5, 0xd1, 0x80, 0xd0, 0xb5, 0xd0, 0xbd, 0xd0, 0xbe, 0xd1, 0x81, 0xd0, 0xb8,
+	0xd1, 0x82, 0x20, 0xd0, 0xb7, 0xd0, 0xb0, 0xd0, 0xb2, 0xd0, 0xb5, 0xd1, 0x80, 0xd1, 0x88, 0xd0,
+	0xb5, 0xd0, 0xbd, 0xd0, 0xbd, 0xd1, 0x8b, 0xd0, 0xb5, 0x20, 0xd0, 0xb7, 0xd0, 0xb0, 0xd0, 0xba,
+	0xd0, 0xb0, 0xd0, 0xb7, 0xd1, 0x8b, 0x20, 0xd1, 0x81, 0xd1, 0x82, 0xd0, 0xb0, 0xd1, 0x80, 0xd1,
+	0x88, 0xd0, 0xb5, 0x20, 0xd0, 0xbd, 0xd0, 0xb0, 0xd1, 0x81, 0xd1, 0x82, 0xd1, 0x80, 0xd0, 0xbe,
+	0xd0, 0xb5, 0xd0, 0xbd, 0xd0, 0xbd, 0xd0, 0xbe, 0xd0, 0xb3, 0xd0, 0xbe, 0x20, 0xd1, 0x81, 0xd1,
+	0x80, 0xd0, 0xbe, 0xd0, 0xba, 0xd0, 0xb0, 0x20, 0xd0, 0xb2, 0x20, 0xd0, 0xb0, 0xd1, 0x80, 0xd1,
+	0x85, 0xd0, 0xb8, 0xd0, 0xb2, 0x2c, 0x20, 0xd0, 0xb2, 0xd0, 0xbe, 0xd0, 0xb7, 0xd0, 0xb2, 0xd1,
+	0x80, 0xd0, 0xb0, 0xd1, 0x89, 0xd0, 0xb0, 0xd0, 0xb5, 0xd1, 0x82, 0x20, 0xd0, 0xba, 0xd0, 0xbe,
+	0xd0, 0xbb, 0xd0, 0xb8, 0xd1, 0x87, 0xd0, 0xb5, 0xd1, 0x81, 0xd1, 0x82, 0xd0, 0xb2, 0xd0, 0xbe,
+	0x20, 0xd0, 0xbf, 0xd0, 0xb5, 0xd1, 0x80, 0xd0, 0xb5, 0xd0, 0xbd, 0xd0, 0xb5, 0xd1, 0x81, 0xd0,
+	0xb5, 0xd0, 0xbd, 0xd0, 0xbd, 0xd1, 0x8b, 0xd1, 0x85, 0x20, 0xd0, 0xb7, 0xd0, 0xb0, 0xd0, 0xba,
+	0xd0, 0xb0, 0xd0, 0xb7, 0xd0, 0xbe, 0xd0, 0xb2, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01,
+	0x2a, 0x22, 0x0e, 0x2f, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x73, 0x12, 0xea, 0x02, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x4c, 0x6f, 0x67, 0x12, 0x19, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c,
+	0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa1, 0x02, 0x92, 0x41, 0x87,
+	0x02, 0x12, 0x32, 0xd0, 0x96, 0xd1, 0x83, 0xd1, 0x80, 0xd0, 0xbd, 0xd0, 0xb0, 0xd0, 0xbb, 0x20,
+	0xd0, 0xb4, 0xd0, 0xb5, 0xd0, 0xb9, 0xd1, 0x81, 0xd1, 0x82, 0xd0, 0xb2, 0xd0, 0xb8, 0xd0, 0xb9,
+	0x20, 0xd0, 0xbe, 0xd0, 0xbf, 0xd0, 0xb5, 0xd1, 0x80, 0xd0, 0xb0, 0xd1, 0x82, 0xd0, 0xbe, 0xd1,
+	0x80, 0xd0, 0xbe, 0xd0, 0xb2, 0x1a, 0xd0, 0x01, 0xd0, 0x9f, 0xd1, 0x80, 0xd0, 0xb8, 0xd0, 0xbd,
+	0xd0, 0xb8, 0xd0, 0xbc, 0xd0, 0xb0, 0xd0, 0xb5, 0xd1, 0x82, 0x20, 0xd1, 0x84, 0xd0, 0xb8, 0xd0,
+	0xbb, 0xd1, 0x8c, 0xd1, 0x82, 0xd1, 0x80, 0xd1, 0x8b, 0x20, 0xd0, 0xbf, 0xd0, 0xbe, 0x20, 0xd0,
+	0xbe, 0xd0, 0xbf, 0xd0, 0xb5, 0xd1, 0x80, 0xd0, 0xb0, 0xd1, 0x82, 0xd0, 0xbe, 0xd1, 0x80, 0xd1,
+	0x83, 0x2c, 0x20, 0xd0, 0xbc, 0xd0, 0xb5, 0xd1, 0x82, 0xd0, 0xbe, 0xd0, 0xb4, 0xd1, 0x83, 0x2c,
+	0x20, 0xd0, 0xb7, 0xd0, 0xb0, 0xd0, 0xba, 0xd0, 0xb0, 0xd0, 0xb7, 0xd1, 0x83, 0x20, 0xd0, 0xb8,
+	0x20, 0xd0, 0xbf, 0xd0, 0xb5, 0xd1, 0x80, 0xd0, 0xb8, 0xd0, 0xbe, 0xd0, 0xb4, 0xd1, 0x83, 0x2c,
+	0x20, 0xd0, 0xba, 0xd0, 0xbe, 0xd0, 0xbb, 0xd0, 0xb8, 0xd1, 0x87, 0xd0, 0xb5, 0xd1, 0x81, 0xd1,
+	0x82, 0xd0, 0xb2, 0xd0, 0xbe, 0x20, 0xd0, 0xb8, 0x20, 0xd0, 0xbe, 0xd1, 0x82, 0xd1, 0x81, 0xd1,
+	0x82, 0xd1, 0x83, 0xd0, 0xbf, 0x2e, 0x20, 0xd0, 0x92, 0xd0, 0xbe, 0xd0, 0xb7, 0xd0, 0xb2, 0xd1,
+	0x80, 0xd0, 0xb0, 0xd1, 0x89, 0xd0, 0xb0, 0xd0, 0xb5, 0xd1, 0x82, 0x20, 0xd0, 0xb7, 0xd0, 0xb0,
+	0xd0, 0xbf, 0xd0, 0xb8, 0xd1, 0x81, 0xd0, 0xb8, 0x20, 0xd0, 0xbe, 0xd1, 0x82, 0x20, 0xd0, 0xbd,
+	0xd0, 0xbe, 0xd0, 0xb2, 0xd1, 0x8b, 0xd1, 0x85, 0x20, 0xd0, 0xba, 0x20, 0xd1, 0x81, 0xd1, 0x82,
+	0xd0, 0xb0, 0xd1, 0x80, 0xd1, 0x8b, 0xd0, 0xbc, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e,
+	0x2f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x42, 0xf5,
+	0x01, 0x92, 0x41, 0xb8, 0x01, 0x12, 0x7f, 0x0a, 0x26, 0xd0, 0x9f, 0xd1, 0x83, 0xd0, 0xbd, 0xd0,
+	0xba, 0xd1, 0x82, 0x20, 0xd0, 0xb2, 0xd1, 0x8b, 0xd0, 0xb4, 0xd0, 0xb0, 0xd1, 0x87, 0xd0, 0xb8,
+	0x20, 0xd0, 0xb7, 0xd0, 0xb0, 0xd0, 0xba, 0xd0, 0xb0, 0xd0, 0xb7, 0xd0, 0xbe, 0xd0, 0xb2, 0x12,
+	0x4e, 0xd0, 0xa1, 0xd0, 0xb5, 0xd1, 0x80, 0xd0, 0xb2, 0xd0, 0xb8, 0xd1, 0x81, 0x20, 0xd0, 0xb4,
+	0xd0, 0xbb, 0xd1, 0x8f, 0x20, 0xd1, 0x83, 0xd1, 0x87, 0xd0, 0xb5, 0xd1, 0x82, 0xd0, 0xb0, 0x20,
+	0xd0, 0xb7, 0xd0, 0xb0, 0xd0, 0xba, 0xd0, 0xb0, 0xd0, 0xb7, 0xd0, 0xbe, 0xd0, 0xb2, 0x20, 0xd0,
+	0xbd, 0xd0, 0xb0, 0x20, 0xd0, 0xbf, 0xd1, 0x83, 0xd0, 0xbd, 0xd0, 0xba, 0xd1, 0x82, 0xd0, 0xb0,
+	0xd1, 0x85, 0x20, 0xd0, 0xb2, 0xd1, 0x8b, 0xd0, 0xb4, 0xd0, 0xb0, 0xd1, 0x87, 0xd0, 0xb8, 0x32,
+	0x05, 0x31, 0x2e, 0x30, 0x2e, 0x30, 0x1a, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x68, 0x6f, 0x73,
+	0x74, 0x3a, 0x37, 0x30, 0x30, 0x30, 0x2a, 0x01, 0x01, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x5a, 0x37, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x61, 0x33, 0x32, 0x32, 0x50,
+	0x72, 0x2f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x32, 0x35, 0x36, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70,
+	0x76, 0x7a, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x3b, 0x70, 0x76, 0x7a, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -931,46 +2450,88 @@ func file_pvz_service_v1_pvz_service_proto_rawDescGZIP() []byte {
 	return file_pvz_service_v1_pvz_service_proto_rawDescData
 }
 
-var file_pvz_service_v1_pvz_service_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_pvz_service_v1_pvz_service_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_pvz_service_v1_pvz_service_proto_goTypes = []any{
-	(*Order)(nil),                  // 0: pvz.Order
-	(*ReceiveCourierRequest)(nil),  // 1: pvz.ReceiveCourierRequest
-	(*ReceiveCourierResponse)(nil), // 2: pvz.ReceiveCourierResponse
-	(*ReturnCourierRequest)(nil),   // 3: pvz.ReturnCourierRequest
-	(*ReturnCourierResponse)(nil),  // 4: pvz.ReturnCourierResponse
-	(*GiveOutClientRequest)(nil),   // 5: pvz.GiveOutClientRequest
-	(*GiveOutClientResponse)(nil),  // 6: pvz.GiveOutClientResponse
-	(*RefundClientRequest)(nil),    // 7: pvz.RefundClientRequest
-	(*RefundClientResponse)(nil),   // 8: pvz.RefundClientResponse
-	(*OrderListRequest)(nil),       // 9: pvz.OrderListRequest
-	(*OrderListResponse)(nil),      // 10: pvz.OrderListResponse
-	(*RefundListRequest)(nil),      // 11: pvz.RefundListRequest
-	(*RefundListResponse)(nil),     // 12: pvz.RefundListResponse
-	(*timestamppb.Timestamp)(nil),  // 13: google.protobuf.Timestamp
+	(*Order)(nil),                          // 0: pvz.Order
+	(*OrderItem)(nil),                      // 1: pvz.OrderItem
+	(*NewOrderItem)(nil),                   // 2: pvz.NewOrderItem
+	(*ReceiveCourierRequest)(nil),          // 3: pvz.ReceiveCourierRequest
+	(*ReceiveCourierResponse)(nil),         // 4: pvz.ReceiveCourierResponse
+	(*ReturnCourierRequest)(nil),           // 5: pvz.ReturnCourierRequest
+	(*ReturnCourierResponse)(nil),          // 6: pvz.ReturnCourierResponse
+	(*GiveOutClientRequest)(nil),           // 7: pvz.GiveOutClientRequest
+	(*GiveOutClientResponse)(nil),          // 8: pvz.GiveOutClientResponse
+	(*CheckoutClientRequest)(nil),          // 9: pvz.CheckoutClientRequest
+	(*CheckoutClientResponse)(nil),         // 10: pvz.CheckoutClientResponse
+	(*GiveOutItemsRequest)(nil),            // 11: pvz.GiveOutItemsRequest
+	(*GiveOutItemsResponse)(nil),           // 12: pvz.GiveOutItemsResponse
+	(*RefundClientRequest)(nil),            // 13: pvz.RefundClientRequest
+	(*RefundClientResponse)(nil),           // 14: pvz.RefundClientResponse
+	(*OrderListRequest)(nil),               // 15: pvz.OrderListRequest
+	(*OrderListResponse)(nil),              // 16: pvz.OrderListResponse
+	(*RefundListRequest)(nil),              // 17: pvz.RefundListRequest
+	(*RefundListResponse)(nil),             // 18: pvz.RefundListResponse
+	(*ReturnRefundsToCourierRequest)(nil),  // 19: pvz.ReturnRefundsToCourierRequest
+	(*ReturnRefundsToCourierResponse)(nil), // 20: pvz.ReturnRefundsToCourierResponse
+	(*ApproveRefundRequest)(nil),           // 21: pvz.ApproveRefundRequest
+	(*ApproveRefundResponse)(nil),          // 22: pvz.ApproveRefundResponse
+	(*FlaggedClient)(nil),                  // 23: pvz.FlaggedClient
+	(*ListFlaggedClientsRequest)(nil),      // 24: pvz.ListFlaggedClientsRequest
+	(*ListFlaggedClientsResponse)(nil),     // 25: pvz.ListFlaggedClientsResponse
+	(*ArchiveOrdersRequest)(nil),           // 26: pvz.ArchiveOrdersRequest
+	(*ArchiveOrdersResponse)(nil),          // 27: pvz.ArchiveOrdersResponse
+	(*AuditLogEntry)(nil),                  // 28: pvz.AuditLogEntry
+	(*QueryAuditLogRequest)(nil),           // 29: pvz.QueryAuditLogRequest
+	(*QueryAuditLogResponse)(nil),          // 30: pvz.QueryAuditLogResponse
+	(*timestamppb.Timestamp)(nil),          // 31: google.protobuf.Timestamp
 }
 var file_pvz_service_v1_pvz_service_proto_depIdxs = []int32{
-	13, // 0: pvz.Order.store_until:type_name -> google.protobuf.Timestamp
-	13, // 1: pvz.Order.pick_up_time:type_name -> google.protobuf.Timestamp
-	13, // 2: pvz.ReceiveCourierRequest.store_until:type_name -> google.protobuf.Timestamp
-	0,  // 3: pvz.OrderListResponse.orders:type_name -> pvz.Order
-	0,  // 4: pvz.RefundListResponse.orders:type_name -> pvz.Order
-	1,  // 5: pvz.PVZService.ReceiveCourier:input_type -> pvz.ReceiveCourierRequest
-	3,  // 6: pvz.PVZService.ReturnCourier:input_type -> pvz.ReturnCourierRequest
-	5,  // 7: pvz.PVZService.GiveOutClient:input_type -> pvz.GiveOutClientRequest
-	7,  // 8: pvz.PVZService.RefundClient:input_type -> pvz.RefundClientRequest
-	9,  // 9: pvz.PVZService.OrderList:input_type -> pvz.OrderListRequest
-	11, // 10: pvz.PVZService.RefundList:input_type -> pvz.RefundListRequest
-	2,  // 11: pvz.PVZService.ReceiveCourier:output_type -> pvz.ReceiveCourierResponse
-	4,  // 12: pvz.PVZService.ReturnCourier:output_type -> pvz.ReturnCourierResponse
-	6,  // 13: pvz.PVZService.GiveOutClient:output_type -> pvz.GiveOutClientResponse
-	8,  // 14: pvz.PVZService.RefundClient:output_type -> pvz.RefundClientResponse
-	10, // 15: pvz.PVZService.OrderList:output_type -> pvz.OrderListResponse
-	12, // 16: pvz.PVZService.RefundList:output_type -> pvz.RefundListResponse
-	11, // [11:17] is the sub-list for method output_type
-	5,  // [5:11] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	31, // 0: pvz.Order.store_until:type_name -> google.protobuf.Timestamp
+	31, // 1: pvz.Order.pick_up_time:type_name -> google.protobuf.Timestamp
+	1,  // 2: pvz.Order.items:type_name -> pvz.OrderItem
+	31, // 3: pvz.ReceiveCourierRequest.store_until:type_name -> google.protobuf.Timestamp
+	2,  // 4: pvz.ReceiveCourierRequest.items:type_name -> pvz.NewOrderItem
+	0,  // 5: pvz.CheckoutClientResponse.orders:type_name -> pvz.Order
+	0,  // 6: pvz.GiveOutItemsResponse.order:type_name -> pvz.Order
+	0,  // 7: pvz.OrderListResponse.orders:type_name -> pvz.Order
+	0,  // 8: pvz.RefundListResponse.orders:type_name -> pvz.Order
+	31, // 9: pvz.ReturnRefundsToCourierResponse.handed_over_at:type_name -> google.protobuf.Timestamp
+	23, // 10: pvz.ListFlaggedClientsResponse.clients:type_name -> pvz.FlaggedClient
+	31, // 11: pvz.AuditLogEntry.created_at:type_name -> google.protobuf.Timestamp
+	31, // 12: pvz.QueryAuditLogRequest.from:type_name -> google.protobuf.Timestamp
+	31, // 13: pvz.QueryAuditLogRequest.to:type_name -> google.protobuf.Timestamp
+	28, // 14: pvz.QueryAuditLogResponse.entries:type_name -> pvz.AuditLogEntry
+	3,  // 15: pvz.PVZService.ReceiveCourier:input_type -> pvz.ReceiveCourierRequest
+	5,  // 16: pvz.PVZService.ReturnCourier:input_type -> pvz.ReturnCourierRequest
+	7,  // 17: pvz.PVZService.GiveOutClient:input_type -> pvz.GiveOutClientRequest
+	9,  // 18: pvz.PVZService.CheckoutClient:input_type -> pvz.CheckoutClientRequest
+	11, // 19: pvz.PVZService.GiveOutItems:input_type -> pvz.GiveOutItemsRequest
+	13, // 20: pvz.PVZService.RefundClient:input_type -> pvz.RefundClientRequest
+	15, // 21: pvz.PVZService.OrderList:input_type -> pvz.OrderListRequest
+	17, // 22: pvz.PVZService.RefundList:input_type -> pvz.RefundListRequest
+	19, // 23: pvz.PVZService.ReturnRefundsToCourier:input_type -> pvz.ReturnRefundsToCourierRequest
+	21, // 24: pvz.PVZService.ApproveRefund:input_type -> pvz.ApproveRefundRequest
+	24, // 25: pvz.PVZService.ListFlaggedClients:input_type -> pvz.ListFlaggedClientsRequest
+	26, // 26: pvz.PVZService.ArchiveOrders:input_type -> pvz.ArchiveOrdersRequest
+	29, // 27: pvz.PVZService.QueryAuditLog:input_type -> pvz.QueryAuditLogRequest
+	4,  // 28: pvz.PVZService.ReceiveCourier:output_type -> pvz.ReceiveCourierResponse
+	6,  // 29: pvz.PVZService.ReturnCourier:output_type -> pvz.ReturnCourierResponse
+	8,  // 30: pvz.PVZService.GiveOutClient:output_type -> pvz.GiveOutClientResponse
+	10, // 31: pvz.PVZService.CheckoutClient:output_type -> pvz.CheckoutClientResponse
+	12, // 32: pvz.PVZService.GiveOutItems:output_type -> pvz.GiveOutItemsResponse
+	14, // 33: pvz.PVZService.RefundClient:output_type -> pvz.RefundClientResponse
+	16, // 34: pvz.PVZService.OrderList:output_type -> pvz.OrderListResponse
+	18, // 35: pvz.PVZService.RefundList:output_type -> pvz.RefundListResponse
+	20, // 36: pvz.PVZService.ReturnRefundsToCourier:output_type -> pvz.ReturnRefundsToCourierResponse
+	22, // 37: pvz.PVZService.ApproveRefund:output_type -> pvz.ApproveRefundResponse
+	25, // 38: pvz.PVZService.ListFlaggedClients:output_type -> pvz.ListFlaggedClientsResponse
+	27, // 39: pvz.PVZService.ArchiveOrders:output_type -> pvz.ArchiveOrdersResponse
+	30, // 40: pvz.PVZService.QueryAuditLog:output_type -> pvz.QueryAuditLogResponse
+	28, // [28:41] is the sub-list for method output_type
+	15, // [15:28] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_pvz_service_v1_pvz_service_proto_init() }
@@ -978,15 +2539,16 @@ func file_pvz_service_v1_pvz_service_proto_init() {
 	if File_pvz_service_v1_pvz_service_proto != nil {
 		return
 	}
-	file_pvz_service_v1_pvz_service_proto_msgTypes[9].OneofWrappers = []any{}
-	file_pvz_service_v1_pvz_service_proto_msgTypes[11].OneofWrappers = []any{}
+	file_pvz_service_v1_pvz_service_proto_msgTypes[15].OneofWrappers = []any{}
+	file_pvz_service_v1_pvz_service_proto_msgTypes[17].OneofWrappers = []any{}
+	file_pvz_service_v1_pvz_service_proto_msgTypes[29].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pvz_service_v1_pvz_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
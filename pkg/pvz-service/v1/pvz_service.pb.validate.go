@@ -124,6 +124,52 @@ func (m *Order) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for RefundReason
+
+	// no validation rules for RefundComment
+
+	// no validation rules for InspectionCondition
+
+	// no validation rules for InspectionOutcome
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for PaidAmount
+
+	// no validation rules for RefundAmount
+
 	if len(errors) > 0 {
 		return OrderMultiError(errors)
 	}
@@ -201,6 +247,258 @@ var _ interface {
 	ErrorName() string
 } = OrderValidationError{}
 
+// Validate checks the field values on OrderItem with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderItemMultiError, or nil
+// if none found.
+func (m *OrderItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sku
+
+	// no validation rules for Name
+
+	// no validation rules for Quantity
+
+	// no validation rules for UnitPrice
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return OrderItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderItemMultiError is an error wrapping multiple validation errors returned
+// by OrderItem.ValidateAll() if the designated constraints aren't met.
+type OrderItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderItemMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderItemMultiError) AllErrors() []error { return m }
+
+// OrderItemValidationError is the validation error returned by
+// OrderItem.Validate if the designated constraints aren't met.
+type OrderItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderItemValidationError) ErrorName() string { return "OrderItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderItemValidationError{}
+
+// Validate checks the field values on NewOrderItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *NewOrderItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NewOrderItem with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in NewOrderItemMultiError, or
+// nil if none found.
+func (m *NewOrderItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NewOrderItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetSku()); l < 1 || l > 64 {
+		err := NewOrderItemValidationError{
+			field:  "Sku",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) > 255 {
+		err := NewOrderItemValidationError{
+			field:  "Name",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetQuantity() <= 0 {
+		err := NewOrderItemValidationError{
+			field:  "Quantity",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUnitPrice() < 0 {
+		err := NewOrderItemValidationError{
+			field:  "UnitPrice",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return NewOrderItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// NewOrderItemMultiError is an error wrapping multiple validation errors
+// returned by NewOrderItem.ValidateAll() if the designated constraints aren't met.
+type NewOrderItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NewOrderItemMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NewOrderItemMultiError) AllErrors() []error { return m }
+
+// NewOrderItemValidationError is the validation error returned by
+// NewOrderItem.Validate if the designated constraints aren't met.
+type NewOrderItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NewOrderItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NewOrderItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NewOrderItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NewOrderItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NewOrderItemValidationError) ErrorName() string { return "NewOrderItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NewOrderItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNewOrderItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NewOrderItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NewOrderItemValidationError{}
+
 // Validate checks the field values on ReceiveCourierRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -296,6 +594,40 @@ func (m *ReceiveCourierRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReceiveCourierRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReceiveCourierRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReceiveCourierRequestValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return ReceiveCourierRequestMultiError(errors)
 	}
@@ -929,64 +1261,104 @@ var _ interface {
 	ErrorName() string
 } = GiveOutClientResponseValidationError{}
 
-// Validate checks the field values on RefundClientRequest with the rules
+// Validate checks the field values on CheckoutClientRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RefundClientRequest) Validate() error {
+func (m *CheckoutClientRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RefundClientRequest with the rules
+// ValidateAll checks the field values on CheckoutClientRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RefundClientRequestMultiError, or nil if none found.
-func (m *RefundClientRequest) ValidateAll() error {
+// CheckoutClientRequestMultiError, or nil if none found.
+func (m *CheckoutClientRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RefundClientRequest) validate(all bool) error {
+func (m *CheckoutClientRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetOrderId() <= 0 {
-		err := RefundClientRequestValidationError{
-			field:  "OrderId",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	_CheckoutClientRequest_KeptOrdersIds_Unique := make(map[int64]struct{}, len(m.GetKeptOrdersIds()))
+
+	for idx, item := range m.GetKeptOrdersIds() {
+		_, _ = idx, item
+
+		if _, exists := _CheckoutClientRequest_KeptOrdersIds_Unique[item]; exists {
+			err := CheckoutClientRequestValidationError{
+				field:  fmt.Sprintf("KeptOrdersIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_CheckoutClientRequest_KeptOrdersIds_Unique[item] = struct{}{}
+		}
+
+		if item <= 0 {
+			err := CheckoutClientRequestValidationError{
+				field:  fmt.Sprintf("KeptOrdersIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
 	}
 
-	if m.GetClientId() <= 0 {
-		err := RefundClientRequestValidationError{
-			field:  "ClientId",
-			reason: "value must be greater than 0",
+	_CheckoutClientRequest_RefusedOrdersIds_Unique := make(map[int64]struct{}, len(m.GetRefusedOrdersIds()))
+
+	for idx, item := range m.GetRefusedOrdersIds() {
+		_, _ = idx, item
+
+		if _, exists := _CheckoutClientRequest_RefusedOrdersIds_Unique[item]; exists {
+			err := CheckoutClientRequestValidationError{
+				field:  fmt.Sprintf("RefusedOrdersIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_CheckoutClientRequest_RefusedOrdersIds_Unique[item] = struct{}{}
 		}
-		if !all {
-			return err
+
+		if item <= 0 {
+			err := CheckoutClientRequestValidationError{
+				field:  fmt.Sprintf("RefusedOrdersIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		errors = append(errors, err)
+
 	}
 
 	if len(errors) > 0 {
-		return RefundClientRequestMultiError(errors)
+		return CheckoutClientRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RefundClientRequestMultiError is an error wrapping multiple validation
-// errors returned by RefundClientRequest.ValidateAll() if the designated
+// CheckoutClientRequestMultiError is an error wrapping multiple validation
+// errors returned by CheckoutClientRequest.ValidateAll() if the designated
 // constraints aren't met.
-type RefundClientRequestMultiError []error
+type CheckoutClientRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RefundClientRequestMultiError) Error() string {
+func (m CheckoutClientRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -995,11 +1367,11 @@ func (m RefundClientRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RefundClientRequestMultiError) AllErrors() []error { return m }
+func (m CheckoutClientRequestMultiError) AllErrors() []error { return m }
 
-// RefundClientRequestValidationError is the validation error returned by
-// RefundClientRequest.Validate if the designated constraints aren't met.
-type RefundClientRequestValidationError struct {
+// CheckoutClientRequestValidationError is the validation error returned by
+// CheckoutClientRequest.Validate if the designated constraints aren't met.
+type CheckoutClientRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1007,24 +1379,24 @@ type RefundClientRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RefundClientRequestValidationError) Field() string { return e.field }
+func (e CheckoutClientRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RefundClientRequestValidationError) Reason() string { return e.reason }
+func (e CheckoutClientRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RefundClientRequestValidationError) Cause() error { return e.cause }
+func (e CheckoutClientRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RefundClientRequestValidationError) Key() bool { return e.key }
+func (e CheckoutClientRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RefundClientRequestValidationError) ErrorName() string {
-	return "RefundClientRequestValidationError"
+func (e CheckoutClientRequestValidationError) ErrorName() string {
+	return "CheckoutClientRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RefundClientRequestValidationError) Error() string {
+func (e CheckoutClientRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1036,14 +1408,14 @@ func (e RefundClientRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRefundClientRequest.%s: %s%s",
+		"invalid %sCheckoutClientRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RefundClientRequestValidationError{}
+var _ error = CheckoutClientRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1051,44 +1423,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RefundClientRequestValidationError{}
+} = CheckoutClientRequestValidationError{}
 
-// Validate checks the field values on RefundClientResponse with the rules
+// Validate checks the field values on CheckoutClientResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RefundClientResponse) Validate() error {
+func (m *CheckoutClientResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RefundClientResponse with the rules
+// ValidateAll checks the field values on CheckoutClientResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RefundClientResponseMultiError, or nil if none found.
-func (m *RefundClientResponse) ValidateAll() error {
+// CheckoutClientResponseMultiError, or nil if none found.
+func (m *CheckoutClientResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RefundClientResponse) validate(all bool) error {
+func (m *CheckoutClientResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetOrders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CheckoutClientResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CheckoutClientResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CheckoutClientResponseValidationError{
+					field:  fmt.Sprintf("Orders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return RefundClientResponseMultiError(errors)
+		return CheckoutClientResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RefundClientResponseMultiError is an error wrapping multiple validation
-// errors returned by RefundClientResponse.ValidateAll() if the designated
+// CheckoutClientResponseMultiError is an error wrapping multiple validation
+// errors returned by CheckoutClientResponse.ValidateAll() if the designated
 // constraints aren't met.
-type RefundClientResponseMultiError []error
+type CheckoutClientResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RefundClientResponseMultiError) Error() string {
+func (m CheckoutClientResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1097,11 +1503,11 @@ func (m RefundClientResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RefundClientResponseMultiError) AllErrors() []error { return m }
+func (m CheckoutClientResponseMultiError) AllErrors() []error { return m }
 
-// RefundClientResponseValidationError is the validation error returned by
-// RefundClientResponse.Validate if the designated constraints aren't met.
-type RefundClientResponseValidationError struct {
+// CheckoutClientResponseValidationError is the validation error returned by
+// CheckoutClientResponse.Validate if the designated constraints aren't met.
+type CheckoutClientResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1109,24 +1515,24 @@ type RefundClientResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RefundClientResponseValidationError) Field() string { return e.field }
+func (e CheckoutClientResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RefundClientResponseValidationError) Reason() string { return e.reason }
+func (e CheckoutClientResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RefundClientResponseValidationError) Cause() error { return e.cause }
+func (e CheckoutClientResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RefundClientResponseValidationError) Key() bool { return e.key }
+func (e CheckoutClientResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RefundClientResponseValidationError) ErrorName() string {
-	return "RefundClientResponseValidationError"
+func (e CheckoutClientResponseValidationError) ErrorName() string {
+	return "CheckoutClientResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RefundClientResponseValidationError) Error() string {
+func (e CheckoutClientResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1138,14 +1544,14 @@ func (e RefundClientResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRefundClientResponse.%s: %s%s",
+		"invalid %sCheckoutClientResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RefundClientResponseValidationError{}
+var _ error = CheckoutClientResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1153,33 +1559,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RefundClientResponseValidationError{}
+} = CheckoutClientResponseValidationError{}
 
-// Validate checks the field values on OrderListRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *OrderListRequest) Validate() error {
+// Validate checks the field values on GiveOutItemsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GiveOutItemsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on OrderListRequest with the rules
+// ValidateAll checks the field values on GiveOutItemsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// OrderListRequestMultiError, or nil if none found.
-func (m *OrderListRequest) ValidateAll() error {
+// GiveOutItemsRequestMultiError, or nil if none found.
+func (m *GiveOutItemsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *OrderListRequest) validate(all bool) error {
+func (m *GiveOutItemsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetClientId() <= 0 {
-		err := OrderListRequestValidationError{
-			field:  "ClientId",
+	if m.GetOrderId() <= 0 {
+		err := GiveOutItemsRequestValidationError{
+			field:  "OrderId",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -1188,10 +1594,10 @@ func (m *OrderListRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetOffset() <= -1 {
-		err := OrderListRequestValidationError{
-			field:  "Offset",
-			reason: "value must be greater than -1",
+	if len(m.GetSkus()) < 1 {
+		err := GiveOutItemsRequestValidationError{
+			field:  "Skus",
+			reason: "value must contain at least 1 item(s)",
 		}
 		if !all {
 			return err
@@ -1199,35 +1605,2096 @@ func (m *OrderListRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.Limit != nil {
+	_GiveOutItemsRequest_Skus_Unique := make(map[string]struct{}, len(m.GetSkus()))
+
+	for idx, item := range m.GetSkus() {
+		_, _ = idx, item
+
+		if _, exists := _GiveOutItemsRequest_Skus_Unique[item]; exists {
+			err := GiveOutItemsRequestValidationError{
+				field:  fmt.Sprintf("Skus[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_GiveOutItemsRequest_Skus_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for Skus[idx]
+	}
+
+	if len(errors) > 0 {
+		return GiveOutItemsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GiveOutItemsRequestMultiError is an error wrapping multiple validation
+// errors returned by GiveOutItemsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GiveOutItemsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GiveOutItemsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GiveOutItemsRequestMultiError) AllErrors() []error { return m }
+
+// GiveOutItemsRequestValidationError is the validation error returned by
+// GiveOutItemsRequest.Validate if the designated constraints aren't met.
+type GiveOutItemsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GiveOutItemsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GiveOutItemsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GiveOutItemsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GiveOutItemsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GiveOutItemsRequestValidationError) ErrorName() string {
+	return "GiveOutItemsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GiveOutItemsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGiveOutItemsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GiveOutItemsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GiveOutItemsRequestValidationError{}
+
+// Validate checks the field values on GiveOutItemsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GiveOutItemsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GiveOutItemsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GiveOutItemsResponseMultiError, or nil if none found.
+func (m *GiveOutItemsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GiveOutItemsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetOrder()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GiveOutItemsResponseValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GiveOutItemsResponseValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrder()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GiveOutItemsResponseValidationError{
+				field:  "Order",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GiveOutItemsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GiveOutItemsResponseMultiError is an error wrapping multiple validation
+// errors returned by GiveOutItemsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GiveOutItemsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GiveOutItemsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GiveOutItemsResponseMultiError) AllErrors() []error { return m }
+
+// GiveOutItemsResponseValidationError is the validation error returned by
+// GiveOutItemsResponse.Validate if the designated constraints aren't met.
+type GiveOutItemsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GiveOutItemsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GiveOutItemsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GiveOutItemsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GiveOutItemsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GiveOutItemsResponseValidationError) ErrorName() string {
+	return "GiveOutItemsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GiveOutItemsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGiveOutItemsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GiveOutItemsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GiveOutItemsResponseValidationError{}
+
+// Validate checks the field values on RefundClientRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefundClientRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefundClientRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefundClientRequestMultiError, or nil if none found.
+func (m *RefundClientRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefundClientRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderId() <= 0 {
+		err := RefundClientRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetClientId() <= 0 {
+		err := RefundClientRequestValidationError{
+			field:  "ClientId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _RefundClientRequest_Reason_InLookup[m.GetReason()]; !ok {
+		err := RefundClientRequestValidationError{
+			field:  "Reason",
+			reason: "value must be in list [defect wrongItem changedMind notAsDescribed damagedInTransit other]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetComment()) > 1000 {
+		err := RefundClientRequestValidationError{
+			field:  "Comment",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _RefundClientRequest_InspectionCondition_InLookup[m.GetInspectionCondition()]; !ok {
+		err := RefundClientRequestValidationError{
+			field:  "InspectionCondition",
+			reason: "value must be in list [intact damaged incomplete]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _RefundClientRequest_InspectionOutcome_InLookup[m.GetInspectionOutcome()]; !ok {
+		err := RefundClientRequestValidationError{
+			field:  "InspectionOutcome",
+			reason: "value must be in list [accepted rejected]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_RefundClientRequest_Skus_Unique := make(map[string]struct{}, len(m.GetSkus()))
+
+	for idx, item := range m.GetSkus() {
+		_, _ = idx, item
+
+		if _, exists := _RefundClientRequest_Skus_Unique[item]; exists {
+			err := RefundClientRequestValidationError{
+				field:  fmt.Sprintf("Skus[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_RefundClientRequest_Skus_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for Skus[idx]
+	}
+
+	if len(errors) > 0 {
+		return RefundClientRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefundClientRequestMultiError is an error wrapping multiple validation
+// errors returned by RefundClientRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RefundClientRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefundClientRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefundClientRequestMultiError) AllErrors() []error { return m }
+
+// RefundClientRequestValidationError is the validation error returned by
+// RefundClientRequest.Validate if the designated constraints aren't met.
+type RefundClientRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefundClientRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefundClientRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefundClientRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefundClientRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefundClientRequestValidationError) ErrorName() string {
+	return "RefundClientRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefundClientRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefundClientRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefundClientRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefundClientRequestValidationError{}
+
+var _RefundClientRequest_Reason_InLookup = map[string]struct{}{
+	"defect":           {},
+	"wrongItem":        {},
+	"changedMind":      {},
+	"notAsDescribed":   {},
+	"damagedInTransit": {},
+	"other":            {},
+}
+
+var _RefundClientRequest_InspectionCondition_InLookup = map[string]struct{}{
+	"intact":     {},
+	"damaged":    {},
+	"incomplete": {},
+}
+
+var _RefundClientRequest_InspectionOutcome_InLookup = map[string]struct{}{
+	"accepted": {},
+	"rejected": {},
+}
+
+// Validate checks the field values on RefundClientResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefundClientResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefundClientResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefundClientResponseMultiError, or nil if none found.
+func (m *RefundClientResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefundClientResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	// no validation rules for RefundAmount
+
+	if len(errors) > 0 {
+		return RefundClientResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefundClientResponseMultiError is an error wrapping multiple validation
+// errors returned by RefundClientResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RefundClientResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefundClientResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefundClientResponseMultiError) AllErrors() []error { return m }
+
+// RefundClientResponseValidationError is the validation error returned by
+// RefundClientResponse.Validate if the designated constraints aren't met.
+type RefundClientResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefundClientResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefundClientResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefundClientResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefundClientResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefundClientResponseValidationError) ErrorName() string {
+	return "RefundClientResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefundClientResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefundClientResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefundClientResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefundClientResponseValidationError{}
+
+// Validate checks the field values on OrderListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *OrderListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderListRequestMultiError, or nil if none found.
+func (m *OrderListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetClientId() <= 0 {
+		err := OrderListRequestValidationError{
+			field:  "ClientId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOffset() <= -1 {
+		err := OrderListRequestValidationError{
+			field:  "Offset",
+			reason: "value must be greater than -1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Limit != nil {
+
+		if m.GetLimit() <= -1 {
+			err := OrderListRequestValidationError{
+				field:  "Limit",
+				reason: "value must be greater than -1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return OrderListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderListRequestMultiError is an error wrapping multiple validation errors
+// returned by OrderListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type OrderListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderListRequestMultiError) AllErrors() []error { return m }
+
+// OrderListRequestValidationError is the validation error returned by
+// OrderListRequest.Validate if the designated constraints aren't met.
+type OrderListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderListRequestValidationError) ErrorName() string { return "OrderListRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderListRequestValidationError{}
+
+// Validate checks the field values on OrderListResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *OrderListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderListResponseMultiError, or nil if none found.
+func (m *OrderListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOrders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderListResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderListResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderListResponseValidationError{
+					field:  fmt.Sprintf("Orders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return OrderListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderListResponseMultiError is an error wrapping multiple validation errors
+// returned by OrderListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type OrderListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderListResponseMultiError) AllErrors() []error { return m }
+
+// OrderListResponseValidationError is the validation error returned by
+// OrderListResponse.Validate if the designated constraints aren't met.
+type OrderListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderListResponseValidationError) ErrorName() string {
+	return "OrderListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrderListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderListResponseValidationError{}
+
+// Validate checks the field values on RefundListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RefundListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefundListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefundListRequestMultiError, or nil if none found.
+func (m *RefundListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefundListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _RefundListRequest_Filter_InLookup[m.GetFilter()]; !ok {
+		err := RefundListRequestValidationError{
+			field:  "Filter",
+			reason: "value must be in list [ awaitingReturn returned]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Limit != nil {
+
+		if m.GetLimit() <= -1 {
+			err := RefundListRequestValidationError{
+				field:  "Limit",
+				reason: "value must be greater than -1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Offset != nil {
+
+		if m.GetOffset() <= -1 {
+			err := RefundListRequestValidationError{
+				field:  "Offset",
+				reason: "value must be greater than -1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RefundListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefundListRequestMultiError is an error wrapping multiple validation errors
+// returned by RefundListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RefundListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefundListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefundListRequestMultiError) AllErrors() []error { return m }
+
+// RefundListRequestValidationError is the validation error returned by
+// RefundListRequest.Validate if the designated constraints aren't met.
+type RefundListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefundListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefundListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefundListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefundListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefundListRequestValidationError) ErrorName() string {
+	return "RefundListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefundListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefundListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefundListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefundListRequestValidationError{}
+
+var _RefundListRequest_Filter_InLookup = map[string]struct{}{
+	"":               {},
+	"awaitingReturn": {},
+	"returned":       {},
+}
+
+// Validate checks the field values on RefundListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefundListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefundListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefundListResponseMultiError, or nil if none found.
+func (m *RefundListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefundListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOrders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RefundListResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RefundListResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RefundListResponseValidationError{
+					field:  fmt.Sprintf("Orders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RefundListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefundListResponseMultiError is an error wrapping multiple validation errors
+// returned by RefundListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type RefundListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefundListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefundListResponseMultiError) AllErrors() []error { return m }
+
+// RefundListResponseValidationError is the validation error returned by
+// RefundListResponse.Validate if the designated constraints aren't met.
+type RefundListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefundListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefundListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefundListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefundListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefundListResponseValidationError) ErrorName() string {
+	return "RefundListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefundListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefundListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefundListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefundListResponseValidationError{}
+
+// Validate checks the field values on ReturnRefundsToCourierRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReturnRefundsToCourierRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReturnRefundsToCourierRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ReturnRefundsToCourierRequestMultiError, or nil if none found.
+func (m *ReturnRefundsToCourierRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReturnRefundsToCourierRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetCourier()); l < 1 || l > 100 {
+		err := ReturnRefundsToCourierRequestValidationError{
+			field:  "Courier",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetOrdersIds()) < 1 {
+		err := ReturnRefundsToCourierRequestValidationError{
+			field:  "OrdersIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_ReturnRefundsToCourierRequest_OrdersIds_Unique := make(map[int64]struct{}, len(m.GetOrdersIds()))
+
+	for idx, item := range m.GetOrdersIds() {
+		_, _ = idx, item
+
+		if _, exists := _ReturnRefundsToCourierRequest_OrdersIds_Unique[item]; exists {
+			err := ReturnRefundsToCourierRequestValidationError{
+				field:  fmt.Sprintf("OrdersIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_ReturnRefundsToCourierRequest_OrdersIds_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for OrdersIds[idx]
+	}
+
+	if len(errors) > 0 {
+		return ReturnRefundsToCourierRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReturnRefundsToCourierRequestMultiError is an error wrapping multiple
+// validation errors returned by ReturnRefundsToCourierRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ReturnRefundsToCourierRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReturnRefundsToCourierRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReturnRefundsToCourierRequestMultiError) AllErrors() []error { return m }
+
+// ReturnRefundsToCourierRequestValidationError is the validation error
+// returned by ReturnRefundsToCourierRequest.Validate if the designated
+// constraints aren't met.
+type ReturnRefundsToCourierRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReturnRefundsToCourierRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReturnRefundsToCourierRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReturnRefundsToCourierRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReturnRefundsToCourierRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReturnRefundsToCourierRequestValidationError) ErrorName() string {
+	return "ReturnRefundsToCourierRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReturnRefundsToCourierRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReturnRefundsToCourierRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReturnRefundsToCourierRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReturnRefundsToCourierRequestValidationError{}
+
+// Validate checks the field values on ReturnRefundsToCourierResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReturnRefundsToCourierResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReturnRefundsToCourierResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ReturnRefundsToCourierResponseMultiError, or nil if none found.
+func (m *ReturnRefundsToCourierResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReturnRefundsToCourierResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for HandoverId
+
+	if all {
+		switch v := interface{}(m.GetHandedOverAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReturnRefundsToCourierResponseValidationError{
+					field:  "HandedOverAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReturnRefundsToCourierResponseValidationError{
+					field:  "HandedOverAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHandedOverAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReturnRefundsToCourierResponseValidationError{
+				field:  "HandedOverAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReturnRefundsToCourierResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReturnRefundsToCourierResponseMultiError is an error wrapping multiple
+// validation errors returned by ReturnRefundsToCourierResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ReturnRefundsToCourierResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReturnRefundsToCourierResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReturnRefundsToCourierResponseMultiError) AllErrors() []error { return m }
+
+// ReturnRefundsToCourierResponseValidationError is the validation error
+// returned by ReturnRefundsToCourierResponse.Validate if the designated
+// constraints aren't met.
+type ReturnRefundsToCourierResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReturnRefundsToCourierResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReturnRefundsToCourierResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReturnRefundsToCourierResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReturnRefundsToCourierResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReturnRefundsToCourierResponseValidationError) ErrorName() string {
+	return "ReturnRefundsToCourierResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReturnRefundsToCourierResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReturnRefundsToCourierResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReturnRefundsToCourierResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReturnRefundsToCourierResponseValidationError{}
+
+// Validate checks the field values on ApproveRefundRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApproveRefundRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveRefundRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApproveRefundRequestMultiError, or nil if none found.
+func (m *ApproveRefundRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveRefundRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderId() <= 0 {
+		err := ApproveRefundRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Reject
+
+	if len(errors) > 0 {
+		return ApproveRefundRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveRefundRequestMultiError is an error wrapping multiple validation
+// errors returned by ApproveRefundRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ApproveRefundRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveRefundRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveRefundRequestMultiError) AllErrors() []error { return m }
+
+// ApproveRefundRequestValidationError is the validation error returned by
+// ApproveRefundRequest.Validate if the designated constraints aren't met.
+type ApproveRefundRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveRefundRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveRefundRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveRefundRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveRefundRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveRefundRequestValidationError) ErrorName() string {
+	return "ApproveRefundRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveRefundRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveRefundRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveRefundRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveRefundRequestValidationError{}
+
+// Validate checks the field values on ApproveRefundResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApproveRefundResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveRefundResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApproveRefundResponseMultiError, or nil if none found.
+func (m *ApproveRefundResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveRefundResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ApproveRefundResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveRefundResponseMultiError is an error wrapping multiple validation
+// errors returned by ApproveRefundResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ApproveRefundResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveRefundResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveRefundResponseMultiError) AllErrors() []error { return m }
+
+// ApproveRefundResponseValidationError is the validation error returned by
+// ApproveRefundResponse.Validate if the designated constraints aren't met.
+type ApproveRefundResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveRefundResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveRefundResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveRefundResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveRefundResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveRefundResponseValidationError) ErrorName() string {
+	return "ApproveRefundResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveRefundResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveRefundResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveRefundResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveRefundResponseValidationError{}
+
+// Validate checks the field values on FlaggedClient with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FlaggedClient) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FlaggedClient with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FlaggedClientMultiError, or
+// nil if none found.
+func (m *FlaggedClient) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FlaggedClient) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ClientId
+
+	// no validation rules for Issued
+
+	// no validation rules for Refunds
+
+	// no validation rules for HighValueRefunds
+
+	// no validation rules for RefundRatio
+
+	if len(errors) > 0 {
+		return FlaggedClientMultiError(errors)
+	}
+
+	return nil
+}
+
+// FlaggedClientMultiError is an error wrapping multiple validation errors
+// returned by FlaggedClient.ValidateAll() if the designated constraints
+// aren't met.
+type FlaggedClientMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FlaggedClientMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FlaggedClientMultiError) AllErrors() []error { return m }
+
+// FlaggedClientValidationError is the validation error returned by
+// FlaggedClient.Validate if the designated constraints aren't met.
+type FlaggedClientValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FlaggedClientValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FlaggedClientValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FlaggedClientValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FlaggedClientValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FlaggedClientValidationError) ErrorName() string { return "FlaggedClientValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FlaggedClientValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFlaggedClient.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FlaggedClientValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FlaggedClientValidationError{}
+
+// Validate checks the field values on ListFlaggedClientsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFlaggedClientsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFlaggedClientsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFlaggedClientsRequestMultiError, or nil if none found.
+func (m *ListFlaggedClientsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFlaggedClientsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListFlaggedClientsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFlaggedClientsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListFlaggedClientsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListFlaggedClientsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFlaggedClientsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFlaggedClientsRequestMultiError) AllErrors() []error { return m }
+
+// ListFlaggedClientsRequestValidationError is the validation error returned by
+// ListFlaggedClientsRequest.Validate if the designated constraints aren't met.
+type ListFlaggedClientsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFlaggedClientsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFlaggedClientsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFlaggedClientsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFlaggedClientsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFlaggedClientsRequestValidationError) ErrorName() string {
+	return "ListFlaggedClientsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFlaggedClientsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFlaggedClientsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFlaggedClientsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFlaggedClientsRequestValidationError{}
+
+// Validate checks the field values on ListFlaggedClientsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFlaggedClientsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFlaggedClientsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFlaggedClientsResponseMultiError, or nil if none found.
+func (m *ListFlaggedClientsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFlaggedClientsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetClients() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListFlaggedClientsResponseValidationError{
+						field:  fmt.Sprintf("Clients[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListFlaggedClientsResponseValidationError{
+						field:  fmt.Sprintf("Clients[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListFlaggedClientsResponseValidationError{
+					field:  fmt.Sprintf("Clients[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListFlaggedClientsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFlaggedClientsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListFlaggedClientsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListFlaggedClientsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFlaggedClientsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFlaggedClientsResponseMultiError) AllErrors() []error { return m }
+
+// ListFlaggedClientsResponseValidationError is the validation error returned
+// by ListFlaggedClientsResponse.Validate if the designated constraints aren't met.
+type ListFlaggedClientsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFlaggedClientsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFlaggedClientsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFlaggedClientsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFlaggedClientsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFlaggedClientsResponseValidationError) ErrorName() string {
+	return "ListFlaggedClientsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFlaggedClientsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFlaggedClientsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFlaggedClientsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFlaggedClientsResponseValidationError{}
+
+// Validate checks the field values on ArchiveOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ArchiveOrdersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ArchiveOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ArchiveOrdersRequestMultiError, or nil if none found.
+func (m *ArchiveOrdersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ArchiveOrdersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ArchiveOrdersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ArchiveOrdersRequestMultiError is an error wrapping multiple validation
+// errors returned by ArchiveOrdersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ArchiveOrdersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ArchiveOrdersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ArchiveOrdersRequestMultiError) AllErrors() []error { return m }
+
+// ArchiveOrdersRequestValidationError is the validation error returned by
+// ArchiveOrdersRequest.Validate if the designated constraints aren't met.
+type ArchiveOrdersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ArchiveOrdersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ArchiveOrdersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ArchiveOrdersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ArchiveOrdersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ArchiveOrdersRequestValidationError) ErrorName() string {
+	return "ArchiveOrdersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ArchiveOrdersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sArchiveOrdersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ArchiveOrdersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ArchiveOrdersRequestValidationError{}
+
+// Validate checks the field values on ArchiveOrdersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ArchiveOrdersResponse) Validate() error {
+	return m.validate(false)
+}
 
-		if m.GetLimit() <= -1 {
-			err := OrderListRequestValidationError{
-				field:  "Limit",
-				reason: "value must be greater than -1",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+// ValidateAll checks the field values on ArchiveOrdersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ArchiveOrdersResponseMultiError, or nil if none found.
+func (m *ArchiveOrdersResponse) ValidateAll() error {
+	return m.validate(true)
+}
 
+func (m *ArchiveOrdersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for Archived
+
 	if len(errors) > 0 {
-		return OrderListRequestMultiError(errors)
+		return ArchiveOrdersResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// OrderListRequestMultiError is an error wrapping multiple validation errors
-// returned by OrderListRequest.ValidateAll() if the designated constraints
-// aren't met.
-type OrderListRequestMultiError []error
+// ArchiveOrdersResponseMultiError is an error wrapping multiple validation
+// errors returned by ArchiveOrdersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ArchiveOrdersResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m OrderListRequestMultiError) Error() string {
+func (m ArchiveOrdersResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1236,11 +3703,11 @@ func (m OrderListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m OrderListRequestMultiError) AllErrors() []error { return m }
+func (m ArchiveOrdersResponseMultiError) AllErrors() []error { return m }
 
-// OrderListRequestValidationError is the validation error returned by
-// OrderListRequest.Validate if the designated constraints aren't met.
-type OrderListRequestValidationError struct {
+// ArchiveOrdersResponseValidationError is the validation error returned by
+// ArchiveOrdersResponse.Validate if the designated constraints aren't met.
+type ArchiveOrdersResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1248,22 +3715,24 @@ type OrderListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e OrderListRequestValidationError) Field() string { return e.field }
+func (e ArchiveOrdersResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e OrderListRequestValidationError) Reason() string { return e.reason }
+func (e ArchiveOrdersResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e OrderListRequestValidationError) Cause() error { return e.cause }
+func (e ArchiveOrdersResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e OrderListRequestValidationError) Key() bool { return e.key }
+func (e ArchiveOrdersResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e OrderListRequestValidationError) ErrorName() string { return "OrderListRequestValidationError" }
+func (e ArchiveOrdersResponseValidationError) ErrorName() string {
+	return "ArchiveOrdersResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e OrderListRequestValidationError) Error() string {
+func (e ArchiveOrdersResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1275,14 +3744,14 @@ func (e OrderListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sOrderListRequest.%s: %s%s",
+		"invalid %sArchiveOrdersResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = OrderListRequestValidationError{}
+var _ error = ArchiveOrdersResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1290,78 +3759,85 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = OrderListRequestValidationError{}
+} = ArchiveOrdersResponseValidationError{}
 
-// Validate checks the field values on OrderListResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *OrderListResponse) Validate() error {
+// Validate checks the field values on AuditLogEntry with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuditLogEntry) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on OrderListResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// OrderListResponseMultiError, or nil if none found.
-func (m *OrderListResponse) ValidateAll() error {
+// ValidateAll checks the field values on AuditLogEntry with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditLogEntryMultiError, or
+// nil if none found.
+func (m *AuditLogEntry) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *OrderListResponse) validate(all bool) error {
+func (m *AuditLogEntry) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetOrders() {
-		_, _ = idx, item
+	// no validation rules for Id
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, OrderListResponseValidationError{
-						field:  fmt.Sprintf("Orders[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, OrderListResponseValidationError{
-						field:  fmt.Sprintf("Orders[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	// no validation rules for OperatorId
+
+	// no validation rules for Method
+
+	// no validation rules for Before
+
+	// no validation rules for After
+
+	// no validation rules for ClientIp
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditLogEntryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return OrderListResponseValidationError{
-					field:  fmt.Sprintf("Orders[%v]", idx),
+				errors = append(errors, AuditLogEntryValidationError{
+					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditLogEntryValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return OrderListResponseMultiError(errors)
+		return AuditLogEntryMultiError(errors)
 	}
 
 	return nil
 }
 
-// OrderListResponseMultiError is an error wrapping multiple validation errors
-// returned by OrderListResponse.ValidateAll() if the designated constraints
+// AuditLogEntryMultiError is an error wrapping multiple validation errors
+// returned by AuditLogEntry.ValidateAll() if the designated constraints
 // aren't met.
-type OrderListResponseMultiError []error
+type AuditLogEntryMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m OrderListResponseMultiError) Error() string {
+func (m AuditLogEntryMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1370,11 +3846,11 @@ func (m OrderListResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m OrderListResponseMultiError) AllErrors() []error { return m }
+func (m AuditLogEntryMultiError) AllErrors() []error { return m }
 
-// OrderListResponseValidationError is the validation error returned by
-// OrderListResponse.Validate if the designated constraints aren't met.
-type OrderListResponseValidationError struct {
+// AuditLogEntryValidationError is the validation error returned by
+// AuditLogEntry.Validate if the designated constraints aren't met.
+type AuditLogEntryValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1382,24 +3858,22 @@ type OrderListResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e OrderListResponseValidationError) Field() string { return e.field }
+func (e AuditLogEntryValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e OrderListResponseValidationError) Reason() string { return e.reason }
+func (e AuditLogEntryValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e OrderListResponseValidationError) Cause() error { return e.cause }
+func (e AuditLogEntryValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e OrderListResponseValidationError) Key() bool { return e.key }
+func (e AuditLogEntryValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e OrderListResponseValidationError) ErrorName() string {
-	return "OrderListResponseValidationError"
-}
+func (e AuditLogEntryValidationError) ErrorName() string { return "AuditLogEntryValidationError" }
 
 // Error satisfies the builtin error interface
-func (e OrderListResponseValidationError) Error() string {
+func (e AuditLogEntryValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1411,14 +3885,14 @@ func (e OrderListResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sOrderListResponse.%s: %s%s",
+		"invalid %sAuditLogEntry.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = OrderListResponseValidationError{}
+var _ error = AuditLogEntryValidationError{}
 
 var _ interface {
 	Field() string
@@ -1426,34 +3900,125 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = OrderListResponseValidationError{}
+} = AuditLogEntryValidationError{}
 
-// Validate checks the field values on RefundListRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *RefundListRequest) Validate() error {
+// Validate checks the field values on QueryAuditLogRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QueryAuditLogRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RefundListRequest with the rules
+// ValidateAll checks the field values on QueryAuditLogRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RefundListRequestMultiError, or nil if none found.
-func (m *RefundListRequest) ValidateAll() error {
+// QueryAuditLogRequestMultiError, or nil if none found.
+func (m *QueryAuditLogRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RefundListRequest) validate(all bool) error {
+func (m *QueryAuditLogRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if utf8.RuneCountInString(m.GetOperatorId()) > 255 {
+		err := QueryAuditLogRequestValidationError{
+			field:  "OperatorId",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetMethod()) > 255 {
+		err := QueryAuditLogRequestValidationError{
+			field:  "Method",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOrderId() <= -1 {
+		err := QueryAuditLogRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than -1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QueryAuditLogRequestValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QueryAuditLogRequestValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QueryAuditLogRequestValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QueryAuditLogRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QueryAuditLogRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QueryAuditLogRequestValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if m.Limit != nil {
 
 		if m.GetLimit() <= -1 {
-			err := RefundListRequestValidationError{
+			err := QueryAuditLogRequestValidationError{
 				field:  "Limit",
 				reason: "value must be greater than -1",
 			}
@@ -1468,7 +4033,7 @@ func (m *RefundListRequest) validate(all bool) error {
 	if m.Offset != nil {
 
 		if m.GetOffset() <= -1 {
-			err := RefundListRequestValidationError{
+			err := QueryAuditLogRequestValidationError{
 				field:  "Offset",
 				reason: "value must be greater than -1",
 			}
@@ -1481,19 +4046,19 @@ func (m *RefundListRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return RefundListRequestMultiError(errors)
+		return QueryAuditLogRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RefundListRequestMultiError is an error wrapping multiple validation errors
-// returned by RefundListRequest.ValidateAll() if the designated constraints
-// aren't met.
-type RefundListRequestMultiError []error
+// QueryAuditLogRequestMultiError is an error wrapping multiple validation
+// errors returned by QueryAuditLogRequest.ValidateAll() if the designated
+// constraints aren't met.
+type QueryAuditLogRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RefundListRequestMultiError) Error() string {
+func (m QueryAuditLogRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1502,11 +4067,11 @@ func (m RefundListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RefundListRequestMultiError) AllErrors() []error { return m }
+func (m QueryAuditLogRequestMultiError) AllErrors() []error { return m }
 
-// RefundListRequestValidationError is the validation error returned by
-// RefundListRequest.Validate if the designated constraints aren't met.
-type RefundListRequestValidationError struct {
+// QueryAuditLogRequestValidationError is the validation error returned by
+// QueryAuditLogRequest.Validate if the designated constraints aren't met.
+type QueryAuditLogRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1514,24 +4079,24 @@ type RefundListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RefundListRequestValidationError) Field() string { return e.field }
+func (e QueryAuditLogRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RefundListRequestValidationError) Reason() string { return e.reason }
+func (e QueryAuditLogRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RefundListRequestValidationError) Cause() error { return e.cause }
+func (e QueryAuditLogRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RefundListRequestValidationError) Key() bool { return e.key }
+func (e QueryAuditLogRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RefundListRequestValidationError) ErrorName() string {
-	return "RefundListRequestValidationError"
+func (e QueryAuditLogRequestValidationError) ErrorName() string {
+	return "QueryAuditLogRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RefundListRequestValidationError) Error() string {
+func (e QueryAuditLogRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1543,14 +4108,14 @@ func (e RefundListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRefundListRequest.%s: %s%s",
+		"invalid %sQueryAuditLogRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RefundListRequestValidationError{}
+var _ error = QueryAuditLogRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1558,47 +4123,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RefundListRequestValidationError{}
+} = QueryAuditLogRequestValidationError{}
 
-// Validate checks the field values on RefundListResponse with the rules
+// Validate checks the field values on QueryAuditLogResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RefundListResponse) Validate() error {
+func (m *QueryAuditLogResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RefundListResponse with the rules
+// ValidateAll checks the field values on QueryAuditLogResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RefundListResponseMultiError, or nil if none found.
-func (m *RefundListResponse) ValidateAll() error {
+// QueryAuditLogResponseMultiError, or nil if none found.
+func (m *QueryAuditLogResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RefundListResponse) validate(all bool) error {
+func (m *QueryAuditLogResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetOrders() {
+	for idx, item := range m.GetEntries() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, RefundListResponseValidationError{
-						field:  fmt.Sprintf("Orders[%v]", idx),
+					errors = append(errors, QueryAuditLogResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, RefundListResponseValidationError{
-						field:  fmt.Sprintf("Orders[%v]", idx),
+					errors = append(errors, QueryAuditLogResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -1606,8 +4171,8 @@ func (m *RefundListResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return RefundListResponseValidationError{
-					field:  fmt.Sprintf("Orders[%v]", idx),
+				return QueryAuditLogResponseValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -1617,19 +4182,19 @@ func (m *RefundListResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return RefundListResponseMultiError(errors)
+		return QueryAuditLogResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RefundListResponseMultiError is an error wrapping multiple validation errors
-// returned by RefundListResponse.ValidateAll() if the designated constraints
-// aren't met.
-type RefundListResponseMultiError []error
+// QueryAuditLogResponseMultiError is an error wrapping multiple validation
+// errors returned by QueryAuditLogResponse.ValidateAll() if the designated
+// constraints aren't met.
+type QueryAuditLogResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RefundListResponseMultiError) Error() string {
+func (m QueryAuditLogResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1638,11 +4203,11 @@ func (m RefundListResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RefundListResponseMultiError) AllErrors() []error { return m }
+func (m QueryAuditLogResponseMultiError) AllErrors() []error { return m }
 
-// RefundListResponseValidationError is the validation error returned by
-// RefundListResponse.Validate if the designated constraints aren't met.
-type RefundListResponseValidationError struct {
+// QueryAuditLogResponseValidationError is the validation error returned by
+// QueryAuditLogResponse.Validate if the designated constraints aren't met.
+type QueryAuditLogResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1650,24 +4215,24 @@ type RefundListResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RefundListResponseValidationError) Field() string { return e.field }
+func (e QueryAuditLogResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RefundListResponseValidationError) Reason() string { return e.reason }
+func (e QueryAuditLogResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RefundListResponseValidationError) Cause() error { return e.cause }
+func (e QueryAuditLogResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RefundListResponseValidationError) Key() bool { return e.key }
+func (e QueryAuditLogResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RefundListResponseValidationError) ErrorName() string {
-	return "RefundListResponseValidationError"
+func (e QueryAuditLogResponseValidationError) ErrorName() string {
+	return "QueryAuditLogResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RefundListResponseValidationError) Error() string {
+func (e QueryAuditLogResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1679,14 +4244,14 @@ func (e RefundListResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRefundListResponse.%s: %s%s",
+		"invalid %sQueryAuditLogResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RefundListResponseValidationError{}
+var _ error = QueryAuditLogResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1694,4 +4259,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RefundListResponseValidationError{}
+} = QueryAuditLogResponseValidationError{}
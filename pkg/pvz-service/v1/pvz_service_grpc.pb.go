@@ -19,12 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PVZService_ReceiveCourier_FullMethodName = "/pvz.PVZService/ReceiveCourier"
-	PVZService_ReturnCourier_FullMethodName  = "/pvz.PVZService/ReturnCourier"
-	PVZService_GiveOutClient_FullMethodName  = "/pvz.PVZService/GiveOutClient"
-	PVZService_RefundClient_FullMethodName   = "/pvz.PVZService/RefundClient"
-	PVZService_OrderList_FullMethodName      = "/pvz.PVZService/OrderList"
-	PVZService_RefundList_FullMethodName     = "/pvz.PVZService/RefundList"
+	PVZService_ReceiveCourier_FullMethodName         = "/pvz.PVZService/ReceiveCourier"
+	PVZService_ReturnCourier_FullMethodName          = "/pvz.PVZService/ReturnCourier"
+	PVZService_GiveOutClient_FullMethodName          = "/pvz.PVZService/GiveOutClient"
+	PVZService_CheckoutClient_FullMethodName         = "/pvz.PVZService/CheckoutClient"
+	PVZService_GiveOutItems_FullMethodName           = "/pvz.PVZService/GiveOutItems"
+	PVZService_RefundClient_FullMethodName           = "/pvz.PVZService/RefundClient"
+	PVZService_OrderList_FullMethodName              = "/pvz.PVZService/OrderList"
+	PVZService_RefundList_FullMethodName             = "/pvz.PVZService/RefundList"
+	PVZService_ReturnRefundsToCourier_FullMethodName = "/pvz.PVZService/ReturnRefundsToCourier"
+	PVZService_ApproveRefund_FullMethodName          = "/pvz.PVZService/ApproveRefund"
+	PVZService_ListFlaggedClients_FullMethodName     = "/pvz.PVZService/ListFlaggedClients"
+	PVZService_ArchiveOrders_FullMethodName          = "/pvz.PVZService/ArchiveOrders"
+	PVZService_QueryAuditLog_FullMethodName          = "/pvz.PVZService/QueryAuditLog"
 )
 
 // PVZServiceClient is the client API for PVZService service.
@@ -34,9 +41,16 @@ type PVZServiceClient interface {
 	ReceiveCourier(ctx context.Context, in *ReceiveCourierRequest, opts ...grpc.CallOption) (*ReceiveCourierResponse, error)
 	ReturnCourier(ctx context.Context, in *ReturnCourierRequest, opts ...grpc.CallOption) (*ReturnCourierResponse, error)
 	GiveOutClient(ctx context.Context, in *GiveOutClientRequest, opts ...grpc.CallOption) (*GiveOutClientResponse, error)
+	CheckoutClient(ctx context.Context, in *CheckoutClientRequest, opts ...grpc.CallOption) (*CheckoutClientResponse, error)
+	GiveOutItems(ctx context.Context, in *GiveOutItemsRequest, opts ...grpc.CallOption) (*GiveOutItemsResponse, error)
 	RefundClient(ctx context.Context, in *RefundClientRequest, opts ...grpc.CallOption) (*RefundClientResponse, error)
 	OrderList(ctx context.Context, in *OrderListRequest, opts ...grpc.CallOption) (*OrderListResponse, error)
 	RefundList(ctx context.Context, in *RefundListRequest, opts ...grpc.CallOption) (*RefundListResponse, error)
+	ReturnRefundsToCourier(ctx context.Context, in *ReturnRefundsToCourierRequest, opts ...grpc.CallOption) (*ReturnRefundsToCourierResponse, error)
+	ApproveRefund(ctx context.Context, in *ApproveRefundRequest, opts ...grpc.CallOption) (*ApproveRefundResponse, error)
+	ListFlaggedClients(ctx context.Context, in *ListFlaggedClientsRequest, opts ...grpc.CallOption) (*ListFlaggedClientsResponse, error)
+	ArchiveOrders(ctx context.Context, in *ArchiveOrdersRequest, opts ...grpc.CallOption) (*ArchiveOrdersResponse, error)
+	QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error)
 }
 
 type pVZServiceClient struct {
@@ -77,6 +91,26 @@ func (c *pVZServiceClient) GiveOutClient(ctx context.Context, in *GiveOutClientR
 	return out, nil
 }
 
+func (c *pVZServiceClient) CheckoutClient(ctx context.Context, in *CheckoutClientRequest, opts ...grpc.CallOption) (*CheckoutClientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckoutClientResponse)
+	err := c.cc.Invoke(ctx, PVZService_CheckoutClient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) GiveOutItems(ctx context.Context, in *GiveOutItemsRequest, opts ...grpc.CallOption) (*GiveOutItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GiveOutItemsResponse)
+	err := c.cc.Invoke(ctx, PVZService_GiveOutItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pVZServiceClient) RefundClient(ctx context.Context, in *RefundClientRequest, opts ...grpc.CallOption) (*RefundClientResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RefundClientResponse)
@@ -107,6 +141,56 @@ func (c *pVZServiceClient) RefundList(ctx context.Context, in *RefundListRequest
 	return out, nil
 }
 
+func (c *pVZServiceClient) ReturnRefundsToCourier(ctx context.Context, in *ReturnRefundsToCourierRequest, opts ...grpc.CallOption) (*ReturnRefundsToCourierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReturnRefundsToCourierResponse)
+	err := c.cc.Invoke(ctx, PVZService_ReturnRefundsToCourier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) ApproveRefund(ctx context.Context, in *ApproveRefundRequest, opts ...grpc.CallOption) (*ApproveRefundResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveRefundResponse)
+	err := c.cc.Invoke(ctx, PVZService_ApproveRefund_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) ListFlaggedClients(ctx context.Context, in *ListFlaggedClientsRequest, opts ...grpc.CallOption) (*ListFlaggedClientsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFlaggedClientsResponse)
+	err := c.cc.Invoke(ctx, PVZService_ListFlaggedClients_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) ArchiveOrders(ctx context.Context, in *ArchiveOrdersRequest, opts ...grpc.CallOption) (*ArchiveOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveOrdersResponse)
+	err := c.cc.Invoke(ctx, PVZService_ArchiveOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAuditLogResponse)
+	err := c.cc.Invoke(ctx, PVZService_QueryAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PVZServiceServer is the server API for PVZService service.
 // All implementations must embed UnimplementedPVZServiceServer
 // for forward compatibility.
@@ -114,9 +198,16 @@ type PVZServiceServer interface {
 	ReceiveCourier(context.Context, *ReceiveCourierRequest) (*ReceiveCourierResponse, error)
 	ReturnCourier(context.Context, *ReturnCourierRequest) (*ReturnCourierResponse, error)
 	GiveOutClient(context.Context, *GiveOutClientRequest) (*GiveOutClientResponse, error)
+	CheckoutClient(context.Context, *CheckoutClientRequest) (*CheckoutClientResponse, error)
+	GiveOutItems(context.Context, *GiveOutItemsRequest) (*GiveOutItemsResponse, error)
 	RefundClient(context.Context, *RefundClientRequest) (*RefundClientResponse, error)
 	OrderList(context.Context, *OrderListRequest) (*OrderListResponse, error)
 	RefundList(context.Context, *RefundListRequest) (*RefundListResponse, error)
+	ReturnRefundsToCourier(context.Context, *ReturnRefundsToCourierRequest) (*ReturnRefundsToCourierResponse, error)
+	ApproveRefund(context.Context, *ApproveRefundRequest) (*ApproveRefundResponse, error)
+	ListFlaggedClients(context.Context, *ListFlaggedClientsRequest) (*ListFlaggedClientsResponse, error)
+	ArchiveOrders(context.Context, *ArchiveOrdersRequest) (*ArchiveOrdersResponse, error)
+	QueryAuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error)
 	mustEmbedUnimplementedPVZServiceServer()
 }
 
@@ -136,6 +227,12 @@ func (UnimplementedPVZServiceServer) ReturnCourier(context.Context, *ReturnCouri
 func (UnimplementedPVZServiceServer) GiveOutClient(context.Context, *GiveOutClientRequest) (*GiveOutClientResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GiveOutClient not implemented")
 }
+func (UnimplementedPVZServiceServer) CheckoutClient(context.Context, *CheckoutClientRequest) (*CheckoutClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckoutClient not implemented")
+}
+func (UnimplementedPVZServiceServer) GiveOutItems(context.Context, *GiveOutItemsRequest) (*GiveOutItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GiveOutItems not implemented")
+}
 func (UnimplementedPVZServiceServer) RefundClient(context.Context, *RefundClientRequest) (*RefundClientResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefundClient not implemented")
 }
@@ -145,6 +242,21 @@ func (UnimplementedPVZServiceServer) OrderList(context.Context, *OrderListReques
 func (UnimplementedPVZServiceServer) RefundList(context.Context, *RefundListRequest) (*RefundListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefundList not implemented")
 }
+func (UnimplementedPVZServiceServer) ReturnRefundsToCourier(context.Context, *ReturnRefundsToCourierRequest) (*ReturnRefundsToCourierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReturnRefundsToCourier not implemented")
+}
+func (UnimplementedPVZServiceServer) ApproveRefund(context.Context, *ApproveRefundRequest) (*ApproveRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveRefund not implemented")
+}
+func (UnimplementedPVZServiceServer) ListFlaggedClients(context.Context, *ListFlaggedClientsRequest) (*ListFlaggedClientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFlaggedClients not implemented")
+}
+func (UnimplementedPVZServiceServer) ArchiveOrders(context.Context, *ArchiveOrdersRequest) (*ArchiveOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveOrders not implemented")
+}
+func (UnimplementedPVZServiceServer) QueryAuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAuditLog not implemented")
+}
 func (UnimplementedPVZServiceServer) mustEmbedUnimplementedPVZServiceServer() {}
 func (UnimplementedPVZServiceServer) testEmbeddedByValue()                    {}
 
@@ -220,6 +332,42 @@ func _PVZService_GiveOutClient_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PVZService_CheckoutClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckoutClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).CheckoutClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_CheckoutClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).CheckoutClient(ctx, req.(*CheckoutClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_GiveOutItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GiveOutItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).GiveOutItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_GiveOutItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).GiveOutItems(ctx, req.(*GiveOutItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PVZService_RefundClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RefundClientRequest)
 	if err := dec(in); err != nil {
@@ -274,6 +422,96 @@ func _PVZService_RefundList_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PVZService_ReturnRefundsToCourier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReturnRefundsToCourierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).ReturnRefundsToCourier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_ReturnRefundsToCourier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).ReturnRefundsToCourier(ctx, req.(*ReturnRefundsToCourierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_ApproveRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).ApproveRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_ApproveRefund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).ApproveRefund(ctx, req.(*ApproveRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_ListFlaggedClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFlaggedClientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).ListFlaggedClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_ListFlaggedClients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).ListFlaggedClients(ctx, req.(*ListFlaggedClientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_ArchiveOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).ArchiveOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_ArchiveOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).ArchiveOrders(ctx, req.(*ArchiveOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_QueryAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).QueryAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_QueryAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).QueryAuditLog(ctx, req.(*QueryAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PVZService_ServiceDesc is the grpc.ServiceDesc for PVZService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -293,6 +531,14 @@ var PVZService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GiveOutClient",
 			Handler:    _PVZService_GiveOutClient_Handler,
 		},
+		{
+			MethodName: "CheckoutClient",
+			Handler:    _PVZService_CheckoutClient_Handler,
+		},
+		{
+			MethodName: "GiveOutItems",
+			Handler:    _PVZService_GiveOutItems_Handler,
+		},
 		{
 			MethodName: "RefundClient",
 			Handler:    _PVZService_RefundClient_Handler,
@@ -305,6 +551,26 @@ var PVZService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RefundList",
 			Handler:    _PVZService_RefundList_Handler,
 		},
+		{
+			MethodName: "ReturnRefundsToCourier",
+			Handler:    _PVZService_ReturnRefundsToCourier_Handler,
+		},
+		{
+			MethodName: "ApproveRefund",
+			Handler:    _PVZService_ApproveRefund_Handler,
+		},
+		{
+			MethodName: "ListFlaggedClients",
+			Handler:    _PVZService_ListFlaggedClients_Handler,
+		},
+		{
+			MethodName: "ArchiveOrders",
+			Handler:    _PVZService_ArchiveOrders_Handler,
+		},
+		{
+			MethodName: "QueryAuditLog",
+			Handler:    _PVZService_QueryAuditLog_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pvz-service/v1/pvz_service.proto",
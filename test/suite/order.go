@@ -212,6 +212,34 @@ func (s *OrderSuite) TestGetRefundsListSuccess() {
 	err := s.repo.AddOrder(context.Background(), order)
 	s.Require().NoError(err)
 
-	_, err = s.repo.GetRefundsList(context.Background(), 0, 0)
+	statuses := []string{domain.OrderStatusMap[domain.OrderStatusRefunded]}
+	_, err = s.repo.GetRefundsList(context.Background(), statuses, 0, 0)
 	s.Require().NoError(err)
 }
+
+func (s *OrderSuite) TestUpdateOrderConcurrentModification() {
+	order := dto.OrderDTO{
+		ID:         10,
+		ClientID:   10,
+		StoreUntil: time.Now().Add(24 * time.Hour),
+		Cost:       1000,
+		Weight:     7,
+		Status:     domain.OrderStatusMap[domain.OrderStatusReceived],
+	}
+
+	err := s.repo.AddOrder(context.Background(), order)
+	s.Require().NoError(err)
+
+	order.Status = domain.OrderStatusMap[domain.OrderStatusPickedUp]
+	err = s.repo.UpdateOrder(context.Background(), order)
+	s.Require().NoError(err)
+
+	order.Status = domain.OrderStatusMap[domain.OrderStatusDelete]
+	err = s.repo.UpdateOrder(context.Background(), order)
+	s.Require().ErrorIs(err, domain.ErrConcurrentModification)
+
+	stored, err := s.repo.GetOrderByID(context.Background(), 10)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), stored.Version)
+	s.Require().Equal(domain.OrderStatusMap[domain.OrderStatusPickedUp], stored.Status)
+}